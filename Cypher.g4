@@ -220,7 +220,18 @@ oC_PatternPart
                ;
 
 oC_AnonymousPatternPart
-                    :  oC_PatternElement ;
+                    :  oC_ShortestPathPattern
+                        | oC_PatternElement
+                        ;
+
+oC_ShortestPathPattern
+                   :  ( SHORTESTPATH '(' oC_PatternElement ')' )
+                       | ( ALLSHORTESTPATHS '(' oC_PatternElement ')' )
+                       ;
+
+SHORTESTPATH : ( 'S' | 's' ) ( 'H' | 'h' ) ( 'O' | 'o' ) ( 'R' | 'r' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'S' | 's' ) ( 'T' | 't' ) ( 'P' | 'p' ) ( 'A' | 'a' ) ( 'T' | 't' ) ( 'H' | 'h' )  ;
+
+ALLSHORTESTPATHS : ( 'A' | 'a' ) ( 'L' | 'l' ) ( 'L' | 'l' ) ( 'S' | 's' ) ( 'H' | 'h' ) ( 'O' | 'o' ) ( 'R' | 'r' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'S' | 's' ) ( 'T' | 't' ) ( 'P' | 'p' ) ( 'A' | 'a' ) ( 'T' | 't' ) ( 'H' | 'h' ) ( 'S' | 's' )  ;
 
 oC_PatternElement
               :  ( oC_NodePattern ( SP? oC_PatternElementChain )* )
@@ -316,7 +327,7 @@ oC_ListOperatorExpression
 IN : ( 'I' | 'i' ) ( 'N' | 'n' )  ;
 
 oC_StringOperatorExpression
-                        :  ( ( SP STARTS SP WITH ) | ( SP ENDS SP WITH ) | ( SP CONTAINS ) ) SP? oC_PropertyOrLabelsExpression ;
+                        :  ( ( SP STARTS SP WITH ) | ( SP ENDS SP WITH ) | ( SP CONTAINS ) | ( SP? '=~' ) ) SP? oC_PropertyOrLabelsExpression ;
 
 STARTS : ( 'S' | 's' ) ( 'T' | 't' ) ( 'A' | 'a' ) ( 'R' | 'r' ) ( 'T' | 't' ) ( 'S' | 's' )  ;
 
@@ -350,9 +361,26 @@ oC_Atom
         | oC_RelationshipsPattern
         | oC_ParenthesizedExpression
         | oC_FunctionInvocation
+        | oC_MapProjection
+        | oC_Reduce
         | oC_Variable
         ;
 
+oC_Reduce
+      :  REDUCE SP? '(' SP? oC_Variable SP? '=' SP? oC_Expression SP? ',' SP? oC_IdInColl SP? '|' SP? oC_Expression SP? ')' ;
+
+REDUCE : ( 'R' | 'r' ) ( 'E' | 'e' ) ( 'D' | 'd' ) ( 'U' | 'u' ) ( 'C' | 'c' ) ( 'E' | 'e' )  ;
+
+oC_MapProjection
+             :  oC_Variable SP? '{' SP? ( oC_MapProjectionItem SP? ( ',' SP? oC_MapProjectionItem SP? )* )? '}' ;
+
+oC_MapProjectionItem
+                 :  oC_PropertyLookup
+                     | ( oC_PropertyKeyName SP? ':' SP? oC_Expression )
+                     | oC_Variable
+                     | ( '.' SP? '*' )
+                     ;
+
 COUNT : ( 'C' | 'c' ) ( 'O' | 'o' ) ( 'U' | 'u' ) ( 'N' | 'n' ) ( 'T' | 't' )  ;
 
 ANY : ( 'A' | 'a' ) ( 'N' | 'n' ) ( 'Y' | 'y' )  ;
@@ -643,6 +671,9 @@ oC_SymbolicName
                 | ANY
                 | NONE
                 | SINGLE
+                | REDUCE
+                | SHORTESTPATH
+                | ALLSHORTESTPATHS
                 ;
 
 FILTER : ( 'F' | 'f' ) ( 'I' | 'i' ) ( 'L' | 'l' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'R' | 'r' )  ;
@@ -0,0 +1,578 @@
+package opencypher
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+// Aggregator computes the value of an aggregation function for a
+// group of rows.
+type Aggregator interface {
+	// Add is called for each row of the group with the evaluated
+	// function arguments. Rows whose first argument is null are not
+	// passed to the aggregator.
+	Add(ctx *EvalContext, args []Value) error
+	// Result returns the aggregated value
+	Result(ctx *EvalContext) (Value, error)
+}
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:          "count",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &countAggregator{} },
+		},
+		Function{
+			Name:          "collect",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &collectAggregator{values: []Value{}} },
+		},
+		Function{
+			Name:          "sum",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &sumAggregator{} },
+		},
+		Function{
+			Name:          "avg",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &avgAggregator{} },
+		},
+		Function{
+			Name:          "min",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &minMaxAggregator{min: true} },
+		},
+		Function{
+			Name:          "max",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &minMaxAggregator{} },
+		},
+		Function{
+			Name:          "percentileCont",
+			MinArgs:       2,
+			MaxArgs:       2,
+			NewAggregator: func() Aggregator { return &percentileAggregator{continuous: true} },
+		},
+		Function{
+			Name:          "percentileDisc",
+			MinArgs:       2,
+			MaxArgs:       2,
+			NewAggregator: func() Aggregator { return &percentileAggregator{} },
+		},
+		Function{
+			Name:          "stDev",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &stDevAggregator{} },
+		},
+		Function{
+			Name:          "stDevP",
+			MinArgs:       1,
+			MaxArgs:       1,
+			NewAggregator: func() Aggregator { return &stDevAggregator{population: true} },
+		},
+	)
+}
+
+type countAggregator struct {
+	n int
+}
+
+func (c *countAggregator) Add(ctx *EvalContext, args []Value) error {
+	c.n++
+	return nil
+}
+
+func (c *countAggregator) Result(ctx *EvalContext) (Value, error) {
+	return RValue{Value: c.n}, nil
+}
+
+type collectAggregator struct {
+	values []Value
+}
+
+func (c *collectAggregator) Add(ctx *EvalContext, args []Value) error {
+	c.values = append(c.values, RValue{Value: args[0].Get()})
+	return nil
+}
+
+func (c *collectAggregator) Result(ctx *EvalContext) (Value, error) {
+	return RValue{Value: c.values}, nil
+}
+
+type sumAggregator struct {
+	intSum   int
+	floatSum float64
+	durSum   Duration
+	// isNum is set if a number is added, and isDur if a duration is
+	// added. Numbers and durations cannot be summed together.
+	isNum   bool
+	isFloat bool
+	isDur   bool
+}
+
+func (s *sumAggregator) Add(ctx *EvalContext, args []Value) error {
+	switch v := args[0].Get().(type) {
+	case int:
+		if s.isDur {
+			return ErrInvalidAdditiveOperation
+		}
+		s.isNum = true
+		s.intSum += v
+		s.floatSum += float64(v)
+	case float64:
+		if s.isDur {
+			return ErrInvalidAdditiveOperation
+		}
+		s.isNum = true
+		s.isFloat = true
+		s.floatSum += v
+	case Duration:
+		if s.isNum {
+			return ErrInvalidAdditiveOperation
+		}
+		s.isDur = true
+		s.durSum, _ = adddurdur(s.durSum, v, false)
+	default:
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("sum: Numeric value expected: %v", v)}
+	}
+	return nil
+}
+
+func (s *sumAggregator) Result(ctx *EvalContext) (Value, error) {
+	if s.isDur {
+		return RValue{Value: s.durSum}, nil
+	}
+	if s.isFloat {
+		return RValue{Value: s.floatSum}, nil
+	}
+	return RValue{Value: s.intSum}, nil
+}
+
+type avgAggregator struct {
+	sum float64
+	n   int
+}
+
+func (a *avgAggregator) Add(ctx *EvalContext, args []Value) error {
+	f, err := valueAsFloat(args[0])
+	if err != nil {
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("avg: %s", err)}
+	}
+	a.sum += f
+	a.n++
+	return nil
+}
+
+func (a *avgAggregator) Result(ctx *EvalContext) (Value, error) {
+	if a.n == 0 {
+		return RValue{}, nil
+	}
+	return RValue{Value: a.sum / float64(a.n)}, nil
+}
+
+// minMaxAggregator keeps the least or the greatest value. Values of
+// different types are ordered as in ORDER BY.
+type minMaxAggregator struct {
+	min   bool
+	value Value
+}
+
+func (m *minMaxAggregator) Add(ctx *EvalContext, args []Value) error {
+	if m.value == nil {
+		m.value = RValue{Value: args[0].Get()}
+		return nil
+	}
+	c := compareOrderable(args[0].Get(), m.value.Get())
+	if (m.min && c < 0) || (!m.min && c > 0) {
+		m.value = RValue{Value: args[0].Get()}
+	}
+	return nil
+}
+
+func (m *minMaxAggregator) Result(ctx *EvalContext) (Value, error) {
+	if m.value == nil {
+		return RValue{}, nil
+	}
+	return m.value, nil
+}
+
+type percentileAggregator struct {
+	continuous bool
+	percentile float64
+	values     []float64
+	// Set if all values are integers, so percentileDisc can return an int
+	allInts bool
+}
+
+func (p *percentileAggregator) Add(ctx *EvalContext, args []Value) error {
+	if args[1].Get() == nil {
+		return ErrInvalidFunctionCall{Msg: "percentile cannot be null"}
+	}
+	percentile, err := valueAsFloat(args[1])
+	if err != nil {
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("percentile: %s", err)}
+	}
+	if percentile < 0 || percentile > 1 {
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("percentile must be between 0 and 1: %v", percentile)}
+	}
+	p.percentile = percentile
+	f, err := valueAsFloat(args[0])
+	if err != nil {
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("percentile: %s", err)}
+	}
+	if len(p.values) == 0 {
+		p.allInts = true
+	}
+	if _, ok := args[0].Get().(int); !ok {
+		p.allInts = false
+	}
+	p.values = append(p.values, f)
+	return nil
+}
+
+func (p *percentileAggregator) Result(ctx *EvalContext) (Value, error) {
+	if len(p.values) == 0 {
+		return RValue{}, nil
+	}
+	sort.Float64s(p.values)
+	count := len(p.values)
+	if p.continuous {
+		index := p.percentile * float64(count-1)
+		floor := math.Floor(index)
+		ceil := math.Ceil(index)
+		if floor == ceil {
+			return RValue{Value: p.values[int(floor)]}, nil
+		}
+		lower := p.values[int(floor)]
+		upper := p.values[int(ceil)]
+		return RValue{Value: lower + (upper-lower)*(index-floor)}, nil
+	}
+	var index int
+	if p.percentile == 1 || count == 1 {
+		index = count - 1
+	} else {
+		f := p.percentile * float64(count)
+		index = int(f)
+		if f == float64(index) && index != 0 {
+			index--
+		}
+	}
+	if p.allInts {
+		return RValue{Value: int(p.values[index])}, nil
+	}
+	return RValue{Value: p.values[index]}, nil
+}
+
+type stDevAggregator struct {
+	population bool
+	values     []float64
+}
+
+func (s *stDevAggregator) Add(ctx *EvalContext, args []Value) error {
+	f, err := valueAsFloat(args[0])
+	if err != nil {
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("stDev: %s", err)}
+	}
+	s.values = append(s.values, f)
+	return nil
+}
+
+func (s *stDevAggregator) Result(ctx *EvalContext) (Value, error) {
+	n := len(s.values)
+	if n == 0 || (n == 1 && !s.population) {
+		return RValue{Value: 0.0}, nil
+	}
+	mean := 0.0
+	for _, x := range s.values {
+		mean += x
+	}
+	mean /= float64(n)
+	sum := 0.0
+	for _, x := range s.values {
+		sum += (x - mean) * (x - mean)
+	}
+	if s.population {
+		return RValue{Value: math.Sqrt(sum / float64(n))}, nil
+	}
+	return RValue{Value: math.Sqrt(sum / float64(n-1))}, nil
+}
+
+// distinctAggregator passes only the distinct values to the
+// underlying aggregator
+type distinctAggregator struct {
+	Aggregator
+	seen map[string]struct{}
+}
+
+func (d *distinctAggregator) Add(ctx *EvalContext, args []Value) error {
+	key := valueHashKey(args[0])
+	if _, ok := d.seen[key]; ok {
+		return nil
+	}
+	d.seen[key] = struct{}{}
+	return d.Aggregator.Add(ctx, args)
+}
+
+// valueAsFloat returns the numeric value as float64
+func valueAsFloat(v Value) (float64, error) {
+	switch val := v.Get().(type) {
+	case int:
+		return float64(val), nil
+	case float64:
+		return val, nil
+	}
+	return 0, fmt.Errorf("Numeric value expected: %v", v.Get())
+}
+
+// valueHashKey returns a string that is the same for values that
+// are the same. Numbers with the same value have the same key, so 1
+// and 1.0 are the same. Strings are quoted, so the keys of different
+// values cannot be confused when they are joined.
+func valueHashKey(v Value) string {
+	switch val := v.Get().(type) {
+	case nil:
+		return "null"
+	case int:
+		return fmt.Sprintf("num:%d", val)
+	case float64:
+		if val == math.Trunc(val) && math.Abs(val) < math.MaxInt64 {
+			return fmt.Sprintf("num:%d", int64(val))
+		}
+		return fmt.Sprintf("num:%v", val)
+	case *lpg.Node:
+		return fmt.Sprintf("n%p", val)
+	case *lpg.Edge:
+		return fmt.Sprintf("e%p", val)
+	case *lpg.Path:
+		bld := strings.Builder{}
+		bld.WriteString("p")
+		if val.NumEdges() == 0 {
+			fmt.Fprintf(&bld, "%p", val.First())
+		}
+		for i := 0; i < val.NumEdges(); i++ {
+			fmt.Fprintf(&bld, "%p,", val.GetEdge(i))
+		}
+		return bld.String()
+	case []Value:
+		keys := make([]string, 0, len(val))
+		for _, x := range val {
+			keys = append(keys, valueHashKey(x))
+		}
+		return "[" + strings.Join(keys, ",") + "]"
+	case map[string]Value:
+		keys := make([]string, 0, len(val))
+		for k, x := range val {
+			keys = append(keys, strconv.Quote(k)+":"+valueHashKey(x))
+		}
+		sort.Strings(keys)
+		return "{" + strings.Join(keys, ",") + "}"
+	}
+	return fmt.Sprintf("%T:%q", v.Get(), fmt.Sprint(v.Get()))
+}
+
+// aggregateExpr is an aggregation function invocation, or count(*)
+// in a projection
+type aggregateExpr struct {
+	// Either the text of the function invocation, or countAtom
+	expr     interface{}
+	function *functionInvocation
+}
+
+func (a aggregateExpr) newAggregator() Aggregator {
+	if a.function == nil {
+		return &countAggregator{}
+	}
+	agg := a.function.function.NewAggregator()
+	if a.function.distinct {
+		return &distinctAggregator{Aggregator: agg, seen: make(map[string]struct{})}
+	}
+	return agg
+}
+
+// add evaluates the arguments of the aggregation function and adds
+// them to the aggregator
+func (a aggregateExpr) add(ctx *EvalContext, agg Aggregator) error {
+	if a.function == nil {
+		return agg.Add(ctx, nil)
+	}
+	args := make([]Value, 0, len(a.function.args))
+	for _, arg := range a.function.args {
+		v, err := arg.Evaluate(ctx)
+		if err != nil {
+			return err
+		}
+		args = append(args, v)
+	}
+	if args[0].Get() == nil {
+		return nil
+	}
+	return agg.Add(ctx, args)
+}
+
+// getAggregates returns the aggregation expressions in expr
+func getAggregates(ctx *EvalContext, expr Expression) ([]aggregateExpr, error) {
+	ret := make([]aggregateExpr, 0)
+	var err error
+	walkExpression(expr, func(e Evaluatable) bool {
+		if err != nil {
+			return false
+		}
+		switch t := e.(type) {
+		case countAtom:
+			ret = append(ret, aggregateExpr{expr: t})
+			return false
+		case *functionInvocation:
+			var fn *Function
+			fn, err = t.getFunction(ctx)
+			if err != nil {
+				return false
+			}
+			if fn.IsAggregation() {
+				if err = t.checkArgs(); err != nil {
+					return false
+				}
+				ret = append(ret, aggregateExpr{expr: t.text, function: t})
+				return false
+			}
+		}
+		return true
+	})
+	return ret, err
+}
+
+type aggregationGroup struct {
+	// The first row of the group
+	row         map[string]Value
+	keys        map[string]Value
+	aggregators []Aggregator
+}
+
+// projectGroups groups the input rows by the values of the
+// non-aggregate projection items, and evaluates the aggregation
+// functions for each group
+func (prj projectionItems) projectGroups(ctx *EvalContext, rows []map[string]Value, names []string, aggregates [][]aggregateExpr) ([]projectedRow, error) {
+	allAggregates := make([]aggregateExpr, 0)
+	for _, x := range aggregates {
+		allAggregates = append(allAggregates, x...)
+	}
+
+	groups := make([]*aggregationGroup, 0)
+	groupIndex := make(map[string]*aggregationGroup)
+	newGroup := func(row, keys map[string]Value) *aggregationGroup {
+		grp := &aggregationGroup{row: row, keys: keys}
+		for _, a := range allAggregates {
+			grp.aggregators = append(grp.aggregators, a.newAggregator())
+		}
+		groups = append(groups, grp)
+		return grp
+	}
+	for _, row := range rows {
+		rowCtx := ctx.SubContext()
+		for k, v := range row {
+			rowCtx.SetVar(k, v)
+		}
+		keys := make(map[string]Value)
+		keyStr := make([]string, 0, len(prj.items))
+		if prj.all {
+			for k, v := range row {
+				if IsNamedVar(k) {
+					keys[k] = v
+				}
+			}
+			for _, k := range sortedKeys(keys) {
+				keyStr = append(keyStr, valueHashKey(keys[k]))
+			}
+		}
+		for i, item := range prj.items {
+			if len(aggregates[i]) > 0 {
+				continue
+			}
+			v, err := item.expr.Evaluate(rowCtx)
+			if err != nil {
+				return nil, err
+			}
+			keys[names[i]] = v
+			keyStr = append(keyStr, valueHashKey(v))
+		}
+		key := strings.Join(keyStr, ";")
+		grp := groupIndex[key]
+		if grp == nil {
+			grp = newGroup(row, keys)
+			groupIndex[key] = grp
+		}
+		for i, a := range allAggregates {
+			if err := a.add(rowCtx, grp.aggregators[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(rows) == 0 && !prj.all && !hasGroupingKeys(aggregates) {
+		// No grouping keys, aggregate over an empty set
+		newGroup(nil, map[string]Value{})
+	}
+
+	ret := make([]projectedRow, 0, len(groups))
+	for _, grp := range groups {
+		grpCtx := ctx.SubContext()
+		for k, v := range grp.row {
+			grpCtx.SetVar(k, v)
+		}
+		grpCtx.aggregates = make(map[interface{}]Value)
+		for i, a := range allAggregates {
+			v, err := grp.aggregators[i].Result(grpCtx)
+			if err != nil {
+				return nil, err
+			}
+			grpCtx.aggregates[a.expr] = v
+		}
+		out := make(map[string]Value)
+		for k, v := range grp.keys {
+			out[k] = v
+		}
+		for i, item := range prj.items {
+			if len(aggregates[i]) == 0 {
+				continue
+			}
+			v, err := item.expr.Evaluate(grpCtx)
+			if err != nil {
+				return nil, err
+			}
+			out[names[i]] = v
+		}
+		ret = append(ret, projectedRow{row: out, vars: grpCtx})
+	}
+	return ret, nil
+}
+
+// hasGroupingKeys returns true if there are projection items
+// without aggregation functions
+func hasGroupingKeys(aggregates [][]aggregateExpr) bool {
+	for _, x := range aggregates {
+		if len(x) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]Value) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -53,7 +53,7 @@ func (expr *powerOfExpression) Evaluate(ctx *EvalContext) (Value, error) {
 		Value: val.Get(),
 		Const: val.IsConst(),
 	}
-	for i := 1; 1 < len(expr.parts); i++ {
+	for i := 1; i < len(expr.parts); i++ {
 		val, err := expr.parts[i].Evaluate(ctx)
 		if err != nil {
 			return nil, err
@@ -307,12 +307,14 @@ func addstringstring(a string, b string, sub bool) (string, error) {
 	return a + b, nil
 }
 
+// adddatedur adds the months and days of the duration to the date. If
+// the day does not exist in the resulting month, the last day of the
+// month is used.
 func adddatedur(a Date, b Duration, sub bool) Date {
-	t := a.Time()
 	if sub {
-		return NewDate(time.Date(t.Year(), t.Month()-time.Month(b.Months), t.Day()-int(b.Days), 0, 0, 0, 0, t.Location()))
+		b = negateDuration(b)
 	}
-	return NewDate(time.Date(t.Year(), t.Month()+time.Month(b.Months), t.Day()+int(b.Days), 0, 0, 0, 0, t.Location()))
+	return NewDate(addMonths(a.Time(), int(b.Months)).AddDate(0, 0, int(b.Days)))
 }
 
 func addtimedur(a LocalTime, b Duration, sub bool) LocalTime {
@@ -323,12 +325,37 @@ func addtimedur(a LocalTime, b Duration, sub bool) LocalTime {
 	return NewLocalTime(time.Date(1970, 1, 1, t.Hour(), t.Minute(), t.Second()+int(b.Seconds), t.Nanosecond()+b.Nanos, t.Location()))
 }
 
+// adddatetimedur adds the months and days of the duration to the
+// calendar date, and then the seconds
 func adddatetimedur(a LocalDateTime, b Duration, sub bool) LocalDateTime {
+	if sub {
+		b = negateDuration(b)
+	}
+	t := addMonths(a.Time(), int(b.Months)).AddDate(0, 0, int(b.Days))
+	return NewLocalDateTime(t.Add(time.Duration(b.Seconds)*time.Second + time.Duration(b.Nanos)))
+}
+
+// addzoneddatetimedur adds the months and days of the duration to
+// the calendar date, and the seconds to the instant
+func addzoneddatetimedur(a DateTime, b Duration, sub bool) DateTime {
+	if sub {
+		b = negateDuration(b)
+	}
+	t := addMonths(a.Time(), int(b.Months)).AddDate(0, 0, int(b.Days))
+	return NewDateTime(t.Add(time.Duration(b.Seconds)*time.Second + time.Duration(b.Nanos)))
+}
+
+// negateDuration returns -d
+func negateDuration(d Duration) Duration {
+	return Duration{Months: -d.Months, Days: -d.Days, Seconds: -d.Seconds, Nanos: -d.Nanos}
+}
+
+func addzonedtimedur(a Time, b Duration, sub bool) Time {
 	t := a.Time()
 	if sub {
-		return NewLocalDateTime(time.Date(t.Year(), t.Month()-time.Month(b.Months), t.Day()-int(b.Days), t.Hour(), t.Minute(), t.Second()-int(b.Seconds), t.Nanosecond()-b.Nanos, t.Location()))
+		return NewTime(time.Date(1970, 1, 1, t.Hour(), t.Minute(), t.Second()-int(b.Seconds), t.Nanosecond()-b.Nanos, t.Location()))
 	}
-	return NewLocalDateTime(time.Date(t.Year(), t.Month()+time.Month(b.Months), t.Day()+int(b.Days), t.Hour(), t.Minute(), t.Second()+int(b.Seconds), t.Nanosecond()+b.Nanos, t.Location()))
+	return NewTime(time.Date(1970, 1, 1, t.Hour(), t.Minute(), t.Second()+int(b.Seconds), t.Nanosecond()+b.Nanos, t.Location()))
 }
 
 func adddurdate(a Duration, b Date, sub bool) (Date, error) {
@@ -352,6 +379,20 @@ func adddurdatetime(a Duration, b LocalDateTime, sub bool) (LocalDateTime, error
 	return adddatetimedur(b, a, false), nil
 }
 
+func adddurzoneddatetime(a Duration, b DateTime, sub bool) (DateTime, error) {
+	if sub {
+		return DateTime{}, ErrInvalidDateOperation
+	}
+	return addzoneddatetimedur(b, a, false), nil
+}
+
+func adddurzonedtime(a Duration, b Time, sub bool) (Time, error) {
+	if sub {
+		return Time{}, ErrInvalidDateOperation
+	}
+	return addzonedtimedur(b, a, false), nil
+}
+
 func adddurdur(a Duration, b Duration, sub bool) (Duration, error) {
 	if sub {
 		return Duration{Months: a.Months - b.Months, Days: a.Days - b.Days, Seconds: a.Seconds - b.Seconds, Nanos: a.Nanos - b.Nanos}, nil
@@ -374,6 +415,7 @@ func addlistlist(a, b []Value) RValue {
 		}
 		arr = append(arr, x)
 	}
+	ret.Value = arr
 	return ret
 }
 
@@ -407,6 +449,12 @@ func (expr *addOrSubtractExpression) Evaluate(ctx *EvalContext) (Value, error) {
 			ret.Const = false
 			return nil
 		}
+		if list, ok := operand.Get().([]Value); ok && !sub {
+			if _, ok := ret.Value.([]Value); !ok {
+				ret = addlistlist([]Value{RValue{Value: ret.Value, Const: ret.Const}}, list)
+				return nil
+			}
+		}
 		ret.Const = ret.Const && operand.IsConst()
 		var err error
 		switch retValue := ret.Value.(type) {
@@ -445,6 +493,10 @@ func (expr *addOrSubtractExpression) Evaluate(ctx *EvalContext) (Value, error) {
 				ret.Value, err = adddurtime(retValue, operandValue, sub)
 			case LocalDateTime:
 				ret.Value, err = adddurdatetime(retValue, operandValue, sub)
+			case DateTime:
+				ret.Value, err = adddurzoneddatetime(retValue, operandValue, sub)
+			case Time:
+				ret.Value, err = adddurzonedtime(retValue, operandValue, sub)
 			default:
 				err = ErrInvalidAdditiveOperation
 			}
@@ -469,6 +521,20 @@ func (expr *addOrSubtractExpression) Evaluate(ctx *EvalContext) (Value, error) {
 			default:
 				err = ErrInvalidAdditiveOperation
 			}
+		case DateTime:
+			switch operandValue := operand.Get().(type) {
+			case Duration:
+				ret.Value = addzoneddatetimedur(retValue, operandValue, sub)
+			default:
+				err = ErrInvalidAdditiveOperation
+			}
+		case Time:
+			switch operandValue := operand.Get().(type) {
+			case Duration:
+				ret.Value = addzonedtimedur(retValue, operandValue, sub)
+			default:
+				err = ErrInvalidAdditiveOperation
+			}
 		case []Value:
 			if sub {
 				return ErrInvalidAdditiveOperation
@@ -477,7 +543,7 @@ func (expr *addOrSubtractExpression) Evaluate(ctx *EvalContext) (Value, error) {
 			case []Value:
 				ret = addlistlist(retValue, operandValue)
 			default:
-				err = ErrInvalidAdditiveOperation
+				ret = addlistlist(retValue, []Value{RValue{Value: operandValue, Const: operand.IsConst()}})
 			}
 		}
 		return err
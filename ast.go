@@ -1,6 +1,7 @@
 package opencypher
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -174,6 +175,9 @@ type listRangeExpression struct {
 type stringOperatorExpression struct {
 	operator string
 	expr     Expression
+
+	// Compiled regular expression if expr is a string literal
+	constRegex *regexp.Regexp
 }
 
 type propertyOrLabelsExpression struct {
@@ -280,6 +284,9 @@ type PatternPart struct {
 	variable *variable
 	start    nodePattern
 	path     []patternChain
+	// shortest is set if the pattern part is shortestPath(...) or
+	// allShortestPaths(...)
+	shortest *shortestPath
 }
 
 type nodePattern struct {
@@ -336,10 +343,21 @@ type listComprehension struct {
 	expr   Expression
 }
 
+type reduceExpression struct {
+	accumulator variable
+	init        Expression
+	filter      filterExpression
+	expr        Expression
+}
+
 type functionInvocation struct {
 	name     []symbolicName
 	distinct bool
 	args     []Expression
+	// text is the text of the invocation. Aggregation results are
+	// looked up by text, so ORDER BY can refer to an aggregation in
+	// the projection.
+	text string
 
 	function       *Function
 	constArgs      []Evaluatable
@@ -575,7 +593,7 @@ func oC_Order(ctx *parser.OC_OrderContext) order {
 func oC_SortItem(ctx *parser.OC_SortItemContext) sortItem {
 	ret := sortItem{
 		expr: oC_Expression(ctx.OC_Expression().(*parser.OC_ExpressionContext)),
-		asc:  ctx.ASCENDING() != nil || ctx.ASC() != nil,
+		asc:  ctx.DESCENDING() == nil && ctx.DESC() == nil,
 	}
 	return ret
 }
@@ -781,23 +799,40 @@ func oC_ListOperatorExpression(ctx *parser.OC_ListOperatorExpressionContext) str
 			listIn: oC_PropertyOrLabelsExpression(x.(*parser.OC_PropertyOrLabelsExpressionContext)),
 		}
 	}
-	expr := ctx.AllOC_Expression()
-	if len(expr) == 1 {
+	// Find the expressions before and after '..', if there is a range
+	var first, second Expression
+	isRange := false
+	for i := 0; i < ctx.GetChildCount(); i++ {
+		switch ch := ctx.GetChild(i).(type) {
+		case antlr.TerminalNode:
+			if ch.GetText() == ".." {
+				isRange = true
+			}
+		case *parser.OC_ExpressionContext:
+			if isRange {
+				second = oC_Expression(ch)
+			} else {
+				first = oC_Expression(ch)
+			}
+		}
+	}
+	if !isRange {
 		return stringListNullOperatorExpressionPart{
-			listIndex: oC_Expression(expr[0].(*parser.OC_ExpressionContext)),
+			listIndex: first,
 		}
 	}
 	return stringListNullOperatorExpressionPart{
 		listRange: &listRangeExpression{
-			first:  oC_Expression(expr[0].(*parser.OC_ExpressionContext)),
-			second: oC_Expression(expr[1].(*parser.OC_ExpressionContext)),
+			first:  first,
+			second: second,
 		}}
 }
 
 func oC_StringOperatorExpression(ctx *parser.OC_StringOperatorExpressionContext) stringListNullOperatorExpressionPart {
+	expr := oC_PropertyOrLabelsExpression(ctx.OC_PropertyOrLabelsExpression().(*parser.OC_PropertyOrLabelsExpressionContext))
 	ret := stringListNullOperatorExpressionPart{
 		stringOp: &stringOperatorExpression{
-			expr: oC_PropertyOrLabelsExpression(ctx.OC_PropertyOrLabelsExpression().(*parser.OC_PropertyOrLabelsExpressionContext)),
+			expr: expr,
 		},
 	}
 	if ctx.STARTS() != nil {
@@ -809,6 +844,16 @@ func oC_StringOperatorExpression(ctx *parser.OC_StringOperatorExpressionContext)
 	if ctx.CONTAINS() != nil {
 		ret.stringOp.operator = "CONTAINS"
 	}
+	for i := 0; i < ctx.GetChildCount(); i++ {
+		if tok, ok := ctx.GetChild(i).(antlr.TerminalNode); ok && tok.GetText() == regexOperator {
+			ret.stringOp.operator = regexOperator
+		}
+	}
+	// Compile literal patterns once. Invalid patterns are reported
+	// when the expression is evaluated
+	if lit, ok := expr.atom.(stringLiteral); ok && ret.stringOp.operator == regexOperator && len(expr.propertyLookup) == 0 && expr.nodeLabels == nil {
+		ret.stringOp.constRegex, _ = compileRegex(string(lit))
+	}
 	return ret
 }
 
@@ -838,7 +883,7 @@ func oC_PartialComparisonExpression(ctx *parser.OC_PartialComparisonExpressionCo
 	for i := 0; i < ctx.GetChildCount(); i++ {
 		if tok, ok := ctx.GetChild(i).(antlr.TerminalNode); ok {
 			t := tok.GetText()
-			if t == "=" || t == "<>" || t == "<" || t == ">" || t == "<=" || t == "=>" {
+			if t == "=" || t == "<>" || t == "<" || t == ">" || t == "<=" || t == ">=" {
 				ret.op = t
 			}
 		}
@@ -893,17 +938,37 @@ func oC_Pattern(ctx *parser.OC_PatternContext) Pattern {
 }
 
 func oC_PatternPart(ctx *parser.OC_PatternPartContext) PatternPart {
-	ret := PatternPart{}
+	ret := oC_AnonymousPatternPart(ctx.OC_AnonymousPatternPart().(*parser.OC_AnonymousPatternPartContext))
 	if v := ctx.OC_Variable(); v != nil {
 		vr := oC_Variable(v.(*parser.OC_VariableContext))
 		ret.variable = &vr
 	}
-	ret.start, ret.path = oC_AnonymousPatternPart(ctx.OC_AnonymousPatternPart().(*parser.OC_AnonymousPatternPartContext))
 	return ret
 }
 
-func oC_AnonymousPatternPart(ctx *parser.OC_AnonymousPatternPartContext) (nodePattern, []patternChain) {
-	return oC_PatternElement(ctx.OC_PatternElement().(*parser.OC_PatternElementContext))
+func oC_AnonymousPatternPart(ctx *parser.OC_AnonymousPatternPartContext) PatternPart {
+	if sp := ctx.OC_ShortestPathPattern(); sp != nil {
+		shortest := oC_ShortestPathPattern(sp.(*parser.OC_ShortestPathPatternContext))
+		return PatternPart{
+			start:    shortest.pattern.start,
+			path:     shortest.pattern.chain,
+			shortest: &shortest,
+		}
+	}
+	ret := PatternPart{}
+	ret.start, ret.path = oC_PatternElement(ctx.OC_PatternElement().(*parser.OC_PatternElementContext))
+	return ret
+}
+
+func oC_ShortestPathPattern(ctx *parser.OC_ShortestPathPatternContext) shortestPath {
+	start, chain := oC_PatternElement(ctx.OC_PatternElement().(*parser.OC_PatternElementContext))
+	return shortestPath{
+		all: ctx.ALLSHORTESTPATHS() != nil,
+		pattern: relationshipsPattern{
+			start: start,
+			chain: chain,
+		},
+	}
 }
 
 func oC_PatternElement(ctx *parser.OC_PatternElementContext) (nodePattern, []patternChain) {
@@ -1152,11 +1217,51 @@ func oC_Atom(ctx *parser.OC_AtomContext) atom {
 		return oC_Expression(p.(*parser.OC_ParenthesizedExpressionContext).OC_Expression().(*parser.OC_ExpressionContext))
 	}
 	if f := ctx.OC_FunctionInvocation(); f != nil {
-		return oC_FunctionInvocation(f.(*parser.OC_FunctionInvocationContext))
+		fn := oC_FunctionInvocation(f.(*parser.OC_FunctionInvocationContext))
+		if sp, ok := asShortestPath(fn); ok {
+			return sp
+		}
+		if rp, ok := asExistsPattern(fn); ok {
+			return rp
+		}
+		return fn
+	}
+	if mp := ctx.OC_MapProjection(); mp != nil {
+		return oC_MapProjection(mp.(*parser.OC_MapProjectionContext))
+	}
+	if r := ctx.OC_Reduce(); r != nil {
+		return oC_Reduce(r.(*parser.OC_ReduceContext))
 	}
 	return oC_Variable(ctx.OC_Variable().(*parser.OC_VariableContext))
 }
 
+func oC_MapProjection(ctx *parser.OC_MapProjectionContext) mapProjection {
+	ret := mapProjection{
+		variable: oC_Variable(ctx.OC_Variable().(*parser.OC_VariableContext)),
+	}
+	for _, x := range ctx.AllOC_MapProjectionItem() {
+		ret.items = append(ret.items, oC_MapProjectionItem(x.(*parser.OC_MapProjectionItemContext)))
+	}
+	return ret
+}
+
+func oC_MapProjectionItem(ctx *parser.OC_MapProjectionItemContext) mapProjectionItem {
+	if p := ctx.OC_PropertyLookup(); p != nil {
+		return mapProjectionItem{property: oC_PropertyLookup(p.(*parser.OC_PropertyLookupContext)).String()}
+	}
+	if k := ctx.OC_PropertyKeyName(); k != nil {
+		return mapProjectionItem{
+			key:   oC_PropertyKeyName(k.(*parser.OC_PropertyKeyNameContext)).String(),
+			value: oC_Expression(ctx.OC_Expression().(*parser.OC_ExpressionContext)),
+		}
+	}
+	if v := ctx.OC_Variable(); v != nil {
+		x := oC_Variable(v.(*parser.OC_VariableContext))
+		return mapProjectionItem{key: string(x), value: x}
+	}
+	return mapProjectionItem{property: "*"}
+}
+
 func oC_FilterExpression(ctx *parser.OC_FilterExpressionContext) filterExpression {
 	idInColl := ctx.OC_IdInColl().(*parser.OC_IdInCollContext)
 	ret := filterExpression{
@@ -1170,6 +1275,20 @@ func oC_FilterExpression(ctx *parser.OC_FilterExpressionContext) filterExpressio
 	return ret
 }
 
+func oC_Reduce(ctx *parser.OC_ReduceContext) reduceExpression {
+	idInColl := ctx.OC_IdInColl().(*parser.OC_IdInCollContext)
+	expr := ctx.AllOC_Expression()
+	return reduceExpression{
+		accumulator: oC_Variable(ctx.OC_Variable().(*parser.OC_VariableContext)),
+		init:        oC_Expression(expr[0].(*parser.OC_ExpressionContext)),
+		filter: filterExpression{
+			variable: oC_Variable(idInColl.OC_Variable().(*parser.OC_VariableContext)),
+			inExpr:   oC_Expression(idInColl.OC_Expression().(*parser.OC_ExpressionContext)),
+		},
+		expr: oC_Expression(expr[1].(*parser.OC_ExpressionContext)),
+	}
+}
+
 //oC_RelationshipsPattern   :  oC_NodePattern ( SP? oC_PatternElementChain )+ ;
 // oC_PatternElementChain :  oC_RelationshipPattern SP? oC_NodePattern ;
 func oC_RelationshipsPattern(ctx *parser.OC_RelationshipsPatternContext) relationshipsPattern {
@@ -1196,6 +1315,7 @@ func oC_FunctionInvocation(ctx *parser.OC_FunctionInvocationContext) *functionIn
 	ret := &functionInvocation{
 		name:     oC_FunctionName(ctx.OC_FunctionName().(*parser.OC_FunctionNameContext)),
 		distinct: ctx.DISTINCT() != nil,
+		text:     textWithoutSpace(ctx),
 	}
 	for _, x := range ctx.AllOC_Expression() {
 		ret.args = append(ret.args, oC_Expression(x.(*parser.OC_ExpressionContext)))
@@ -1203,6 +1323,22 @@ func oC_FunctionInvocation(ctx *parser.OC_FunctionInvocationContext) *functionIn
 	return ret
 }
 
+// textWithoutSpace returns the text of the parse tree without the
+// whitespace
+func textWithoutSpace(tree antlr.Tree) string {
+	if term, ok := tree.(antlr.TerminalNode); ok {
+		if term.GetSymbol().GetTokenType() == parser.CypherParserSP {
+			return ""
+		}
+		return term.GetText()
+	}
+	var bld strings.Builder
+	for _, ch := range tree.GetChildren() {
+		bld.WriteString(textWithoutSpace(ch))
+	}
+	return bld.String()
+}
+
 func oC_FunctionName(ctx *parser.OC_FunctionNameContext) []symbolicName {
 	if ctx.EXISTS() != nil {
 		return []symbolicName{"EXISTS"}
@@ -1421,10 +1557,78 @@ func oC_Merge(ctx *parser.OC_MergeContext) UpdatingClause {
 	return ret
 }
 
+type procedureCall struct {
+	name []symbolicName
+	args []Expression
+	// If true, the procedure is called without an argument list, and
+	// the arguments are passed using parameters
+	implicit bool
+	// Empty if there is no YIELD, or if it is YIELD *
+	yieldItems []yieldItem
+	where      Expression
+}
+
+type yieldItem struct {
+	field    string
+	variable variable
+}
+
+// oC_InQueryCall :  CALL SP oC_ExplicitProcedureInvocation ( SP? YIELD SP oC_YieldItems )? ;
 func oC_InQueryCall(ctx *parser.OC_InQueryCallContext) ReadingClause {
-	panic("Unsupported: inQueryCall")
+	ret := oC_ExplicitProcedureInvocation(ctx.OC_ExplicitProcedureInvocation().(*parser.OC_ExplicitProcedureInvocationContext))
+	if x := ctx.OC_YieldItems(); x != nil {
+		oC_YieldItems(&ret, x.(*parser.OC_YieldItemsContext))
+	}
+	return ret
 }
 
+// oC_StandaloneCall :  CALL SP ( oC_ExplicitProcedureInvocation | oC_ImplicitProcedureInvocation ) ( SP YIELD SP oC_YieldItems )? ;
 func oC_StandaloneCall(ctx *parser.OC_StandaloneCallContext) Evaluatable {
-	panic("Unsupported: standaloneCall")
+	var ret procedureCall
+	if x := ctx.OC_ExplicitProcedureInvocation(); x != nil {
+		ret = oC_ExplicitProcedureInvocation(x.(*parser.OC_ExplicitProcedureInvocationContext))
+	} else {
+		ret = procedureCall{
+			name:     oC_ProcedureName(ctx.OC_ImplicitProcedureInvocation().(*parser.OC_ImplicitProcedureInvocationContext).OC_ProcedureName().(*parser.OC_ProcedureNameContext)),
+			implicit: true,
+		}
+	}
+	if x := ctx.OC_YieldItems(); x != nil {
+		oC_YieldItems(&ret, x.(*parser.OC_YieldItemsContext))
+	}
+	return standaloneCall{ret}
+}
+
+func oC_ExplicitProcedureInvocation(ctx *parser.OC_ExplicitProcedureInvocationContext) procedureCall {
+	ret := procedureCall{
+		name: oC_ProcedureName(ctx.OC_ProcedureName().(*parser.OC_ProcedureNameContext)),
+	}
+	for _, x := range ctx.AllOC_Expression() {
+		ret.args = append(ret.args, oC_Expression(x.(*parser.OC_ExpressionContext)))
+	}
+	return ret
+}
+
+func oC_ProcedureName(ctx *parser.OC_ProcedureNameContext) []symbolicName {
+	ns := oC_Namespace(ctx.OC_Namespace().(*parser.OC_NamespaceContext))
+	return append(ns, oC_SymbolicName(ctx.OC_SymbolicName().(*parser.OC_SymbolicNameContext)))
+}
+
+// oC_YieldItems :  ( '*' | ( oC_YieldItem ( SP? ',' SP? oC_YieldItem )* ) ) ( SP? oC_Where )? ;
+func oC_YieldItems(call *procedureCall, ctx *parser.OC_YieldItemsContext) {
+	for _, x := range ctx.AllOC_YieldItem() {
+		item := x.(*parser.OC_YieldItemContext)
+		yi := yieldItem{
+			variable: oC_Variable(item.OC_Variable().(*parser.OC_VariableContext)),
+		}
+		if f := item.OC_ProcedureResultField(); f != nil {
+			yi.field = string(oC_SymbolicName(f.(*parser.OC_ProcedureResultFieldContext).OC_SymbolicName().(*parser.OC_SymbolicNameContext)))
+		} else {
+			yi.field = string(yi.variable)
+		}
+		call.yieldItems = append(call.yieldItems, yi)
+	}
+	if w := ctx.OC_Where(); w != nil {
+		call.where = oC_Where(w.(*parser.OC_WhereContext))
+	}
 }
@@ -1,6 +1,8 @@
 package opencypher
 
 import (
+	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/cloudprivacylabs/lpg/v2"
@@ -146,3 +148,468 @@ func TestQueryChain(t *testing.T) {
 		t.Errorf("Wrong b")
 	}
 }
+
+func TestSortItem(t *testing.T) {
+	for input, asc := range map[string]bool{
+		`x`:            true,
+		`x ASC`:        true,
+		`x ASCENDING`:  true,
+		`x DESC`:       false,
+		`x DESCENDING`: false,
+	} {
+		c := GetParser(input).OC_SortItem()
+		item := oC_SortItem(c.(*parser.OC_SortItemContext))
+		if item.asc != asc {
+			t.Errorf("%s: Expecting asc=%v", input, asc)
+		}
+	}
+}
+
+func TestCaseNullCondition(t *testing.T) {
+	v, err := ParseAndEvaluate(`return case when null then 1 when true then 2 end as x`, NewEvalContext(lpg.NewGraph()))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if x := v.Get().(ResultSet).Rows[0]["x"].Get(); x != 2 {
+		t.Errorf("Expecting 2, got %v", x)
+	}
+}
+
+func TestRegexMatch(t *testing.T) {
+	g := lpg.NewGraph()
+	g.NewNode([]string{"Record"}, map[string]interface{}{"value": "123-45-6789"})
+	g.NewNode([]string{"Record"}, map[string]interface{}{"value": "john@example.com"})
+	g.NewNode([]string{"Record"}, map[string]interface{}{"value": "x=~y"})
+	g.NewNode([]string{"Record"}, map[string]interface{}{})
+
+	rs := runTestMatch(t, `MATCH (n:Record) WHERE n.value =~ '[0-9]{3}-[0-9]{2}-[0-9]{4}' RETURN n.value AS v`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["v"].Get() != "123-45-6789" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	// Whole string must match, flags are supported
+	rs = runTestMatch(t, `MATCH (n:Record) WHERE n.value=~'(?i)JOHN@.*' OR n.value =~ 'example' RETURN n.value AS v`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["v"].Get() != "john@example.com" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	// =~ inside strings and comments is not an operator
+	rs = runTestMatch(t, `MATCH (n:Record) /* =~ */ WHERE n.value = 'x=~y' RETURN n.value =~ "x=~.*" AS v`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["v"].Get() != true {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	// Null propagates
+	rs = runTestMatch(t, `MATCH (n:Record) RETURN n.value =~ '.*' AS v, n.value =~ null AS w`, g)
+	nulls := 0
+	for _, row := range rs.Rows {
+		if row["w"].Get() != nil {
+			t.Errorf("Wrong result: %v", rs)
+		}
+		if row["v"].Get() == nil {
+			nulls++
+		}
+	}
+	if nulls != 1 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	ctx := NewEvalContext(g)
+	ctx.SetParameter("$re", ValueOf(".*@.*"))
+	v, err := ParseAndEvaluate(`MATCH (n:Record) WHERE n.value =~ $re RETURN n`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs := v.Get().(ResultSet); len(rs.Rows) != 1 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	if _, err := ParseAndEvaluate(`RETURN 'a' =~ '('`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error")
+	}
+
+	// Literal patterns are compiled when parsed, so a parsed query can
+	// be evaluated concurrently
+	ev, err := Parse(`MATCH (n:Record) WHERE n.value =~ '.*@.*' RETURN n`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := ev.Evaluate(NewEvalContext(g))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if rs := v.Get().(ResultSet); len(rs.Rows) != 1 {
+				t.Errorf("Wrong result: %v", rs)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// evalExprs evaluates each expression using RETURN, and compares the
+// result with the expected value
+func evalExprs(t *testing.T, tests map[string]interface{}) {
+	for expr, expected := range tests {
+		v, err := ParseAndEvaluate("RETURN "+expr+" AS x", NewEvalContext(lpg.NewGraph()))
+		if err != nil {
+			t.Errorf("%s: %s", expr, err)
+			continue
+		}
+		got := v.Get().(ResultSet).Rows[0]["x"].Get()
+		if arr, ok := got.([]Value); ok {
+			list := make([]interface{}, 0, len(arr))
+			for _, x := range arr {
+				list = append(list, x.Get())
+			}
+			got = list
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v (%T), got %v (%T)", expr, expected, expected, got, got)
+		}
+	}
+}
+
+func TestStringFunctions(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`toUpper('über')`:             "ÜBER",
+		`toLower('ÇA Va')`:            "ça va",
+		`toLower(null)`:               nil,
+		`replace('a-b-c', '-', '+')`:  "a+b+c",
+		`replace('abc', null, 'x')`:   nil,
+		`reverse('añb')`:              "bña",
+		`reverse([1, 2, 3])`:          []interface{}{3, 2, 1},
+		`toString(12)`:                "12",
+		`toString(1.0)`:               "1.0",
+		`toString(2.5)`:               "2.5",
+		`toString(true)`:              "true",
+		`toStringOrNull([1])`:         nil,
+		`toBoolean('TRUE')`:           true,
+		`toBoolean('no')`:             nil,
+		`toBoolean(0)`:                false,
+		`lTrim('  a ')`:               "a ",
+		`rTrim('  a ')`:               "  a",
+		`size('héllo')`:               5,
+		`substring('héllo', 1, 3)`:    "éll",
+		`left('héllo', 2)`:            "hé",
+		`right('héllo', 3)`:           "llo",
+		`right('héllo', 10)`:          "héllo",
+		`normalize('é') = 'é'`:       true,
+		`size(normalize('é', 'NFD'))`: 2,
+		`normalize('ﬁ', 'NFKC')`:      "fi",
+	})
+	if _, err := ParseAndEvaluate(`RETURN toString([1])`, NewEvalContext(lpg.NewGraph())); err == nil {
+		t.Errorf("Expecting error")
+	}
+}
+
+func TestMathFunctions(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`abs(-3)`:                    3,
+		`abs(-2.5)`:                  2.5,
+		`abs(null)`:                  nil,
+		`ceil(1.2)`:                  2.0,
+		`floor(-1.2)`:                -2.0,
+		`ceil(3)`:                    3.0,
+		`round(2.5)`:                 3.0,
+		`round(-2.5)`:                -2.0,
+		`round(3.14159, 2)`:          3.14,
+		`round(1.005, 2)`:            1.01,
+		`round(-1.5, 0)`:             -2.0,
+		`round(1250, -2)`:            1300.0,
+		`round(2.5, 0, 'HALF_EVEN')`: 2.0,
+		`round(3.5, 0, 'half_even')`: 4.0,
+		`round(2.5, 0, 'HALF_DOWN')`: 2.0,
+		`round(1.21, 1, 'UP')`:       1.3,
+		`round(-1.21, 1, 'CEILING')`: -1.2,
+		`round(-1.21, 1, 'FLOOR')`:   -1.3,
+		`round(1.29, 1, 'DOWN')`:     1.2,
+		`round(null, 1)`:             nil,
+		`sign(-4)`:                   -1,
+		`sign(0.5)`:                  1,
+		`sign(0)`:                    0,
+		`sqrt(16)`:                   4.0,
+		`exp(0)`:                     1.0,
+		`log(e())`:                   1.0,
+		`log10(1000)`:                3.0,
+		`pi() = 3.141592653589793`:   true,
+		`sin(0)`:                     0.0,
+		`cos(0)`:                     1.0,
+		`atan2(1, 1) * 4 = pi()`:     true,
+		`degrees(pi())`:              180.0,
+		`radians(180) = pi()`:        true,
+		`haversin(0)`:                0.0,
+		`isNaN(sqrt(-1))`:            true,
+		`isNaN(1)`:                   false,
+		`rand() >= 0 AND rand() < 1`: true,
+	})
+	for _, expr := range []string{`abs('a')`, `round(1.5, 0, 'NEAREST')`, `sqrt('x')`} {
+		if _, err := ParseAndEvaluate("RETURN "+expr, NewEvalContext(lpg.NewGraph())); err == nil {
+			t.Errorf("%s: Expecting error", expr)
+		}
+	}
+}
+
+func TestListFunctions(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`head([1, 2, 3])`:            1,
+		`head([])`:                   nil,
+		`last([1, 2, 3])`:            3,
+		`tail([1, 2, 3])`:            []interface{}{2, 3},
+		`tail([])`:                   []interface{}{},
+		`tail(null)`:                 nil,
+		`keys({b: 1, a: 2})`:         []interface{}{"a", "b"},
+		`properties({a: 1}).a`:       1,
+		`coalesce(null, null, 3, 4)`: 3,
+		`coalesce(null)`:             nil,
+		`2 IN [1, 2]`:                true,
+		`3 IN [1, 2]`:                false,
+		`3 IN [1, null]`:             nil,
+		`3 IN null`:                  nil,
+		`[1] + 2`:                    []interface{}{1, 2},
+		`0 + [1]`:                    []interface{}{0, 1},
+		`[1] + [2, 3]`:               []interface{}{1, 2, 3},
+		`2 ^ 3`:                      8.0,
+		`reduce(acc = [], x IN [[1, 2], [3]] | acc + x)`: []interface{}{1, 2, 3},
+		`range(0, 3)`:                                       []interface{}{0, 1, 2, 3},
+		`range(6, 0, -3)`:                                   []interface{}{6, 3, 0},
+		`range(3, 0)`:                                       []interface{}{},
+		`[1, 2, 3, 4][1..3]`:                                []interface{}{2, 3},
+		`[1, 2, 3, 4][1..]`:                                 []interface{}{2, 3, 4},
+		`[1, 2, 3, 4][..2]`:                                 []interface{}{1, 2},
+		`[1, 2, 3, 4][-2..]`:                                []interface{}{3, 4},
+		`[1, 2, 3, 4][..-1]`:                                []interface{}{1, 2, 3},
+		`[1, 2, 3, 4][2..10]`:                               []interface{}{3, 4},
+		`[1, 2, 3, 4][3..1]`:                                []interface{}{},
+		`[1, 2, 3, 4][null..2]`:                             nil,
+		`reduce(acc = 0, x IN [1, 2, 3] | acc + x)`:         6,
+		`REDUCE(s = '', x IN ['a', 'b'] | s + x + '|')`:     "a|b|",
+		`reduce(acc = 1, x IN null | acc * x)`:              nil,
+		`reduce (total = 10, n IN range(1, 3) | total - n)`: 4,
+		`reduce(acc = 0, x IN [1, 2] | acc + reduce(y = 0, z IN [x, x] | y + z))`: 6,
+		`coll.toSet([1, 2, 1, 'a', 'a'])`:                                         []interface{}{1, 2, "a"},
+		`coll.setUnion([1, 2], [2, 3])`:                                           []interface{}{1, 2, 3},
+		`coll.setIntersection([1, 2, 2, 3], [3, 2, 5])`:                           []interface{}{2, 3},
+		`coll.setUnion([1], null)`:                                                nil,
+		`coll.toSet([1, 1.0, 2.5, 2.5])`:                                          []interface{}{1, 2.5},
+		`coll.setIntersection([1.0, 2], [2.0, 3])`:                                []interface{}{2},
+	})
+	if _, err := ParseAndEvaluate(`RETURN range(0, 3, 0) AS r`, NewEvalContext(lpg.NewGraph())); err == nil {
+		t.Errorf("Expecting error for range with step 0")
+	}
+}
+
+func TestTemporalValues(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`toString(datetime('2015-07-21T21:40:32.142+01:00'))`:                                                                "2015-07-21T21:40:32.142+01:00",
+		`toString(datetime('2015-07-21T21:40:32[Europe/London]'))`:                                                           "2015-07-21T21:40:32+01:00[Europe/London]",
+		`toString(datetime('2015-01-21T21:40Z'))`:                                                                            "2015-01-21T21:40:00Z",
+		`toString(datetime('2015-07-21'))`:                                                                                   "2015-07-21T00:00:00Z",
+		`toString(datetime({year: 2020, month: 3, day: 8, hour: 12, timezone: 'America/New_York'}))`:                         "2020-03-08T12:00:00-04:00[America/New_York]",
+		`toString(datetime({year: 2020, month: 1, day: 2, hour: 3, minute: 4, second: 5, millisecond: 6, timezone: 'EST'}))`: "2020-01-02T03:04:05.006-05:00[EST]",
+		`toString(datetime({epochSeconds: 1000000000}))`:                                                                     "2001-09-09T01:46:40Z",
+		`toString(datetime({epochMillis: 1000000000123, timezone: '+02:00'}))`:                                               "2001-09-09T03:46:40.123+02:00",
+		`toString(datetime.fromepoch(1000000000, 5))`:                                                                        "2001-09-09T01:46:40.000000005Z",
+		`toString(datetime.fromepochmillis(1000000000123))`:                                                                  "2001-09-09T01:46:40.123Z",
+		`toString(localdatetime('2015-07-21T21:40:32'))`:                                                                     "2015-07-21T21:40:32",
+		`toString(localdatetime({year: 2015, month: 7, day: 21, hour: 1}))`:                                                  "2015-07-21T01:00:00",
+		`toString(time('21:40:32.5+01:00'))`:                                                                                 "21:40:32.5+01:00",
+		`toString(time({hour: 10, minute: 30, timezone: '-0300'}))`:                                                          "10:30:00-03:00",
+		`toString(localtime('21:40'))`:                                                                                       "21:40:00",
+		`toString(localtime({hour: 21, minute: 40, second: 32}))`:                                                            "21:40:32",
+		`datetime(null)`:     nil,
+		`time({hour: null})`: nil,
+		`datetime('2015-07-21T21:40:32+01:00') = datetime('2015-07-21T20:40:32Z')`: true,
+		`datetime('2015-07-21T21:40:32+01:00') < datetime('2015-07-21T21:00:00Z')`: true,
+		`time('10:00+01:00') = time('09:00Z')`:                                     true,
+		`time('10:00+01:00') > time('09:30Z')`:                                     false,
+	})
+	ctx := NewEvalContext(lpg.NewGraph())
+	ctx.SetParameter("$second", ValueOf(Duration{Seconds: 1}))
+	ctx.SetParameter("$hour", ValueOf(Duration{Seconds: 3600}))
+	ctx.SetParameter("$dayAndHours", ValueOf(Duration{Days: 1, Seconds: 7200}))
+	ctx.SetParameter("$month", ValueOf(Duration{Months: 1}))
+	ctx.SetParameter("$days", ValueOf(Duration{Days: 15}))
+	for expr, expected := range map[string]interface{}{
+		`localtime('10:00') < localtime('10:00') + $second`:             true,
+		`toString(datetime('2015-01-31T10:00Z') + $dayAndHours)`:        "2015-02-01T12:00:00Z",
+		`toString(datetime('2015-03-29T00:30[Europe/London]') + $hour)`: "2015-03-29T02:30:00+01:00[Europe/London]",
+		`toString($month + datetime('2015-01-15T10:00Z'))`:              "2015-02-15T10:00:00Z",
+		`toString(datetime('2015-01-15T10:00Z') - $days)`:               "2014-12-31T10:00:00Z",
+		`toString(time('23:30Z') + $hour)`:                              "00:30:00Z",
+		`toString(localdatetime('2015-01-15T23:30') + $hour)`:           "2015-01-16T00:30:00",
+		`toString(datetime('2015-01-31T10:00Z') + $month)`:              "2015-02-28T10:00:00Z",
+		`toString(localdatetime('2016-03-31T10:00') - $month)`:          "2016-02-29T10:00:00",
+		`toString($month + date('2015-01-31'))`:                         "2015-02-28",
+	} {
+		v, err := ParseAndEvaluate("RETURN "+expr+" AS x", ctx)
+		if err != nil {
+			t.Errorf("%s: %s", expr, err)
+			continue
+		}
+		if got := v.Get().(ResultSet).Rows[0]["x"].Get(); got != expected {
+			t.Errorf("%s: expected %v, got %v", expr, expected, got)
+		}
+	}
+	for _, expr := range []string{
+		`datetime('2015-13-01')`,
+		`datetime('2015-01-01T25:00')`,
+		`datetime({year: 2015, timezone: 'Nowhere/Nothing'})`,
+		`time({minute: 1})`,
+		`datetime(1)`,
+		`datetime({year: 2020, month: 2, day: 30})`,
+		`localdatetime({year: 2021, month: 2, day: 29})`,
+		`datetime({year: 2020, month: 13})`,
+		`datetime({year: 2020, hour: 25})`,
+		`localtime({hour: 1, minute: 60})`,
+		`time({hour: 1, second: -1})`,
+		`datetime({year: 2020, millisecond: 999, microsecond: 999999})`,
+	} {
+		if _, err := ParseAndEvaluate("RETURN "+expr+" AS x", NewEvalContext(lpg.NewGraph())); err == nil {
+			t.Errorf("%s: expecting error", expr)
+		}
+	}
+}
+
+func TestDurationFunctions(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`duration('P1Y2M3DT4H5M6S') = duration({years: 1, months: 2, days: 3, hours: 4, minutes: 5, seconds: 6})`: true,
+		`toString(duration('P1Y2M3DT4H5M6.5S'))`:                                                   "P14M3DT14706.500000000S",
+		`toString(duration('P2W'))`:                                                                "P0M14DT0S",
+		`toString(duration('-P1DT1H'))`:                                                            "P0M-1DT-3600S",
+		`toString(duration('P0.5D'))`:                                                              "P0M0DT43200S",
+		`toString(duration('PT1.5M'))`:                                                             "P0M0DT90S",
+		`toString(duration('P2012-02-02T14:37:21.545'))`:                                           "P24146M2DT52641.545000000S",
+		`toString(duration({weeks: 1, days: 1.5, milliseconds: 10}))`:                              "P0M8DT43200.010000000S",
+		`toString(duration({quarters: 1, seconds: -1.5}))`:                                         "P3M0DT-1.500000000S",
+		`duration({days: null})`:                                                                   nil,
+		`duration(null)`:                                                                           nil,
+		`duration('P90D') < duration('P3M')`:                                                       nil,
+		`duration('P1M') >= duration('P29D')`:                                                      nil,
+		`duration('P1D') = duration('PT24H')`:                                                      false,
+		`toString(date('2015-01-31') + duration('P1M'))`:                                           "2015-02-28",
+		`toString(datetime('2015-07-21T10:00Z') - duration('P90D'))`:                               "2015-04-22T10:00:00Z",
+		`toString(duration.between(date('1984-10-11'), date('2015-06-24')))`:                       "P368M13DT0S",
+		`toString(duration.between(date('2015-06-24'), date('1984-10-11')))`:                       "P-368M-13DT0S",
+		`toString(duration.between(datetime('2015-01-31T10:00Z'), datetime('2015-03-01T09:00Z')))`: "P1M0DT82800S",
+		`toString(duration.between(localdatetime('2015-07-21T21:40:32'), localdatetime('2015-07-21T21:40:31.5')))`:               "P0M0DT-0.500000000S",
+		`toString(duration.between(datetime('2015-07-21T10:00+02:00'), datetime('2015-07-21T10:00Z')))`:                          "P0M0DT7200S",
+		`toString(duration.between(localtime('10:00'), localtime('12:30:15')))`:                                                  "P0M0DT9015S",
+		`toString(duration.between(time('10:00+01:00'), time('10:00Z')))`:                                                        "P0M0DT3600S",
+		`toString(duration.inMonths(date('1984-10-11'), date('2015-06-24')))`:                                                    "P368M0DT0S",
+		`toString(duration.inDays(date('2015-01-01'), date('2015-03-01')))`:                                                      "P0M59DT0S",
+		`toString(duration.inDays(datetime('2015-01-01T10:00Z'), datetime('2015-01-03T09:00Z')))`:                                "P0M1DT0S",
+		`toString(duration.inSeconds(datetime('2015-03-29T00:00[Europe/London]'), datetime('2015-03-29T03:00[Europe/London]')))`: "P0M0DT7200S",
+		`toString(duration.inSeconds(date('2015-01-01'), datetime('2015-01-01T01:00+01:00')))`:                                   "P0M0DT3600S",
+		`duration.between(null, date())`: nil,
+		`datetime('2015-01-01T00:00Z') < datetime('2015-07-21T00:00Z') - duration('P90D')`:                   true,
+		`toString(date.truncate('month', date('2017-11-11')))`:                                               "2017-11-01",
+		`toString(date.truncate('millennium', date('2017-11-11')))`:                                          "2000-01-01",
+		`toString(date.truncate('decade', date('2017-11-11')))`:                                              "2010-01-01",
+		`toString(date.truncate('quarter', date('2017-11-11')))`:                                             "2017-10-01",
+		`toString(date.truncate('week', date('2017-11-11')))`:                                                "2017-11-06",
+		`toString(date.truncate('weekYear', date('2017-11-11')))`:                                            "2017-01-02",
+		`toString(date.truncate('year', datetime('2017-11-11T12:00Z'), {day: 5}))`:                           "2017-01-05",
+		`toString(datetime.truncate('hour', datetime('2017-11-11T12:31:14.645876123+01:00')))`:               "2017-11-11T12:00:00+01:00",
+		`toString(datetime.truncate('millisecond', datetime('2017-11-11T12:31:14.645876123Z')))`:             "2017-11-11T12:31:14.645Z",
+		`toString(datetime.truncate('day', localdatetime('2017-11-11T12:31:14'), {hour: 3, nanosecond: 7}))`: "2017-11-11T03:00:00.000000007Z",
+		`toString(datetime.truncate('month', date('2017-11-11')))`:                                           "2017-11-01T00:00:00Z",
+		`date.truncate('month', null)`:                                                                       nil,
+	})
+	for _, expr := range []string{`duration('P1H')`, `duration('PT1D')`, `duration('P1M1Y')`, `duration('1D')`, `duration('P')`, `duration({eons: 1})`, `date.truncate('hour', date())`, `datetime.truncate('fortnight', datetime())`, `date.truncate('day', localtime())`, `duration.between(1, date())`} {
+		if _, err := ParseAndEvaluate("RETURN "+expr+" AS x", NewEvalContext(lpg.NewGraph())); err == nil {
+			t.Errorf("%s: expecting error", expr)
+		}
+	}
+}
+
+func TestTemporalComponents(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`date('2017-11-11').year`:                                                             2017,
+		`date('2017-11-11').month`:                                                            11,
+		`date('2017-11-11').quarter`:                                                          4,
+		`date('2017-11-11').dayOfQuarter`:                                                     42,
+		`date('2017-11-11').ordinalDay`:                                                       315,
+		`date('2017-11-11').week`:                                                             45,
+		`date('2018-01-01').weekYear`:                                                         2018,
+		`date('2017-01-01').weekYear`:                                                         2016,
+		`date('2017-11-11').dayOfWeek`:                                                        6,
+		`date('2017-11-12').dayOfWeek`:                                                        7,
+		`localdatetime('2017-11-11T12:31:14.645876123').hour`:                                 12,
+		`localdatetime('2017-11-11T12:31:14.645876123').millisecond`:                          645,
+		`localdatetime('2017-11-11T12:31:14.645876123').microsecond`:                          645876,
+		`localdatetime('2017-11-11T12:31:14.645876123').nanosecond`:                           645876123,
+		`localtime('12:31:14').minute`:                                                        31,
+		`localtime('12:31:14').second`:                                                        14,
+		`datetime('2017-11-11T12:31:14+01:00').offset`:                                        "+01:00",
+		`datetime('2017-11-11T12:31:14+01:00').offsetMinutes`:                                 60,
+		`datetime('2017-11-11T12:31:14[Europe/Paris]').timezone`:                              "Europe/Paris",
+		`datetime('2017-11-11T12:31:14Z').timezone`:                                           "Z",
+		`time('12:31:14-05:00').timezone`:                                                     "-05:00",
+		`datetime('2001-09-09T01:46:40.123Z').epochMillis`:                                    1000000000123,
+		`datetime('2001-09-09T02:46:40+01:00').epochSeconds`:                                  1000000000,
+		`localdatetime('2001-09-09T01:46:40').epochSeconds`:                                   1000000000,
+		`datetime('2017-11-11T12:31:14Z')['day']`:                                             11,
+		`duration('P1Y5M3DT4H5M6.5S').years`:                                                  1,
+		`duration('P1Y5M3DT4H5M6.5S').months`:                                                 17,
+		`duration('P1Y5M3DT4H5M6.5S').monthsOfYear`:                                           5,
+		`duration('P1Y5M3DT4H5M6.5S').quartersOfYear`:                                         1,
+		`duration('P1Y5M3DT4H5M6.5S').hours`:                                                  4,
+		`duration('P1Y5M3DT4H5M6.5S').minutesOfHour`:                                          5,
+		`duration('P1Y5M3DT4H5M6.5S').secondsOfMinute`:                                        6,
+		`duration('P1Y5M3DT4H5M6.5S').milliseconds`:                                           14706500,
+		`duration('P1Y5M3DT4H5M6.5S').millisecondsOfSecond`:                                   500,
+		`duration('P17D').weeks`:                                                              2,
+		`duration('P17D').daysOfWeek`:                                                         3,
+		`formatDate(date('2017-11-11'), 'MMMM Do YYYY, dddd')`:                                "November 11th 2017, Saturday",
+		`formatDate(datetime('2017-11-11T08:05:00-05:00'), 'HH:mm Z')`:                        "08:05 -05:00",
+		`formatDate(null, 'YYYY')`:                                                            nil,
+		`toString(parseDateTime('11/05/2017 13:10', 'MM/DD/YYYY HH:mm'))`:                     "2017-11-05T13:10:00Z",
+		`toString(parseDateTime('11/05/2017 13:10', 'MM/DD/YYYY HH:mm', 'America/New_York'))`: "2017-11-05T13:10:00-05:00[America/New_York]",
+		`toString(parseDateTime('2017-11-05 13:10 +02:00', 'YYYY-MM-DD HH:mm Z'))`:            "2017-11-05T13:10:00+02:00",
+	})
+	for _, expr := range []string{`date('2017-11-11').hour`, `localtime('10:00').year`, `localdatetime().offset`, `date().epochMillis`, `duration('P1D').year`, `formatDate(1, 'YYYY')`} {
+		if _, err := ParseAndEvaluate("RETURN "+expr+" AS x", NewEvalContext(lpg.NewGraph())); err == nil {
+			t.Errorf("%s: expecting error", expr)
+		}
+	}
+}
+
+func TestPoints(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`toString(point({x: 3, y: 4}))`:                                        "point({x: 3, y: 4, crs: 'cartesian'})",
+		`toString(point({x: 3, y: 4, z: 5.5}))`:                                "point({x: 3, y: 4, z: 5.5, crs: 'cartesian-3d'})",
+		`toString(point({longitude: 12.5, latitude: 56.7}))`:                   "point({x: 12.5, y: 56.7, crs: 'wgs-84'})",
+		`toString(point({x: 12.5, y: 56.7, crs: 'WGS-84'}))`:                   "point({x: 12.5, y: 56.7, crs: 'wgs-84'})",
+		`point({x: 12.5, y: 56.7, crs: 'wgs-84'}).latitude`:                    56.7,
+		`point({x: 12.5, y: 56.7, srid: 4326}).crs`:                            "wgs-84",
+		`point({longitude: 12.5, latitude: 56.7, height: 100}).height`:         100.0,
+		`point({longitude: 12.5, latitude: 56.7, height: 100}).srid`:           4979,
+		`point({x: 3, y: 4}).x`:                                                3.0,
+		`point({x: 3, y: 4})['y']`:                                             4.0,
+		`point({x: 3, y: null})`:                                               nil,
+		`point(null)`:                                                          nil,
+		`point.distance(point({x: 0, y: 0}), point({x: 3, y: 4}))`:             5.0,
+		`point.distance(point({x: 0, y: 0, z: 0}), point({x: 2, y: 3, z: 6}))`: 7.0,
+		`round(point.distance(point({longitude: 12.56, latitude: 55.67}), point({longitude: 13.40, latitude: 52.52})))`:                       354911.0,
+		`point.distance(point({x: 0, y: 0}), point({longitude: 3, latitude: 4}))`:                                                             nil,
+		`point.distance(point({x: 0, y: 0}), null)`:                                                                                           nil,
+		`point.withinBBox(point({x: 1, y: 1}), point({x: 0, y: 0}), point({x: 2, y: 2}))`:                                                     true,
+		`point.withinBBox(point({x: 3, y: 1}), point({x: 0, y: 0}), point({x: 2, y: 2}))`:                                                     false,
+		`point.withinBBox(point({longitude: 179, latitude: 1}), point({longitude: 170, latitude: 0}), point({longitude: -170, latitude: 2}))`: true,
+		`point.withinBBox(point({longitude: 0, latitude: 1}), point({longitude: 170, latitude: 0}), point({longitude: -170, latitude: 2}))`:   false,
+		`point.withinBBox(point({x: 1, y: 1}), point({longitude: 0, latitude: 0}), point({x: 2, y: 2}))`:                                      nil,
+		`point({x: 3, y: 4}) = point({x: 3, y: 4})`:                                                                                           true,
+		`point({x: 3, y: 4}) = point({x: 3, y: 4, z: 0})`:                                                                                     false,
+		`point({x: 3, y: 4}) = point({longitude: 3, latitude: 4})`:                                                                            false,
+		`point({x: 3, y: 4}) <> point({x: 3, y: 5})`:                                                                                          true,
+		`point({x: 3, y: 4}) < point({x: 3, y: 5})`:                                                                                           nil,
+	})
+	for _, expr := range []string{`point({x: 1})`, `point({x: 1, y: 2}).latitude`, `point({x: 1, y: 2}).z`, `point({longitude: 1, latitude: 91})`, `point({x: 1, y: 2, crs: 'cartesian-3d'})`, `point({x: 1, y: 2, crs: 'mars'})`, `point(1)`, `point.distance(1, 2)`} {
+		if _, err := ParseAndEvaluate("RETURN "+expr+" AS x", NewEvalContext(lpg.NewGraph())); err == nil {
+			t.Errorf("%s: expecting error", expr)
+		}
+	}
+}
@@ -16,10 +16,14 @@ func (e ErrUnknownParameter) Error() string { return "Unknown parameter: " + e.K
 type EvalContext struct {
 	parent     *EvalContext
 	funcMap    map[string]Function
+	procMap    map[string]Procedure
 	variables  map[string]Value
 	parameters map[string]Value
 	graph      *lpg.Graph
 
+	// Results of the aggregation functions for the current group of rows
+	aggregates map[interface{}]Value
+
 	// If this function is non-nil, it will be called to filter property
 	// values when setting properties of nodes or edges
 	PropertyValueFromNativeFilter func(string, interface{}) interface{}
@@ -28,6 +32,7 @@ type EvalContext struct {
 func NewEvalContext(graph *lpg.Graph) *EvalContext {
 	return &EvalContext{
 		funcMap:    globalFuncs,
+		procMap:    globalProcedures,
 		variables:  make(map[string]Value),
 		parameters: make(map[string]Value),
 		graph:      graph,
@@ -39,6 +44,7 @@ func (ctx *EvalContext) SubContext() *EvalContext {
 	return &EvalContext{
 		parent:                        ctx,
 		funcMap:                       ctx.funcMap,
+		procMap:                       ctx.procMap,
 		variables:                     make(map[string]Value),
 		parameters:                    make(map[string]Value),
 		graph:                         ctx.graph,
@@ -103,6 +109,38 @@ func (ctx *EvalContext) GetFunction(name []string) (Function, error) {
 	return ctx.getFunction(bld.String())
 }
 
+type ErrUnknownProcedure struct {
+	Name string
+}
+
+func (e ErrUnknownProcedure) Error() string { return "Unknown procedure: " + e.Name }
+
+func (ctx *EvalContext) GetProcedure(name []string) (Procedure, error) {
+	bld := strings.Builder{}
+	for i, x := range name {
+		if i > 0 {
+			bld.WriteRune('.')
+		}
+		bld.WriteString(x)
+	}
+	p, ok := ctx.procMap[bld.String()]
+	if !ok {
+		return Procedure{}, ErrUnknownProcedure{bld.String()}
+	}
+	return p, nil
+}
+
+// getAggregateResult returns the result of the aggregation expression
+// for the current group of rows
+func (ctx *EvalContext) getAggregateResult(expr interface{}) (Value, error) {
+	for c := ctx; c != nil; c = c.parent {
+		if v, ok := c.aggregates[expr]; ok {
+			return v, nil
+		}
+	}
+	return nil, ErrInvalidAggregation
+}
+
 func (ctx *EvalContext) GetVar(name string) (Value, error) {
 	val, ok := ctx.variables[name]
 	if !ok {
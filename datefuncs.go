@@ -20,6 +20,18 @@ func init() {
 		MaxArgs:   2,
 		ValueFunc: parseDateFunc,
 	}
+	globalFuncs["parseDateTime"] = Function{
+		Name:      "parseDateTime",
+		MinArgs:   2,
+		MaxArgs:   3,
+		ValueFunc: parseDateTimeFunc,
+	}
+	globalFuncs["formatDate"] = Function{
+		Name:      "formatDate",
+		MinArgs:   2,
+		MaxArgs:   2,
+		ValueFunc: formatDateFunc,
+	}
 }
 
 var supportedDateFormats = []string{
@@ -45,7 +57,7 @@ func dateFunc(ctx *EvalContext, args []Value) (Value, error) {
 				if err != nil {
 					return nil, err
 				}
-				loc, err := time.LoadLocation(str)
+				loc, err := loadLocation(str)
 				if err != nil {
 					return nil, err
 				}
@@ -121,3 +133,65 @@ func parseDateFunc(ctx *EvalContext, args []Value) (Value, error) {
 	}
 	return RValue{Value: NewDate(g.ToTime())}, nil
 }
+
+// parseDateTime(str,moment format,timezone). If the format does not
+// have a timezone, the time is in the given timezone, or UTC.
+func parseDateTimeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	for _, arg := range args {
+		if arg.Get() == nil {
+			return RValue{}, nil
+		}
+	}
+	str, err := ValueAsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In parseDateTime: %w", err)
+	}
+	format, err := ValueAsString(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("In parseDateTime: %w", err)
+	}
+	loc := time.UTC
+	if len(args) == 3 {
+		tz, err := ValueAsString(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("In parseDateTime: %w", err)
+		}
+		if loc, err = loadLocation(tz); err != nil {
+			return nil, fmt.Errorf("In parseDateTime: %w", err)
+		}
+	}
+	g, err := goment.New(str, format)
+	if err != nil {
+		return nil, fmt.Errorf("In parseDateTime: %w", err)
+	}
+	t := g.ToTime()
+	// goment uses the local timezone if the format does not have
+	// one, and a named offset otherwise
+	if t.Location() == time.Local {
+		t = inLocation(t, loc)
+	} else {
+		_, offset := t.Zone()
+		t = t.In(time.FixedZone("", offset))
+	}
+	return RValue{Value: NewDateTime(t)}, nil
+}
+
+// formatDate(temporal,moment format)
+func formatDateFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil || args[1].Get() == nil {
+		return RValue{}, nil
+	}
+	t, _, _, ok := temporalValue(args[0].Get())
+	if !ok {
+		return nil, fmt.Errorf("In formatDate: Temporal value required: %v", args[0].Get())
+	}
+	format, err := ValueAsString(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("In formatDate: %w", err)
+	}
+	g, err := goment.New(t)
+	if err != nil {
+		return nil, fmt.Errorf("In formatDate: %w", err)
+	}
+	return RValue{Value: g.Format(format)}, nil
+}
@@ -8,6 +8,7 @@ import (
 
 type (
 	Time          time.Time // Time since start of day with timezone information
+	DateTime      time.Time // Date and time with timezone information
 	Date          time.Time // Date value, without a time zone and time related components.
 	LocalTime     time.Time // Time since start of day in local timezone
 	LocalDateTime time.Time // Date and time in local timezone
@@ -46,7 +47,22 @@ func (t Time) Time() time.Time {
 }
 
 func (t Time) String() string {
-	return t.Time().Format("15:04:05")
+	return t.Time().Format("15:04:05.999999999Z07:00")
+}
+
+// Time casts DateTime to time.Time
+func (t DateTime) Time() time.Time {
+	return time.Time(t)
+}
+
+// String returns the date and time in ISO 8601 format. If the
+// timezone has a name, it is appended in brackets.
+func (t DateTime) String() string {
+	ret := t.Time().Format("2006-01-02T15:04:05.999999999Z07:00")
+	if name := t.Time().Location().String(); name != "" && name != "UTC" && name != "Local" {
+		ret += "[" + name + "]"
+	}
+	return ret
 }
 
 // NewDate creates a Date
@@ -69,3 +85,112 @@ func NewLocalDateTime(t time.Time) LocalDateTime {
 	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local)
 	return LocalDateTime(t)
 }
+
+// NewTime creates a Time from time.Time. Year, month and day are set
+// to zero, and location is set to the UTC offset of t.
+func NewTime(t time.Time) Time {
+	_, offset := t.Zone()
+	t = time.Date(0, 0, 0, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.FixedZone("", offset))
+	return Time(t)
+}
+
+// NewDateTime creates a DateTime from time.Time
+func NewDateTime(t time.Time) DateTime {
+	return DateTime(t)
+}
+
+// nanoOfDay returns the nanoseconds since the start of the day of t
+func nanoOfDay(t time.Time) int64 {
+	return int64(t.Hour())*int64(time.Hour) + int64(t.Minute())*int64(time.Minute) + int64(t.Second())*int64(time.Second) + int64(t.Nanosecond())
+}
+
+// temporalComponent returns the named component of a temporal value
+// or a duration, such as year, dayOfWeek, or epochMillis. The second
+// return value is false if the value is not temporal.
+func temporalComponent(v interface{}, name string) (Value, bool, error) {
+	if d, ok := v.(Duration); ok {
+		ret, err := d.component(name)
+		return ret, true, err
+	}
+	t, hasDate, zoned, ok := temporalValue(v)
+	if !ok {
+		return nil, false, nil
+	}
+	_, isDate := v.(Date)
+	hasTime := !isDate
+	var ret interface{}
+	if hasDate {
+		switch name {
+		case "year":
+			ret = t.Year()
+		case "quarter":
+			ret = (int(t.Month())-1)/3 + 1
+		case "month":
+			ret = int(t.Month())
+		case "week":
+			_, week := t.ISOWeek()
+			ret = week
+		case "weekYear":
+			year, _ := t.ISOWeek()
+			ret = year
+		case "day":
+			ret = t.Day()
+		case "ordinalDay":
+			ret = t.YearDay()
+		case "dayOfQuarter", "quarterDay":
+			quarterStart := time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, time.UTC)
+			ret = t.YearDay() - quarterStart.YearDay() + 1
+		case "dayOfWeek", "weekDay":
+			// Monday is 1, Sunday is 7
+			ret = (int(t.Weekday())+6)%7 + 1
+		}
+	}
+	if ret == nil && hasTime {
+		switch name {
+		case "hour":
+			ret = t.Hour()
+		case "minute":
+			ret = t.Minute()
+		case "second":
+			ret = t.Second()
+		case "millisecond":
+			ret = t.Nanosecond() / int(time.Millisecond)
+		case "microsecond":
+			ret = t.Nanosecond() / int(time.Microsecond)
+		case "nanosecond":
+			ret = t.Nanosecond()
+		}
+	}
+	if ret == nil && zoned {
+		_, offset := t.Zone()
+		switch name {
+		case "timezone":
+			ret = t.Location().String()
+			if ret == "" {
+				ret = t.Format("Z07:00")
+			}
+		case "offset":
+			ret = t.Format("Z07:00")
+		case "offsetMinutes":
+			ret = offset / 60
+		case "offsetSeconds":
+			ret = offset
+		}
+	}
+	if ret == nil && hasDate && hasTime {
+		// Local date times are interpreted in UTC
+		if !zoned {
+			t = inLocation(t, time.UTC)
+		}
+		switch name {
+		case "epochSeconds":
+			ret = int(t.Unix())
+		case "epochMillis":
+			ret = int(t.UnixMilli())
+		}
+	}
+	if ret == nil {
+		return nil, true, ErrValueDoesNotHaveProperties{Value: v, Property: name}
+	}
+	return RValue{Value: ret}, true, nil
+}
@@ -0,0 +1,395 @@
+package opencypher
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tkuchiki/go-timezone"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "datetime",
+			MinArgs:   0,
+			MaxArgs:   1,
+			ValueFunc: datetimeFunc,
+		},
+		Function{
+			Name:      "localdatetime",
+			MinArgs:   0,
+			MaxArgs:   1,
+			ValueFunc: localdatetimeFunc,
+		},
+		Function{
+			Name:      "time",
+			MinArgs:   0,
+			MaxArgs:   1,
+			ValueFunc: timeFunc,
+		},
+		Function{
+			Name:      "localtime",
+			MinArgs:   0,
+			MaxArgs:   1,
+			ValueFunc: localtimeFunc,
+		},
+		Function{
+			Name:      "datetime.fromepoch",
+			MinArgs:   1,
+			MaxArgs:   2,
+			ValueFunc: datetimeFromEpochFunc,
+		},
+		Function{
+			Name:      "datetime.fromepochmillis",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: datetimeFromEpochMillisFunc,
+		},
+	)
+}
+
+var timezones = timezone.New()
+
+// loadLocation returns the location for a timezone. The timezone can
+// be "Z", a UTC offset such as "+01:00", an IANA timezone name such as
+// "Europe/London", or an unambiguous abbreviation such as "PST".
+func loadLocation(name string) (*time.Location, error) {
+	if name == "Z" || name == "UTC" {
+		return time.UTC, nil
+	}
+	if strings.HasPrefix(name, "+") || strings.HasPrefix(name, "-") {
+		offset, err := parseOffset(name)
+		if err != nil {
+			return nil, err
+		}
+		return time.FixedZone("", offset), nil
+	}
+	if loc, err := time.LoadLocation(name); err == nil {
+		return loc, nil
+	}
+	infos, err := timezones.GetTzAbbreviationInfo(name)
+	if err != nil || len(infos) != 1 {
+		return nil, fmt.Errorf("Invalid timezone: %s", name)
+	}
+	return time.FixedZone(name, infos[0].Offset()), nil
+}
+
+// parseOffset parses a UTC offset of the form Z, +hh, +hhmm, or
+// +hh:mm, and returns the offset in seconds
+func parseOffset(str string) (int, error) {
+	if str == "Z" {
+		return 0, nil
+	}
+	for _, layout := range []string{"-07:00", "-0700", "-07"} {
+		if t, err := time.Parse(layout, str); err == nil {
+			_, offset := t.Zone()
+			return offset, nil
+		}
+	}
+	return 0, fmt.Errorf("Invalid timezone offset: %s", str)
+}
+
+var supportedTimeFormats = []string{
+	"15:04:05",
+	"15:04",
+	"150405",
+	"1504",
+	"15",
+}
+
+// temporalString is a parsed ISO 8601 date, time, or date and time
+type temporalString struct {
+	date    time.Time
+	hasDate bool
+	clock   time.Time
+	hasTime bool
+	// loc is nil if the string does not have a timezone
+	loc *time.Location
+}
+
+// parseTemporalString parses strings of the form
+//
+//	2015-07-21T21:40:32.142+01:00[Europe/London]
+//
+// All parts other than the date or the time are optional. If
+// allowDate is false, the string can only contain a time.
+func parseTemporalString(str string, allowDate bool) (temporalString, error) {
+	ret := temporalString{}
+	in := str
+	if strings.HasSuffix(in, "]") {
+		ix := strings.LastIndex(in, "[")
+		if ix == -1 {
+			return ret, fmt.Errorf("Invalid temporal value: %s", str)
+		}
+		loc, err := loadLocation(in[ix+1 : len(in)-1])
+		if err != nil {
+			return ret, err
+		}
+		ret.loc = loc
+		in = in[:ix]
+	}
+	clock := in
+	if allowDate {
+		clock = ""
+		datePart := in
+		if ix := strings.IndexAny(in, "Tt"); ix != -1 {
+			datePart, clock = in[:ix], in[ix+1:]
+			if len(clock) == 0 {
+				return ret, fmt.Errorf("Invalid temporal value: %s", str)
+			}
+		}
+		for _, f := range supportedDateFormats {
+			if t, err := time.Parse(f, datePart); err == nil {
+				ret.date = t
+				ret.hasDate = true
+				break
+			}
+		}
+		if !ret.hasDate {
+			return ret, fmt.Errorf("Invalid date string: %s", str)
+		}
+	}
+	if len(clock) == 0 {
+		return ret, nil
+	}
+	if ix := strings.IndexAny(clock, "Zz+-"); ix != -1 {
+		offset, err := parseOffset(strings.ToUpper(clock[ix:]))
+		if err != nil {
+			return ret, err
+		}
+		if ret.loc == nil {
+			ret.loc = time.FixedZone("", offset)
+		}
+		clock = clock[:ix]
+	}
+	for _, f := range supportedTimeFormats {
+		if t, err := time.Parse(f, clock); err == nil {
+			ret.clock = t
+			ret.hasTime = true
+			return ret, nil
+		}
+	}
+	return ret, fmt.Errorf("Invalid time string: %s", str)
+}
+
+// build returns the time.Time for the parsed string, using loc if
+// the string does not have a timezone.
+func (t temporalString) build(loc *time.Location) time.Time {
+	if t.loc != nil {
+		loc = t.loc
+	}
+	// Times without dates use the current date, so the offset for
+	// a named timezone is the one currently in effect
+	y, m, d := time.Now().In(loc).Date()
+	if t.hasDate {
+		y, m, d = t.date.Date()
+	}
+	return time.Date(y, m, d, t.clock.Hour(), t.clock.Minute(), t.clock.Second(), t.clock.Nanosecond(), loc)
+}
+
+// temporalTime returns the time.Time for a temporal value. Local
+// values are interpreted in loc. The second return value is false if
+// the value is not temporal.
+func temporalTime(v interface{}, loc *time.Location) (time.Time, bool) {
+	t, _, zoned, ok := temporalValue(v)
+	if !ok {
+		return time.Time{}, false
+	}
+	if zoned {
+		return t, true
+	}
+	return inLocation(t, loc), true
+}
+
+// temporalMapComponent returns the integer value of the map key, or
+// def if the key does not exist. The second return value is false if
+// the key has null value.
+func temporalMapComponent(props map[string]Value, key string, def int) (int, bool, error) {
+	v, ok := props[key]
+	if !ok {
+		return def, true, nil
+	}
+	if v.Get() == nil {
+		return 0, false, nil
+	}
+	ret, err := ValueAsInt(v)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s: %w", key, err)
+	}
+	return ret, true, nil
+}
+
+// temporalFromMap builds a time from the map keys year, month, day,
+// hour, minute, second, millisecond, microsecond, nanosecond, and
+// timezone. Alternatively, the map can contain epochSeconds or
+// epochMillis. If the map only has timezone, the current time in that
+// timezone is returned. If allowDate is false, the date is set to
+// the current date. The second return value is false if any
+// component is null.
+func temporalFromMap(props map[string]Value, loc *time.Location, allowDate bool) (time.Time, bool, error) {
+	if tz, ok := props["timezone"]; ok {
+		if tz.Get() == nil {
+			return time.Time{}, false, nil
+		}
+		str, err := ValueAsString(tz)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if loc, err = loadLocation(str); err != nil {
+			return time.Time{}, false, err
+		}
+		if len(props) == 1 {
+			return time.Now().In(loc), true, nil
+		}
+	}
+	components := make(map[string]int)
+	for _, x := range []struct {
+		key string
+		def int
+	}{
+		{"year", 1970}, {"month", 1}, {"day", 1}, {"hour", 0}, {"minute", 0}, {"second", 0}, {"millisecond", 0}, {"microsecond", 0}, {"nanosecond", 0}, {"epochSeconds", 0}, {"epochMillis", 0},
+	} {
+		v, ok, err := temporalMapComponent(props, x.key, x.def)
+		if err != nil || !ok {
+			return time.Time{}, ok, err
+		}
+		components[x.key] = v
+	}
+	nanos := components["millisecond"]*int(time.Millisecond) + components["microsecond"]*int(time.Microsecond) + components["nanosecond"]
+	if _, ok := props["epochSeconds"]; ok {
+		return time.Unix(int64(components["epochSeconds"]), int64(nanos)).In(loc), true, nil
+	}
+	if _, ok := props["epochMillis"]; ok {
+		return time.UnixMilli(int64(components["epochMillis"])).Add(time.Duration(nanos)).In(loc), true, nil
+	}
+	if allowDate {
+		if _, ok := props["year"]; !ok {
+			return time.Time{}, false, fmt.Errorf("year required")
+		}
+	} else {
+		y, m, d := time.Now().In(loc).Date()
+		components["year"], components["month"], components["day"] = y, int(m), d
+	}
+	if _, ok := props["hour"]; !ok && !allowDate {
+		return time.Time{}, false, fmt.Errorf("hour required")
+	}
+	// time.Date normalizes out of range values, so they are checked
+	// here
+	daysInMonth := time.Date(components["year"], time.Month(components["month"])+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, x := range []struct {
+		key      string
+		min, max int
+	}{
+		{"month", 1, 12}, {"day", 1, daysInMonth}, {"hour", 0, 23}, {"minute", 0, 59}, {"second", 0, 59}, {"millisecond", 0, 999}, {"microsecond", 0, 999999}, {"nanosecond", 0, 999999999},
+	} {
+		if v := components[x.key]; v < x.min || v > x.max {
+			return time.Time{}, false, fmt.Errorf("Invalid %s: %d", x.key, v)
+		}
+	}
+	if nanos >= int(time.Second) {
+		return time.Time{}, false, fmt.Errorf("Invalid fraction of second: %d nanoseconds", nanos)
+	}
+	return time.Date(components["year"], time.Month(components["month"]), components["day"], components["hour"], components["minute"], components["second"], nanos, loc), true, nil
+}
+
+// temporalArg builds a time from the optional temporal function
+// argument, which can be a string, a map, or another temporal
+// value. Returns false if the result is null.
+func temporalArg(args []Value, loc *time.Location, allowDate bool) (time.Time, bool, error) {
+	if len(args) == 0 {
+		return time.Now().In(loc), true, nil
+	}
+	switch val := args[0].Get().(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case string:
+		t, err := parseTemporalString(val, allowDate)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		return t.build(loc), true, nil
+	case map[string]Value:
+		return temporalFromMap(val, loc, allowDate)
+	}
+	if t, ok := temporalTime(args[0].Get(), loc); ok {
+		return t, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("Invalid argument: %v", args[0].Get())
+}
+
+// datetimeFunc returns a DateTime. Without a timezone, UTC is used.
+func datetimeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	t, ok, err := temporalArg(args, time.UTC, true)
+	if err != nil {
+		return nil, fmt.Errorf("In datetime: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: NewDateTime(t)}, nil
+}
+
+func localdatetimeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	t, ok, err := temporalArg(args, time.Local, true)
+	if err != nil {
+		return nil, fmt.Errorf("In localdatetime: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: NewLocalDateTime(t)}, nil
+}
+
+// timeFunc returns a Time. Without a timezone, UTC is used.
+func timeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	t, ok, err := temporalArg(args, time.UTC, false)
+	if err != nil {
+		return nil, fmt.Errorf("In time: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: NewTime(t)}, nil
+}
+
+func localtimeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	t, ok, err := temporalArg(args, time.Local, false)
+	if err != nil {
+		return nil, fmt.Errorf("In localtime: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: NewLocalTime(t)}, nil
+}
+
+// datetimeFromEpochFunc returns the UTC DateTime for the given
+// seconds and nanoseconds since epoch
+func datetimeFromEpochFunc(ctx *EvalContext, args []Value) (Value, error) {
+	nums := make([]int, 2)
+	for i := range args {
+		if args[i].Get() == nil {
+			return RValue{}, nil
+		}
+		n, err := ValueAsInt(args[i])
+		if err != nil {
+			return nil, fmt.Errorf("In datetime.fromepoch: %w", err)
+		}
+		nums[i] = n
+	}
+	return RValue{Value: NewDateTime(time.Unix(int64(nums[0]), int64(nums[1])).UTC())}, nil
+}
+
+// datetimeFromEpochMillisFunc returns the UTC DateTime for the given
+// milliseconds since epoch
+func datetimeFromEpochMillisFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	n, err := ValueAsInt(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In datetime.fromepochmillis: %w", err)
+	}
+	return RValue{Value: NewDateTime(time.UnixMilli(int64(n)).UTC())}, nil
+}
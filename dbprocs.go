@@ -0,0 +1,79 @@
+package opencypher
+
+import (
+	"sort"
+)
+
+func init() {
+	RegisterGlobalProcedure(
+		Procedure{
+			Name:    "db.labels",
+			Outputs: []string{"label", "count"},
+			Func:    dbLabelsProc,
+		},
+		Procedure{
+			Name:    "db.relationshipTypes",
+			Outputs: []string{"relationshipType", "count"},
+			Func:    dbRelationshipTypesProc,
+		},
+		Procedure{
+			Name:    "db.propertyKeys",
+			Outputs: []string{"propertyKey", "count"},
+			Func:    dbPropertyKeysProc,
+		},
+	)
+}
+
+// emitCounts emits a row for each key in sorted order
+func emitCounts(counts map[string]int, keyColumn string, emit func(map[string]Value) error) error {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := emit(map[string]Value{keyColumn: RValue{Value: k}, "count": RValue{Value: counts[k]}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dbLabelsProc lists the node labels, and the number of nodes with
+// each label
+func dbLabelsProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	counts := make(map[string]int)
+	for nodes := ctx.graph.GetNodes(); nodes.Next(); {
+		for _, label := range nodes.Node().GetLabels().Slice() {
+			counts[label]++
+		}
+	}
+	return emitCounts(counts, "label", emit)
+}
+
+// dbRelationshipTypesProc lists the edge labels, and the number of
+// edges with each label
+func dbRelationshipTypesProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	counts := make(map[string]int)
+	for edges := ctx.graph.GetEdges(); edges.Next(); {
+		counts[edges.Edge().GetLabel()]++
+	}
+	return emitCounts(counts, "relationshipType", emit)
+}
+
+// dbPropertyKeysProc lists the property keys, and the number of
+// nodes and edges with each property
+func dbPropertyKeysProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	counts := make(map[string]int)
+	count := func(key string, _ interface{}) bool {
+		counts[key]++
+		return true
+	}
+	for nodes := ctx.graph.GetNodes(); nodes.Next(); {
+		nodes.Node().ForEachProperty(count)
+	}
+	for edges := ctx.graph.GetEdges(); edges.Next(); {
+		edges.Edge().ForEachProperty(count)
+	}
+	return emitCounts(counts, "propertyKey", emit)
+}
@@ -37,3 +37,53 @@ func (d Duration) String() string {
 func (d1 Duration) Equal(d2 Duration) bool {
 	return d1.Months == d2.Months && d1.Days == d2.Days && d1.Seconds == d2.Seconds && d1.Nanos == d2.Nanos
 }
+
+// component returns the named component of the duration
+func (d Duration) component(name string) (Value, error) {
+	var ret int64
+	switch name {
+	case "years":
+		ret = d.Months / 12
+	case "quarters":
+		ret = d.Months / 3
+	case "months":
+		ret = d.Months
+	case "weeks":
+		ret = d.Days / 7
+	case "days":
+		ret = d.Days
+	case "hours":
+		ret = d.Seconds / 3600
+	case "minutes":
+		ret = d.Seconds / 60
+	case "seconds":
+		ret = d.Seconds
+	case "milliseconds":
+		ret = d.Seconds*1000 + int64(d.Nanos)/int64(time.Millisecond)
+	case "microseconds":
+		ret = d.Seconds*1000000 + int64(d.Nanos)/int64(time.Microsecond)
+	case "nanoseconds":
+		ret = d.Seconds*int64(time.Second) + int64(d.Nanos)
+	case "quartersOfYear":
+		ret = d.Months % 12 / 3
+	case "monthsOfQuarter":
+		ret = d.Months % 3
+	case "monthsOfYear":
+		ret = d.Months % 12
+	case "daysOfWeek":
+		ret = d.Days % 7
+	case "minutesOfHour":
+		ret = d.Seconds / 60 % 60
+	case "secondsOfMinute":
+		ret = d.Seconds % 60
+	case "millisecondsOfSecond":
+		ret = int64(d.Nanos) / int64(time.Millisecond)
+	case "microsecondsOfSecond":
+		ret = int64(d.Nanos) / int64(time.Microsecond)
+	case "nanosecondsOfSecond":
+		ret = int64(d.Nanos)
+	default:
+		return nil, ErrValueDoesNotHaveProperties{Value: d, Property: name}
+	}
+	return RValue{Value: int(ret)}, nil
+}
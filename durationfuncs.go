@@ -0,0 +1,524 @@
+package opencypher
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "duration",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: durationFunc,
+		},
+		Function{
+			Name:      "duration.between",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: durationBetweenFunc("duration.between", ""),
+		},
+		Function{
+			Name:      "duration.inMonths",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: durationBetweenFunc("duration.inMonths", "months"),
+		},
+		Function{
+			Name:      "duration.inDays",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: durationBetweenFunc("duration.inDays", "days"),
+		},
+		Function{
+			Name:      "duration.inSeconds",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: durationBetweenFunc("duration.inSeconds", "seconds"),
+		},
+		Function{
+			Name:      "date.truncate",
+			MinArgs:   2,
+			MaxArgs:   3,
+			ValueFunc: dateTruncateFunc,
+		},
+		Function{
+			Name:      "datetime.truncate",
+			MinArgs:   2,
+			MaxArgs:   3,
+			ValueFunc: datetimeTruncateFunc,
+		},
+	)
+}
+
+const (
+	secondsPerDay = 86400
+	// secondsPerMonth is the average length of a month, 30.436875
+	// days, used to carry fractional months to days
+	secondsPerMonth = 2629746
+)
+
+// newDuration returns a duration with nanoseconds normalized to
+// [0, 1e9)
+func newDuration(months, days, seconds, nanos int64) Duration {
+	seconds += nanos / int64(time.Second)
+	nanos %= int64(time.Second)
+	if nanos < 0 {
+		seconds--
+		nanos += int64(time.Second)
+	}
+	return Duration{Months: months, Days: days, Seconds: seconds, Nanos: int(nanos)}
+}
+
+// durationFromComponents builds a duration from possibly fractional
+// months, days, and seconds. Fractional months and days are carried
+// to the smaller units.
+func durationFromComponents(months, days, seconds float64) Duration {
+	m, fm := math.Modf(months)
+	days += fm * secondsPerMonth / secondsPerDay
+	d, fd := math.Modf(days)
+	seconds += fd * secondsPerDay
+	s, fs := math.Modf(seconds)
+	return newDuration(int64(m), int64(d), int64(s), int64(math.Round(fs*float64(time.Second))))
+}
+
+// parseDuration parses an ISO 8601 duration of the form
+//
+//	P[nY][nM][nW][nD][T[nH][nM][nS]]
+//
+// or
+//
+//	PYYYY-MM-DDThh:mm:ss
+//
+// Components can be negative or fractional.
+func parseDuration(str string) (Duration, error) {
+	invalid := fmt.Errorf("Invalid duration: %s", str)
+	in := strings.ToUpper(str)
+	sign := 1.0
+	if strings.HasPrefix(in, "-") {
+		sign = -1
+		in = in[1:]
+	}
+	if !strings.HasPrefix(in, "P") || len(in) == 1 {
+		return Duration{}, invalid
+	}
+	in = in[1:]
+	if len(in) >= 10 && in[4] == '-' && in[7] == '-' {
+		return parseAlternativeDuration(in, sign, invalid)
+	}
+	var months, days, seconds float64
+	units := "YMWD"
+	inTime := false
+	for len(in) > 0 {
+		if in[0] == 'T' {
+			if inTime || len(in) == 1 {
+				return Duration{}, invalid
+			}
+			inTime, units = true, "HMS"
+			in = in[1:]
+			continue
+		}
+		i := strings.IndexAny(in, "YMWDHS")
+		if i <= 0 || strings.Trim(in[:i], "0123456789.+-") != "" {
+			return Duration{}, invalid
+		}
+		n, err := strconv.ParseFloat(in[:i], 64)
+		if err != nil {
+			return Duration{}, invalid
+		}
+		// Units must be in order
+		ix := strings.IndexByte(units, in[i])
+		if ix == -1 {
+			return Duration{}, invalid
+		}
+		units = units[ix+1:]
+		n *= sign
+		switch in[i] {
+		case 'Y':
+			months += n * 12
+		case 'M':
+			if inTime {
+				seconds += n * 60
+			} else {
+				months += n
+			}
+		case 'W':
+			days += n * 7
+		case 'D':
+			days += n
+		case 'H':
+			seconds += n * 3600
+		case 'S':
+			seconds += n
+		}
+		in = in[i+1:]
+	}
+	return durationFromComponents(months, days, seconds), nil
+}
+
+// parseAlternativeDuration parses the YYYY-MM-DDThh:mm:ss part of an
+// ISO 8601 duration
+func parseAlternativeDuration(in string, sign float64, invalid error) (Duration, error) {
+	datePart, timePart := in, ""
+	if ix := strings.IndexByte(in, 'T'); ix != -1 {
+		datePart, timePart = in[:ix], in[ix+1:]
+	}
+	parse := func(s string, sep string, n int) ([]float64, bool) {
+		parts := strings.Split(s, sep)
+		if len(parts) != n {
+			return nil, false
+		}
+		ret := make([]float64, 0, n)
+		for _, p := range parts {
+			f, err := strconv.ParseFloat(p, 64)
+			if err != nil || f < 0 {
+				return nil, false
+			}
+			ret = append(ret, f)
+		}
+		return ret, true
+	}
+	date, ok := parse(datePart, "-", 3)
+	if !ok {
+		return Duration{}, invalid
+	}
+	clock := []float64{0, 0, 0}
+	if len(timePart) > 0 {
+		if clock, ok = parse(timePart, ":", 3); !ok {
+			return Duration{}, invalid
+		}
+	}
+	return durationFromComponents(sign*(date[0]*12+date[1]), sign*date[2], sign*(clock[0]*3600+clock[1]*60+clock[2])), nil
+}
+
+// durationFromMap builds a duration from the map keys years,
+// quarters, months, weeks, days, hours, minutes, seconds,
+// milliseconds, microseconds, and nanoseconds. The second return
+// value is false if any component is null.
+func durationFromMap(props map[string]Value) (Duration, bool, error) {
+	var months, days, seconds float64
+	for k, v := range props {
+		if v.Get() == nil {
+			return Duration{}, false, nil
+		}
+		n, err := valueAsFloat(v)
+		if err != nil {
+			return Duration{}, false, fmt.Errorf("%s: %w", k, err)
+		}
+		switch k {
+		case "years":
+			months += n * 12
+		case "quarters":
+			months += n * 3
+		case "months":
+			months += n
+		case "weeks":
+			days += n * 7
+		case "days":
+			days += n
+		case "hours":
+			seconds += n * 3600
+		case "minutes":
+			seconds += n * 60
+		case "seconds":
+			seconds += n
+		case "milliseconds":
+			seconds += n / 1e3
+		case "microseconds":
+			seconds += n / 1e6
+		case "nanoseconds":
+			seconds += n / 1e9
+		default:
+			return Duration{}, false, fmt.Errorf("Invalid duration component: %s", k)
+		}
+	}
+	return durationFromComponents(months, days, seconds), true, nil
+}
+
+// durationFunc returns a duration from an ISO 8601 string or a map
+func durationFunc(ctx *EvalContext, args []Value) (Value, error) {
+	switch val := args[0].Get().(type) {
+	case nil:
+		return RValue{}, nil
+	case Duration:
+		return RValue{Value: val}, nil
+	case string:
+		d, err := parseDuration(val)
+		if err != nil {
+			return nil, fmt.Errorf("In duration: %w", err)
+		}
+		return RValue{Value: d}, nil
+	case map[string]Value:
+		d, ok, err := durationFromMap(val)
+		if err != nil {
+			return nil, fmt.Errorf("In duration: %w", err)
+		}
+		if !ok {
+			return RValue{}, nil
+		}
+		return RValue{Value: d}, nil
+	}
+	return nil, fmt.Errorf("In duration: Invalid argument: %v", args[0].Get())
+}
+
+// temporalValue returns the time for a temporal value, and whether
+// the value has a date and a timezone
+func temporalValue(v interface{}) (t time.Time, hasDate, zoned, ok bool) {
+	switch val := v.(type) {
+	case DateTime:
+		return val.Time(), true, true, true
+	case LocalDateTime:
+		return val.Time(), true, false, true
+	case Date:
+		return val.Time(), true, false, true
+	case Time:
+		return val.Time(), false, true, true
+	case LocalTime:
+		return val.Time(), false, false, true
+	}
+	return time.Time{}, false, false, false
+}
+
+// inLocation returns the time with the same wall clock in loc
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// addMonths adds months to t. If the day does not exist in the
+// resulting month, the last day of the month is used.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := t.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// calendarBetween returns the duration between two UTC wall clock
+// times in months, days, and seconds. If unit is "months" or "days",
+// the duration is only in that unit.
+func calendarBetween(t1, t2 time.Time, unit string) Duration {
+	months := 0
+	if unit != "days" {
+		months = (t2.Year()-t1.Year())*12 + int(t2.Month()) - int(t1.Month())
+		// Step back if adding the months goes past t2
+		if months > 0 && addMonths(t1, months).After(t2) {
+			months--
+		} else if months < 0 && addMonths(t1, months).Before(t2) {
+			months++
+		}
+		if unit == "months" {
+			return Duration{Months: int64(months)}
+		}
+	}
+	mid := addMonths(t1, months)
+	midnight := func(t time.Time) int64 {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix()
+	}
+	days := (midnight(t2) - midnight(mid)) / secondsPerDay
+	rest := t2.Sub(mid.AddDate(0, 0, int(days)))
+	if days > 0 && rest < 0 {
+		days--
+		rest += 24 * time.Hour
+	} else if days < 0 && rest > 0 {
+		days++
+		rest -= 24 * time.Hour
+	}
+	if unit == "days" {
+		return Duration{Days: days}
+	}
+	return newDuration(int64(months), days, 0, int64(rest))
+}
+
+// durationBetweenFunc returns a function that computes the duration
+// between two temporal values. If unit is empty, the duration is in
+// months, days, and seconds. Otherwise, it is only in the given unit.
+func durationBetweenFunc(name, unit string) func(*EvalContext, []Value) (Value, error) {
+	return func(ctx *EvalContext, args []Value) (Value, error) {
+		if args[0].Get() == nil || args[1].Get() == nil {
+			return RValue{}, nil
+		}
+		t1, hasDate1, zoned1, ok1 := temporalValue(args[0].Get())
+		t2, hasDate2, zoned2, ok2 := temporalValue(args[1].Get())
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("In %s: Temporal values required", name)
+		}
+		if !hasDate1 || !hasDate2 {
+			// Only the time of day is compared
+			n1, n2 := nanoOfDay(t1), nanoOfDay(t2)
+			if zoned1 && zoned2 {
+				_, offset1 := t1.Zone()
+				_, offset2 := t2.Zone()
+				n1 -= int64(offset1) * int64(time.Second)
+				n2 -= int64(offset2) * int64(time.Second)
+			}
+			if unit == "months" || unit == "days" {
+				return RValue{Value: Duration{}}, nil
+			}
+			return RValue{Value: newDuration(0, 0, 0, n2-n1)}, nil
+		}
+		// Local values are interpreted in the timezone of the other
+		// value
+		switch {
+		case zoned1 && zoned2:
+			t2 = t2.In(t1.Location())
+		case zoned1:
+			t2 = inLocation(t2, t1.Location())
+		case zoned2:
+			t1 = inLocation(t1, t2.Location())
+		}
+		if unit == "seconds" {
+			return RValue{Value: newDuration(0, 0, t2.Unix()-t1.Unix(), int64(t2.Nanosecond()-t1.Nanosecond()))}, nil
+		}
+		return RValue{Value: calendarBetween(inLocation(t1, time.UTC), inLocation(t2, time.UTC), unit)}, nil
+	}
+}
+
+// truncateUnits are the units temporal values can be truncated to,
+// from the largest to the smallest
+var truncateUnits = []string{"millennium", "century", "decade", "year", "weekYear", "quarter", "month", "week", "day", "hour", "minute", "second", "millisecond", "microsecond"}
+
+// truncateTime truncates t to the given unit
+func truncateTime(t time.Time, unit string) (time.Time, error) {
+	y, m, d := t.Date()
+	hour, min, sec, nanos := t.Hour(), t.Minute(), t.Second(), t.Nanosecond()
+	switch unit {
+	case "millennium":
+		y, m, d = y-y%1000, time.January, 1
+	case "century":
+		y, m, d = y-y%100, time.January, 1
+	case "decade":
+		y, m, d = y-y%10, time.January, 1
+	case "year":
+		m, d = time.January, 1
+	case "weekYear":
+		// Monday of the first ISO week of the week year
+		wy, _ := t.ISOWeek()
+		jan4 := time.Date(wy, time.January, 4, 0, 0, 0, 0, time.UTC)
+		y, m, d = jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7).Date()
+	case "quarter":
+		m, d = (m-1)/3*3+1, 1
+	case "month":
+		d = 1
+	case "week":
+		y, m, d = t.AddDate(0, 0, -(int(t.Weekday())+6)%7).Date()
+	case "day":
+	case "hour":
+		min, sec, nanos = 0, 0, 0
+	case "minute":
+		sec, nanos = 0, 0
+	case "second":
+		nanos = 0
+	case "millisecond":
+		nanos -= nanos % int(time.Millisecond)
+	case "microsecond":
+		nanos -= nanos % int(time.Microsecond)
+	default:
+		return time.Time{}, fmt.Errorf("Invalid unit: %s", unit)
+	}
+	if unit != "hour" && unit != "minute" && unit != "second" && unit != "millisecond" && unit != "microsecond" {
+		hour, min, sec, nanos = 0, 0, 0, 0
+	}
+	return time.Date(y, m, d, hour, min, sec, nanos, t.Location()), nil
+}
+
+// truncateArgs truncates the temporal value in args[1] to the unit
+// in args[0], and then sets the components given in the optional map
+// in args[2]. The second return value is false if the result is null.
+func truncateArgs(args []Value, maxUnit string) (time.Time, bool, error) {
+	for _, arg := range args {
+		if arg.Get() == nil {
+			return time.Time{}, false, nil
+		}
+	}
+	unit, err := ValueAsString(args[0])
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	valid := false
+	for _, u := range truncateUnits {
+		if u == unit {
+			valid = true
+		}
+		if u == maxUnit {
+			break
+		}
+	}
+	if !valid {
+		return time.Time{}, false, fmt.Errorf("Invalid unit: %s", unit)
+	}
+	t, hasDate, zoned, ok := temporalValue(args[1].Get())
+	if !ok || !hasDate {
+		return time.Time{}, false, fmt.Errorf("Value with a date required: %v", args[1].Get())
+	}
+	if !zoned {
+		t = inLocation(t, time.UTC)
+	}
+	if t, err = truncateTime(t, unit); err != nil {
+		return time.Time{}, false, err
+	}
+	if len(args) < 3 {
+		return t, true, nil
+	}
+	props, ok := args[2].Get().(map[string]Value)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("Map required: %v", args[2].Get())
+	}
+	components := []int{t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond()}
+	for i, key := range []string{"year", "month", "day", "hour", "minute", "second"} {
+		if components[i], ok, err = temporalMapComponent(props, key, components[i]); err != nil || !ok {
+			return time.Time{}, ok, err
+		}
+	}
+	// If any of the sub-second components are given, they replace
+	// the nanoseconds
+	nanos := 0
+	subSecond := false
+	for _, unit := range []struct {
+		key   string
+		nanos int
+	}{{"millisecond", int(time.Millisecond)}, {"microsecond", int(time.Microsecond)}, {"nanosecond", 1}} {
+		n, ok, err := temporalMapComponent(props, unit.key, 0)
+		if err != nil || !ok {
+			return time.Time{}, ok, err
+		}
+		if _, exists := props[unit.key]; exists {
+			subSecond = true
+		}
+		nanos += n * unit.nanos
+	}
+	if subSecond {
+		components[6] = nanos
+	}
+	return time.Date(components[0], time.Month(components[1]), components[2], components[3], components[4], components[5], components[6], t.Location()), true, nil
+}
+
+// dateTruncateFunc implements date.truncate(unit, temporal, map)
+func dateTruncateFunc(ctx *EvalContext, args []Value) (Value, error) {
+	t, ok, err := truncateArgs(args, "day")
+	if err != nil {
+		return nil, fmt.Errorf("In date.truncate: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: NewDate(t)}, nil
+}
+
+// datetimeTruncateFunc implements datetime.truncate(unit, temporal,
+// map). Local values are truncated in UTC.
+func datetimeTruncateFunc(ctx *EvalContext, args []Value) (Value, error) {
+	t, ok, err := truncateArgs(args, "microsecond")
+	if err != nil {
+		return nil, fmt.Errorf("In datetime.truncate: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: NewDateTime(t)}, nil
+}
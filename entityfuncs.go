@@ -0,0 +1,95 @@
+package opencypher
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "id",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: idFunc,
+		},
+		Function{
+			Name:      "elementId",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: elementIdFunc,
+		},
+		Function{
+			Name:      "EXISTS",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: existsFunc,
+		},
+	)
+}
+
+// idFunc returns the graph id of a node or edge
+func idFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	if node, ok := args[0].Get().(*lpg.Node); ok {
+		return RValue{Value: node.GetID()}, nil
+	}
+	if edge, err := valueAsEdge(args[0]); err == nil {
+		return RValue{Value: edge.GetID()}, nil
+	}
+	return nil, fmt.Errorf("In id: Not a node or edge: %T", args[0].Get())
+}
+
+// elementIdFunc returns a string id for a node or edge. Node ids are
+// of the form "n:<id>", and edge ids are of the form "e:<id>", so
+// nodes and edges do not share ids.
+func elementIdFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	if node, ok := args[0].Get().(*lpg.Node); ok {
+		return RValue{Value: "n:" + strconv.Itoa(node.GetID())}, nil
+	}
+	if edge, err := valueAsEdge(args[0]); err == nil {
+		return RValue{Value: "e:" + strconv.Itoa(edge.GetID())}, nil
+	}
+	return nil, fmt.Errorf("In elementId: Not a node or edge: %T", args[0].Get())
+}
+
+// asExistsPattern returns the pattern of exists((n)-->()). Such an
+// invocation is evaluated as the pattern predicate.
+func asExistsPattern(f *functionInvocation) (relationshipsPattern, bool) {
+	if len(f.name) != 1 || len(f.args) != 1 || !strings.EqualFold(string(f.name[0]), "exists") {
+		return relationshipsPattern{}, false
+	}
+	var expr Evaluatable = f.args[0]
+	for {
+		switch e := expr.(type) {
+		case relationshipsPattern:
+			return e, true
+		case stringListNullOperatorExpression:
+			if len(e.parts) != 0 {
+				return relationshipsPattern{}, false
+			}
+			expr = e.propertyOrLabels
+		case propertyOrLabelsExpression:
+			if len(e.propertyLookup) != 0 || e.nodeLabels != nil {
+				return relationshipsPattern{}, false
+			}
+			expr = e.atom
+		default:
+			return relationshipsPattern{}, false
+		}
+	}
+}
+
+// existsFunc returns true if the argument is not null, as in
+// exists(n.prop)
+func existsFunc(ctx *EvalContext, args []Value) (Value, error) {
+	return RValue{Value: args[0].Get() != nil}, nil
+}
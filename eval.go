@@ -3,7 +3,7 @@ package opencypher
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"regexp"
 	"strings"
 
 	"github.com/cloudprivacylabs/lpg/v2"
@@ -39,6 +39,8 @@ var (
 	ErrPropertiesParameterExpected    = errors.New("Parameter value cannot be used for properties")
 	ErrPropertiesExpected             = errors.New("Value cannot be used for properties")
 	ErrNotAnLValue                    = errors.New("Not and lvalue")
+	ErrInvalidAggregation             = errors.New("Invalid use of aggregation function")
+	ErrShortestPathNotAllowed         = errors.New("shortestPath cannot be used in CREATE or MERGE")
 )
 
 type ErrValueDoesNotHaveProperties struct {
@@ -50,6 +52,17 @@ func (e ErrValueDoesNotHaveProperties) Error() string {
 	return fmt.Sprintf("Value does not have properties: %v property: %s", e.Value, e.Property)
 }
 
+type ErrInvalidRegularExpression struct {
+	Pattern string
+	Err     error
+}
+
+func (e ErrInvalidRegularExpression) Error() string {
+	return fmt.Sprintf("Invalid regular expression: %s: %v", e.Pattern, e.Err)
+}
+
+func (e ErrInvalidRegularExpression) Unwrap() error { return e.Err }
+
 type ErrInvalidIndirection string
 
 func (e ErrInvalidIndirection) Error() string { return "Invalid indirection: " + string(e) }
@@ -85,6 +98,26 @@ func (expr stringListNullOperatorExpressionPart) evaluate(ctx *EvalContext, inpu
 		return RValue{Value: inputValue.Get() != nil}, nil
 
 	case expr.listIndex != nil:
+		switch inputValue.Get().(type) {
+		case *lpg.Node, *lpg.Edge, *lpg.Path, map[string]Value, Date, DateTime, LocalDateTime, Time, LocalTime, Duration, Point:
+			// Dynamic property lookup
+			keyValue, err := expr.listIndex.Evaluate(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if keyValue.Get() == nil {
+				return RValue{}, nil
+			}
+			key, ok := keyValue.Get().(string)
+			if !ok {
+				return nil, ErrMapKeyNotString
+			}
+			v, ok := valueProperty(inputValue.Get(), key)
+			if !ok {
+				return nil, ErrValueDoesNotHaveProperties{Value: inputValue.Get(), Property: key}
+			}
+			return RValue{Value: v.Get()}, nil
+		}
 		listValue, ok := inputValue.Get().([]Value)
 		if !ok {
 			if inputValue.Get() != nil {
@@ -123,10 +156,11 @@ func (expr stringListNullOperatorExpressionPart) evaluate(ctx *EvalContext, inpu
 			return nil, err
 		}
 		list, ok := listValue.Get().([]Value)
-		if ok {
+		if !ok {
 			if listValue.Get() != nil {
 				return nil, ErrNotAList
 			}
+			return RValue{}, nil
 		}
 		if inputValue.Get() == nil {
 			return RValue{}, nil
@@ -158,43 +192,48 @@ func (expr stringListNullOperatorExpressionPart) evaluate(ctx *EvalContext, inpu
 				return nil, ErrNotAList
 			}
 		}
-		from, err := expr.listRange.first.Evaluate(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if from.Get() == nil {
-			return RValue{}, nil
-		}
-		if !from.IsConst() {
-			constant = false
+		// getIndex evaluates a range bound. Negative indexes are from
+		// the end of the list.
+		getIndex := func(bound Expression, def int) (int, bool, error) {
+			if bound == nil {
+				return def, true, nil
+			}
+			v, err := bound.Evaluate(ctx)
+			if err != nil {
+				return 0, false, err
+			}
+			if v.Get() == nil {
+				return 0, false, nil
+			}
+			if !v.IsConst() {
+				constant = false
+			}
+			i, ok := v.Get().(int)
+			if !ok {
+				return 0, false, ErrInvalidListIndex
+			}
+			if i < 0 {
+				i += len(listValue)
+			}
+			if i < 0 {
+				i = 0
+			}
+			if i > len(listValue) {
+				i = len(listValue)
+			}
+			return i, true, nil
 		}
-		fromi, ok := from.Get().(int)
-		if !ok {
-			return nil, ErrInvalidListIndex
+		fromi, ok, err := getIndex(expr.listRange.first, 0)
+		if err != nil || !ok {
+			return RValue{}, err
 		}
-		to, err := expr.listRange.second.Evaluate(ctx)
-		if err != nil {
-			return nil, err
+		toi, ok, err := getIndex(expr.listRange.second, len(listValue))
+		if err != nil || !ok {
+			return RValue{}, err
 		}
-		if to.Get() == nil {
+		if inputValue.Get() == nil {
 			return RValue{}, nil
 		}
-		if !to.IsConst() {
-			constant = false
-		}
-		toi, ok := to.Get().(int)
-		if !ok {
-			return nil, ErrInvalidListIndex
-		}
-		if fromi < 0 || toi < 0 {
-			return nil, ErrInvalidListIndex
-		}
-		if fromi >= len(listValue) {
-			fromi = len(listValue) - 1
-		}
-		if toi >= len(listValue) {
-			toi = len(listValue) - 1
-		}
 		if fromi > toi {
 			fromi = toi
 		}
@@ -210,28 +249,53 @@ func (expr stringListNullOperatorExpressionPart) evaluate(ctx *EvalContext, inpu
 	return expr.stringOp.evaluate(ctx, inputValue)
 }
 
-func (expr stringOperatorExpression) evaluate(ctx *EvalContext, inputValue Value) (Value, error) {
+func (expr *stringOperatorExpression) evaluate(ctx *EvalContext, inputValue Value) (Value, error) {
+	if inputValue.Get() == nil {
+		return RValue{}, nil
+	}
 	inputStrValue, ok := inputValue.Get().(string)
 	if !ok {
 		return nil, ErrInvalidStringOperation
 	}
+	if expr.constRegex != nil {
+		return RValue{Value: expr.constRegex.MatchString(inputStrValue)}, nil
+	}
 	exprValue, err := expr.expr.Evaluate(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if exprValue.Get() == nil {
+		return RValue{}, nil
+	}
 	strValue, ok := exprValue.Get().(string)
 	if !ok {
 		return nil, ErrInvalidStringOperation
 	}
-	if expr.operator == "STARTS" {
+	switch expr.operator {
+	case "STARTS":
 		return RValue{Value: strings.HasPrefix(inputStrValue, strValue)}, nil
-	}
-	if expr.operator == "ENDS" {
+	case "ENDS":
 		return RValue{Value: strings.HasSuffix(inputStrValue, strValue)}, nil
+	case regexOperator:
+		re, err := compileRegex(strValue)
+		if err != nil {
+			return nil, err
+		}
+		return RValue{Value: re.MatchString(inputStrValue)}, nil
 	}
 	return RValue{Value: strings.Contains(inputStrValue, strValue)}, nil
 }
 
+// compileRegex compiles a Cypher regular expression. Cypher regular
+// expressions match the whole string
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, ErrInvalidRegularExpression{Pattern: pattern, Err: err}
+	}
+	return re, nil
+}
+
 func (pl propertyOrLabelsExpression) Evaluate(ctx *EvalContext) (Value, error) {
 	v, err := pl.atom.Evaluate(ctx)
 	if err != nil {
@@ -259,6 +323,21 @@ func (pl propertyOrLabelsExpression) Evaluate(ctx *EvalContext) (Value, error) {
 		if val.Value == nil {
 			return RValue{}, nil
 		}
+		if m, isMap := val.Value.(map[string]Value); isMap {
+			if v, exists := m[property.String()]; exists && v != nil {
+				val = RValue{Value: v.Get()}
+			} else {
+				val = RValue{}
+			}
+			continue
+		}
+		if c, hasComponents, err := valueComponent(val.Value, property.String()); hasComponents {
+			if err != nil {
+				return nil, err
+			}
+			val = c.(RValue)
+			continue
+		}
 		wp, ok := val.Value.(withProperty)
 		if !ok {
 			if path, ed := val.Value.(*lpg.Path); ed {
@@ -289,7 +368,8 @@ func (pl propertyOrLabelsExpression) Evaluate(ctx *EvalContext) (Value, error) {
 	return val, nil
 }
 
-func (f *functionInvocation) Evaluate(ctx *EvalContext) (Value, error) {
+// getFunction returns the function called by the invocation
+func (f *functionInvocation) getFunction(ctx *EvalContext) (*Function, error) {
 	if f.function == nil {
 		fname := make([]string, 0, len(f.name))
 		for _, x := range f.name {
@@ -301,11 +381,28 @@ func (f *functionInvocation) Evaluate(ctx *EvalContext) (Value, error) {
 		}
 		f.function = &fn
 	}
+	return f.function, nil
+}
+
+func (f *functionInvocation) checkArgs() error {
 	if len(f.args) < f.function.MinArgs {
-		return nil, ErrInvalidFunctionCall{Msg: fmt.Sprintf("'%s' needs at least %d arguments", f.function.Name, f.function.MinArgs)}
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("'%s' needs at least %d arguments", f.function.Name, f.function.MinArgs)}
 	}
 	if f.function.MaxArgs != -1 && len(f.args) > f.function.MaxArgs {
-		return nil, ErrInvalidFunctionCall{Msg: fmt.Sprintf("'%s' accepts at most %d arguments", f.function.Name, f.function.MaxArgs)}
+		return ErrInvalidFunctionCall{Msg: fmt.Sprintf("'%s' accepts at most %d arguments", f.function.Name, f.function.MaxArgs)}
+	}
+	return nil
+}
+
+func (f *functionInvocation) Evaluate(ctx *EvalContext) (Value, error) {
+	if _, err := f.getFunction(ctx); err != nil {
+		return nil, err
+	}
+	if f.function.IsAggregation() {
+		return ctx.getAggregateResult(f.text)
+	}
+	if err := f.checkArgs(); err != nil {
+		return nil, err
 	}
 
 	args := f.constArgs
@@ -359,6 +456,9 @@ func (cs caseClause) Evaluate(ctx *EvalContext) (Value, error) {
 				return alternative.then.Evaluate(ctx)
 			}
 		} else {
+			if when.Get() == nil {
+				continue
+			}
 			boolValue, ok := when.Get().(bool)
 			if !ok {
 				return nil, ErrNotABooleanExpression
@@ -420,107 +520,149 @@ func (query regularQuery) Evaluate(ctx *EvalContext) (Value, error) {
 }
 
 func (query singlePartQuery) Evaluate(ctx *EvalContext) (Value, error) {
-	ret := *NewResultSet()
-	skip := -1
-	limit := -1
-	if query.ret != nil {
-		ret.Cols = query.ret.projection.items.getProjectedNames()
-		var err error
-		if query.ret.projection.skip != nil {
-			skip, err = ValueAsInt(query.ret.projection.skip.Evaluate(ctx))
+	return query.evaluate(ctx, nil)
+}
+
+// evaluate runs the query using the rows produced by the earlier
+// parts of a multipart query. If input is nil, the query is not
+// preceded by a WITH clause.
+func (query singlePartQuery) evaluate(ctx *EvalContext, input *ResultSet) (Value, error) {
+	results, err := evaluateClauses(ctx, query.read, query.update, input)
+	if err != nil {
+		return nil, err
+	}
+	if query.ret == nil {
+		return RValue{Value: *NewResultSet()}, nil
+	}
+	if len(query.read) == 0 && input == nil && len(results.Rows) == 0 {
+		// Top level RETURN, or RETURN after top level updates. Project
+		// once using the variables of the context
+		results.Rows = []map[string]Value{nil}
+	}
+	ret, err := query.ret.projection.project(ctx, results, query.ret.projection.items.getProjectedNames())
+	if err != nil {
+		return nil, err
+	}
+	return RValue{Value: ret}, nil
+}
+
+// evaluateClauses runs the reading and updating clauses of a query
+// part. If input is nil, the clauses start the query. Otherwise,
+// reading clauses are evaluated for each input row, and their
+// results are joined with that row.
+func evaluateClauses(ctx *EvalContext, read []ReadingClause, update []UpdatingClause, input *ResultSet) (ResultSet, error) {
+	results := *NewResultSet()
+	if input == nil && len(read) == 0 {
+		for _, upd := range update {
+			v, err := upd.TopLevelUpdate(ctx)
 			if err != nil {
-				return nil, err
+				return ResultSet{}, err
 			}
-		}
-		if query.ret.projection.limit != nil {
-			limit, err = ValueAsInt(query.ret.projection.limit.Evaluate(ctx))
-			if err != nil {
-				return nil, err
+			if v != nil && v.Get() != nil {
+				results = v.Get().(ResultSet)
 			}
 		}
+		return results, nil
 	}
 
-	project := func(rows []map[string]Value) error {
-		for index, item := range rows {
-			if skip != -1 && index < skip {
-				continue
-			}
-			if limit != -1 && len(ret.Rows) >= limit {
-				break
-			}
-			val, err := query.ret.projection.items.Project(ctx, item)
-			if err != nil {
-				return err
-			}
-			ret.Rows = append(ret.Rows, val)
+	if input == nil {
+		rs, err := read[0].GetResults(ctx)
+		if err != nil {
+			return ResultSet{}, err
 		}
-		return nil
+		results.Add(rs)
+		read = read[1:]
+	} else {
+		results.Add(*input)
 	}
-	results := *NewResultSet()
-	if len(query.read) > 0 {
-		for _, r := range query.read {
-			rs, err := r.GetResults(ctx)
-			if err != nil {
-				return nil, err
-			}
-			results.Add(rs)
+	for _, r := range read {
+		rs, err := joinReadingClause(ctx, r, results)
+		if err != nil {
+			return ResultSet{}, err
 		}
+		results = rs
+	}
 
-		for _, upd := range query.update {
-			v, err := upd.Update(ctx, results)
-			if err != nil {
-				return nil, err
-			}
-			results = v.Get().(ResultSet)
-		}
-		if query.ret == nil {
-			return RValue{Value: *NewResultSet()}, nil
-		}
-		err := project(results.Rows)
+	for _, upd := range update {
+		v, err := upd.Update(ctx, results)
 		if err != nil {
-			return nil, err
+			return ResultSet{}, err
 		}
-		return RValue{Value: ret}, nil
+		results = v.Get().(ResultSet)
 	}
+	return results, nil
+}
 
-	for _, upd := range query.update {
-		v, err := upd.TopLevelUpdate(ctx)
+// joinReadingClause evaluates the reading clause for each row of
+// input, and returns the rows extended with the clause results
+func joinReadingClause(ctx *EvalContext, clause ReadingClause, input ResultSet) (ResultSet, error) {
+	results := *NewResultSet()
+	for _, row := range input.Rows {
+		rowCtx := ctx.SubContext()
+		rowCtx.SetVars(row)
+		rs, err := clause.GetResults(rowCtx)
 		if err != nil {
-			return nil, err
+			return ResultSet{}, err
 		}
-		if v != nil && v.Get() != nil {
-			results = v.Get().(ResultSet)
+		for _, r := range rs.Rows {
+			newRow := make(map[string]Value, len(row)+len(r))
+			for k, v := range row {
+				newRow[k] = v
+			}
+			for k, v := range r {
+				newRow[k] = v
+			}
+			results.Append(newRow)
 		}
 	}
-	if query.ret == nil {
-		return RValue{Value: *NewResultSet()}, nil
-	}
+	return results, nil
+}
 
-	if len(results.Rows) > 0 {
-		for _, row := range results.Rows {
-			val, err := query.ret.projection.items.Project(ctx, row)
-			if err != nil {
-				return nil, err
-			}
-			ret.Rows = append(ret.Rows, val)
+// Evaluate a multipart query. Each part is evaluated in its own
+// context, so only the variables projected by a WITH clause are
+// visible to the next part.
+func (mq multiPartQuery) Evaluate(ctx *EvalContext) (Value, error) {
+	var input *ResultSet
+	for _, part := range mq.parts {
+		partCtx := ctx.SubContext()
+		results, err := evaluateClauses(partCtx, part.read, part.update, input)
+		if err != nil {
+			return nil, err
 		}
-	} else {
-		val, err := query.ret.projection.items.Project(ctx, nil)
+		if input == nil && len(part.read) == 0 && len(results.Rows) == 0 {
+			// Top level updates record their results in the context
+			results.Rows = append(results.Rows, map[string]Value{})
+		}
+		projected, err := part.with.evaluate(partCtx, results)
 		if err != nil {
 			return nil, err
 		}
-		ret.Rows = append(ret.Rows, val)
+		input = &projected
 	}
-	return RValue{Value: ret}, nil
+	lastCtx := ctx.SubContext()
+	result, err := mq.singleQuery.evaluate(lastCtx, input)
+	if err != nil {
+		return nil, err
+	}
+	ctx.SetVars(lastCtx.GetVarsNearestScope())
+	return result, nil
 }
 
 func (prj projectionItems) Project(ctx *EvalContext, values map[string]Value) (map[string]Value, error) {
+	return prj.projectAs(ctx, values, prj.getProjectedNames())
+}
+
+// projectAs evaluates the projection items for the given row, and
+// stores the results using the given column names
+func (prj projectionItems) projectAs(ctx *EvalContext, values map[string]Value, names []string) (map[string]Value, error) {
 	ret := make(map[string]Value)
 	if prj.all {
 		for k, v := range values {
 			ret[k] = v
 		}
-		return ret, nil
+		if len(prj.items) == 0 {
+			return ret, nil
+		}
 	}
 
 	for k, v := range values {
@@ -531,13 +673,7 @@ func (prj projectionItems) Project(ctx *EvalContext, values map[string]Value) (m
 		if err != nil {
 			return nil, err
 		}
-		var varName string
-		if item.variable != nil {
-			varName = string(*item.variable)
-		} else {
-			varName = strconv.Itoa(i + 1)
-		}
-		ret[varName] = result
+		ret[names[i]] = result
 	}
 	return ret, nil
 }
@@ -593,12 +729,28 @@ func (pe propertyExpression) Evaluate(ctx *EvalContext) (Value, error) {
 	return val, nil
 }
 
-func (unwind unwind) GetResults(ctx *EvalContext) (ResultSet, error)      { panic("Unimplemented") }
-func (ls listComprehension) Evaluate(ctx *EvalContext) (Value, error)     { panic("Unimplemented") }
-func (p patternComprehension) Evaluate(ctx *EvalContext) (Value, error)   { panic("Unimplemented") }
-func (flt filterAtom) Evaluate(ctx *EvalContext) (Value, error)           { panic("Unimplemented") }
-func (rel relationshipsPattern) Evaluate(ctx *EvalContext) (Value, error) { panic("Unimplemented") }
-func (cnt countAtom) Evaluate(ctx *EvalContext) (Value, error)            { panic("Unimplemented") }
-func (mq multiPartQuery) Evaluate(ctx *EvalContext) (Value, error) {
-	panic("multipart query unimplemented")
+// GetResults expands the list into rows. A null or an empty list
+// results in no rows, and a value that is not a list results in a
+// single row.
+func (unwind unwind) GetResults(ctx *EvalContext) (ResultSet, error) {
+	v, err := unwind.expr.Evaluate(ctx)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	ret := *NewResultSet()
+	ret.Cols = []string{string(unwind.as)}
+	switch val := v.Get().(type) {
+	case nil:
+	case []Value:
+		for _, x := range val {
+			ret.Append(map[string]Value{string(unwind.as): RValue{Value: x.Get()}})
+		}
+	default:
+		ret.Append(map[string]Value{string(unwind.as): RValue{Value: val}})
+	}
+	return ret, nil
+}
+
+func (cnt countAtom) Evaluate(ctx *EvalContext) (Value, error) {
+	return ctx.getAggregateResult(cnt)
 }
@@ -0,0 +1,430 @@
+package opencypher
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	RegisterGlobalProcedure(
+		Procedure{
+			Name:    "fulltext.createIndex",
+			MinArgs: 3,
+			MaxArgs: 3,
+			Args:    []string{"name", "labels", "properties"},
+			Outputs: []string{"name", "nodeCount"},
+			Func:    fulltextCreateIndexProc,
+		},
+		Procedure{
+			Name:    "fulltext.queryIndex",
+			MinArgs: 2,
+			MaxArgs: 3,
+			Args:    []string{"name", "query", "config"},
+			Outputs: []string{"node", "score"},
+			Func:    fulltextQueryIndexProc,
+		},
+	)
+}
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// inexactMatchWeight is the weight of the index terms matched by
+// prefix or fuzzy matching relative to an exact match
+const inexactMatchWeight = 0.5
+
+var caseFolder = cases.Fold()
+
+// tokenize splits the text into case-folded words of letters and
+// digits
+func tokenize(text string) []string {
+	text = caseFolder.String(norm.NFKC.String(text))
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// editDistance returns the edit distance between a and b counting
+// insertions, deletions, substitutions, and transpositions of
+// adjacent characters, or max+1 if the distance is greater than max
+func editDistance(a, b []rune, max int) int {
+	if d := len(a) - len(b); d > max || -d > max {
+		return max + 1
+	}
+	// Rows i-2, i-1, and i of the distance matrix
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if prev[j]+1 < cur[j] {
+				cur[j] = prev[j] + 1
+			}
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && prev2[j-2]+1 < cur[j] {
+				cur[j] = prev2[j-2] + 1
+			}
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// fulltextPosting is an occurrence of a term in an indexed node
+type fulltextPosting struct {
+	doc  int
+	freq int
+}
+
+// fulltextIndex is an in-memory inverted index of the words in the
+// string properties of nodes
+type fulltextIndex struct {
+	labels     []string
+	properties []string
+	nodes      []*lpg.Node
+	docLengths []int
+	avgLength  float64
+	postings   map[string][]fulltextPosting
+	// terms is the sorted list of indexed terms
+	terms []string
+	// deleted are the indexed nodes deleted after the index is
+	// created
+	deleted map[*lpg.Node]struct{}
+}
+
+// removeNode marks the node deleted if it has any of the labels of
+// the index
+func (index *fulltextIndex) removeNode(node *lpg.Node) {
+	for _, label := range index.labels {
+		if node.HasLabel(label) {
+			if index.deleted == nil {
+				index.deleted = make(map[*lpg.Node]struct{})
+			}
+			index.deleted[node] = struct{}{}
+			return
+		}
+	}
+}
+
+// addText adds the words of the string, or list of strings to the
+// term frequencies of a node. Other values are ignored.
+func addText(freq map[string]int, v Value) int {
+	n := 0
+	switch val := v.Get().(type) {
+	case string:
+		for _, term := range tokenize(val) {
+			freq[term]++
+			n++
+		}
+	case []Value:
+		for _, x := range val {
+			n += addText(freq, x)
+		}
+	}
+	return n
+}
+
+// newFulltextIndex indexes the properties of the nodes that have any
+// of the labels
+func newFulltextIndex(g *lpg.Graph, labels, properties []string) *fulltextIndex {
+	ret := &fulltextIndex{
+		labels:     labels,
+		properties: properties,
+		postings:   make(map[string][]fulltextPosting),
+	}
+	seen := make(map[*lpg.Node]struct{})
+	totalLength := 0
+	for _, label := range labels {
+		for nodes := g.GetNodesWithAllLabels(lpg.NewStringSet(label)); nodes.Next(); {
+			node := nodes.Node()
+			if _, ok := seen[node]; ok {
+				continue
+			}
+			seen[node] = struct{}{}
+			freq := make(map[string]int)
+			length := 0
+			for _, property := range properties {
+				if prop, ok := node.GetProperty(property); ok && prop != nil {
+					length += addText(freq, propertyValue(prop))
+				}
+			}
+			if length == 0 {
+				continue
+			}
+			doc := len(ret.nodes)
+			ret.nodes = append(ret.nodes, node)
+			ret.docLengths = append(ret.docLengths, length)
+			totalLength += length
+			for term, n := range freq {
+				ret.postings[term] = append(ret.postings[term], fulltextPosting{doc: doc, freq: n})
+			}
+		}
+	}
+	if len(ret.nodes) > 0 {
+		ret.avgLength = float64(totalLength) / float64(len(ret.nodes))
+	}
+	for term := range ret.postings {
+		ret.terms = append(ret.terms, term)
+	}
+	sort.Strings(ret.terms)
+	return ret
+}
+
+// fulltextQueryTerm is a term of a full-text query
+type fulltextQueryTerm struct {
+	text   string
+	prefix bool
+	fuzzy  bool
+	// maxEdits is the edit distance for fuzzy matching
+	maxEdits int
+}
+
+// parseFulltextQuery parses a query of space separated words. A word
+// ending with * matches index terms with that prefix. A word ending
+// with ~ matches index terms within an edit distance that depends on
+// the length of the word, and a word ending with ~N matches index
+// terms within N edits.
+func parseFulltextQuery(query string, cfg fulltextConfig) ([]fulltextQueryTerm, error) {
+	ret := make([]fulltextQueryTerm, 0)
+	for _, word := range strings.Fields(query) {
+		prefix, fuzzy := cfg.prefix, cfg.fuzzy
+		maxEdits := -1
+		if strings.HasSuffix(word, "*") {
+			prefix = true
+			word = strings.TrimRight(word, "*")
+		} else if ix := strings.LastIndex(word, "~"); ix != -1 {
+			fuzzy = true
+			if ix < len(word)-1 {
+				n, err := strconv.Atoi(word[ix+1:])
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("Invalid edit distance: %s", word)
+				}
+				maxEdits = n
+			}
+			word = word[:ix]
+		}
+		for _, text := range tokenize(word) {
+			term := fulltextQueryTerm{text: text, prefix: prefix, fuzzy: fuzzy, maxEdits: maxEdits}
+			if term.maxEdits < 0 {
+				term.maxEdits = defaultMaxEdits(text)
+			}
+			ret = append(ret, term)
+		}
+	}
+	return ret, nil
+}
+
+// defaultMaxEdits returns the edit distance for fuzzy matching a term
+// based on its length
+func defaultMaxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	}
+	return 2
+}
+
+// matchingTerms returns the index terms matching the query term, and
+// their weights
+func (index *fulltextIndex) matchingTerms(q fulltextQueryTerm) map[string]float64 {
+	ret := make(map[string]float64)
+	if _, ok := index.postings[q.text]; ok {
+		ret[q.text] = 1
+	}
+	if q.prefix {
+		for i := sort.SearchStrings(index.terms, q.text); i < len(index.terms) && strings.HasPrefix(index.terms[i], q.text); i++ {
+			if _, ok := ret[index.terms[i]]; !ok {
+				ret[index.terms[i]] = inexactMatchWeight
+			}
+		}
+	}
+	if q.fuzzy && q.maxEdits > 0 {
+		text := []rune(q.text)
+		for _, term := range index.terms {
+			if _, ok := ret[term]; ok {
+				continue
+			}
+			if editDistance(text, []rune(term), q.maxEdits) <= q.maxEdits {
+				ret[term] = inexactMatchWeight
+			}
+		}
+	}
+	return ret
+}
+
+// search returns the BM25 score of the matching nodes. A node matches
+// if it matches any of the query terms. For each query term, the best
+// scoring index term that matches it is used.
+func (index *fulltextIndex) search(query []fulltextQueryTerm) map[int]float64 {
+	ret := make(map[int]float64)
+	n := float64(len(index.nodes))
+	for _, q := range query {
+		best := make(map[int]float64)
+		for term, weight := range index.matchingTerms(q) {
+			postings := index.postings[term]
+			df := float64(len(postings))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			for _, p := range postings {
+				tf := float64(p.freq)
+				lengthNorm := bm25K1 * (1 - bm25B + bm25B*float64(index.docLengths[p.doc])/index.avgLength)
+				score := weight * idf * tf * (bm25K1 + 1) / (tf + lengthNorm)
+				if score > best[p.doc] {
+					best[p.doc] = score
+				}
+			}
+		}
+		for doc, score := range best {
+			ret[doc] += score
+		}
+	}
+	return ret
+}
+
+// fulltextConfig is the configuration map of fulltext.queryIndex:
+//
+//	maxResults: Maximum number of nodes returned. Default is all matches.
+//	prefix: If true, all query words are prefix matched.
+//	fuzzy: If true, all query words are fuzzy matched.
+type fulltextConfig struct {
+	maxResults int
+	prefix     bool
+	fuzzy      bool
+}
+
+func parseFulltextConfig(v Value) (fulltextConfig, error) {
+	ret := fulltextConfig{maxResults: -1}
+	boolOption := func(name string, b *bool) func(Value) error {
+		return func(val Value) error {
+			x, ok := val.Get().(bool)
+			if !ok {
+				return fmt.Errorf("%s must be a boolean: %v", name, val.Get())
+			}
+			*b = x
+			return nil
+		}
+	}
+	err := parseConfig(v, map[string]func(Value) error{
+		"maxResults": func(val Value) error {
+			n, err := ValueAsInt(val)
+			if err != nil {
+				return err
+			}
+			if n < 0 {
+				return fmt.Errorf("maxResults must be non-negative: %d", n)
+			}
+			ret.maxResults = n
+			return nil
+		},
+		"prefix": boolOption("prefix", &ret.prefix),
+		"fuzzy":  boolOption("fuzzy", &ret.fuzzy),
+	})
+	return ret, err
+}
+
+// fulltextCreateIndexProc creates a named in-memory full-text index of
+// the properties of the nodes with any of the labels
+func fulltextCreateIndexProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	name, err := ValueAsString(args[0])
+	if err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+	labels, err := valueAsStrings(args[1])
+	if err != nil {
+		return fmt.Errorf("labels: %w", err)
+	}
+	properties, err := valueAsStrings(args[2])
+	if err != nil {
+		return fmt.Errorf("properties: %w", err)
+	}
+	index := newFulltextIndex(ctx.graph, labels, properties)
+	return createIndex(ctx, name, index, len(index.nodes), emit)
+}
+
+// fulltextQueryIndexProc returns the nodes of a full-text index that
+// match the query, in descending order of score. Ties are broken by
+// node id.
+func fulltextQueryIndexProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	name, x, err := lookupIndex(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	index, ok := x.(*fulltextIndex)
+	if !ok {
+		return fmt.Errorf("Full-text index not found: %s", name)
+	}
+	var cfgValue Value
+	if len(args) > 2 {
+		cfgValue = args[2]
+	}
+	cfg, err := parseFulltextConfig(cfgValue)
+	if err != nil {
+		return err
+	}
+	if args[1].Get() == nil {
+		return nil
+	}
+	str, err := ValueAsString(args[1])
+	if err != nil {
+		return fmt.Errorf("query: %w", err)
+	}
+	query, err := parseFulltextQuery(str, cfg)
+	if err != nil {
+		return err
+	}
+	scores := index.search(query)
+	docs := make([]int, 0, len(scores))
+	for doc := range scores {
+		if _, ok := index.deleted[index.nodes[doc]]; !ok {
+			docs = append(docs, doc)
+		}
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		if scores[docs[i]] != scores[docs[j]] {
+			return scores[docs[i]] > scores[docs[j]]
+		}
+		return index.nodes[docs[i]].GetID() < index.nodes[docs[j]].GetID()
+	})
+	if cfg.maxResults >= 0 && cfg.maxResults < len(docs) {
+		docs = docs[:cfg.maxResults]
+	}
+	for _, doc := range docs {
+		if err := emit(map[string]Value{
+			"node":  RValue{Value: index.nodes[doc]},
+			"score": RValue{Value: scores[doc]},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
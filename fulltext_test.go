@@ -0,0 +1,100 @@
+package opencypher
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func getFulltextGraph() *lpg.Graph {
+	g := lpg.NewGraph()
+	g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Alice Smith"})
+	g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Bob Smithson", "aliases": []string{"Bobby"}})
+	g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Carol Smyth"})
+	g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Dieter Straße"})
+	g.NewNode([]string{"Company"}, map[string]interface{}{"name": "Smith & Sons"})
+	g.NewNode([]string{"Person"}, map[string]interface{}{"age": 30})
+	g.NewNode([]string{"Street"}, map[string]interface{}{"name": "Smith Street"})
+	return g
+}
+
+func TestFulltextIndex(t *testing.T) {
+	g := getFulltextGraph()
+	ctx := NewEvalContext(g)
+	v, err := ParseAndEvaluate(`CALL fulltext.createIndex('names', ['Person', 'Company'], ['name', 'aliases']) YIELD nodeCount RETURN nodeCount AS nodeCount`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := v.Get().(ResultSet).Rows[0]["nodeCount"].Get(); n != 5 {
+		t.Errorf("Expecting 5 indexed nodes, got %v", n)
+	}
+	for query, expected := range map[string][]interface{}{
+		`CALL fulltext.queryIndex('names', 'SMITH') YIELD node RETURN node.name AS name`:                        {"Alice Smith", "Smith & Sons"},
+		`CALL fulltext.queryIndex('names', 'smith*') YIELD node RETURN node.name AS name`:                       {"Alice Smith", "Smith & Sons", "Bob Smithson"},
+		`CALL fulltext.queryIndex('names', 'smith~') YIELD node RETURN node.name AS name`:                       {"Alice Smith", "Smith & Sons", "Carol Smyth"},
+		`CALL fulltext.queryIndex('names', 'smith~0') YIELD node RETURN node.name AS name`:                      {"Alice Smith", "Smith & Sons"},
+		`CALL fulltext.queryIndex('names', 'alice smith') YIELD node RETURN node.name AS name`:                  {"Alice Smith", "Smith & Sons"},
+		`CALL fulltext.queryIndex('names', 'bob', {prefix: true}) YIELD node RETURN node.name AS name`:          {"Bob Smithson"},
+		`CALL fulltext.queryIndex('names', 'smiht sons', {fuzzy: true}) YIELD node RETURN node.name AS name`:    {"Smith & Sons", "Alice Smith"},
+		`CALL fulltext.queryIndex('names', 'strasse') YIELD node RETURN node.name AS name`:                      {"Dieter Straße"},
+		`CALL fulltext.queryIndex('names', 'smith*', {maxResults: 1}) YIELD node RETURN node.name AS name`:      {"Alice Smith"},
+		`CALL fulltext.queryIndex('names', 'street') YIELD node RETURN node.name AS name`:                       {},
+		`CALL fulltext.queryIndex('names', 'smith') YIELD node, score WHERE score > 0 RETURN node.name AS name`: {"Alice Smith", "Smith & Sons"},
+	} {
+		if got := columnValues(runTestMatch(t, query, g), "name"); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v, got %v", query, expected, got)
+		}
+	}
+	// The index belongs to the graph, and skips deleted nodes
+	ctx = NewEvalContext(g)
+	if _, err := ParseAndEvaluate(`MATCH (n:Person {name: 'Alice Smith'}) DETACH DELETE n`, ctx); err != nil {
+		t.Fatal(err)
+	}
+	query := `CALL fulltext.queryIndex('names', 'smith') YIELD node RETURN node.name AS name`
+	if got := columnValues(runTestMatch(t, query, g), "name"); !reflect.DeepEqual(got, []interface{}{"Smith & Sons"}) {
+		t.Errorf("%s: got %v", query, got)
+	}
+	if _, err := ParseAndEvaluate(`CALL fulltext.queryIndex('names', 'smith') YIELD node RETURN node`, NewEvalContext(getFulltextGraph())); err == nil {
+		t.Errorf("Expecting error for the index of another graph")
+	}
+	DropIndexes(g)
+	if _, err := ParseAndEvaluate(`CALL fulltext.queryIndex('names', 'smith') YIELD node RETURN node`, ctx); err == nil {
+		t.Errorf("Expecting error for a dropped index")
+	}
+	for _, query := range []string{
+		`CALL fulltext.queryIndex('missing', 'smith') YIELD node RETURN node`,
+		`CALL fulltext.queryIndex('names', 'smith', {maxEdits: 1}) YIELD node RETURN node`,
+		`CALL fulltext.queryIndex('names', 'smith~x') YIELD node RETURN node`,
+		`CALL fulltext.createIndex('names', 1, 'name') YIELD name RETURN name`,
+	} {
+		if _, err := ParseAndEvaluate(query, ctx); err == nil {
+			t.Errorf("%s: Expecting error", query)
+		}
+	}
+}
+
+func TestDropIndexesReleasesGraph(t *testing.T) {
+	released := make(chan struct{})
+	func() {
+		g := getFulltextGraph()
+		runTestMatch(t, `CALL fulltext.createIndex('names', 'Person', 'name') YIELD name RETURN name`, g)
+		// The graph and its nodes form a cycle, so the finalizer is set
+		// on a value that is only reachable from the graph
+		marker := &struct{ data [64]byte }{}
+		runtime.SetFinalizer(marker, func(interface{}) { close(released) })
+		g.NewNode([]string{"Marker"}, map[string]interface{}{"marker": marker})
+		DropIndexes(g)
+	}()
+	for i := 0; i < 20; i++ {
+		runtime.GC()
+		select {
+		case <-released:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	t.Errorf("Graph is not released after DropIndexes")
+}
@@ -3,6 +3,7 @@ package opencypher
 import (
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"github.com/cloudprivacylabs/lpg/v2"
 )
@@ -14,6 +15,14 @@ type Function struct {
 	MaxArgs   int
 	Func      func(*EvalContext, []Evaluatable) (Value, error)
 	ValueFunc func(*EvalContext, []Value) (Value, error)
+	// NewAggregator is set for aggregation functions. It is called to
+	// create a new aggregator for each group of rows.
+	NewAggregator func() Aggregator
+}
+
+// IsAggregation returns true if the function is an aggregation function
+func (f Function) IsAggregation() bool {
+	return f.NewAggregator != nil
 }
 
 type ErrInvalidFunctionCall struct {
@@ -79,16 +88,16 @@ func rangeFunc(ctx *EvalContext, args []Value) (Value, error) {
 			return nil, err
 		}
 	}
-	if (end <= start && skip > 0) || (end >= start && skip < 0) || skip == 0 {
-		return RValue{Value: []Value{}}, nil
+	if skip == 0 {
+		return nil, fmt.Errorf("In range: step cannot be 0")
 	}
 	arr := make([]Value, 0)
-	if end > start {
-		for at := start; at < end; at += skip {
+	if skip > 0 {
+		for at := start; at <= end; at += skip {
 			arr = append(arr, RValue{Value: at})
 		}
 	} else {
-		for at := start; at > end; at += skip {
+		for at := start; at >= end; at += skip {
 			arr = append(arr, RValue{Value: at})
 		}
 	}
@@ -111,6 +120,9 @@ func timestampFunc(ctx *EvalContext, args []Value) (Value, error) {
 }
 
 func typeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
 	edge, ok := args[0].Get().(*lpg.Edge)
 	if ok {
 		return RValue{Value: edge.GetLabel()}, nil
@@ -131,7 +143,7 @@ func sizeFunc(ctx *EvalContext, args []Value) (Value, error) {
 		return RValue{Value: len(arr)}, nil
 	}
 	if str, ok := val.(string); ok {
-		return RValue{Value: len(str)}, nil
+		return RValue{Value: utf8.RuneCountInString(str)}, nil
 	}
 	return RValue{}, nil
 }
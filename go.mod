@@ -6,11 +6,10 @@ require (
 	github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20210803070921-b358b509191a
 	github.com/cloudprivacylabs/lpg/v2 v2.0.0
 	github.com/nleeper/goment v1.4.4
+	github.com/tkuchiki/go-timezone v0.2.0
+	golang.org/x/text v0.13.0
 )
 
 require github.com/stretchr/testify v1.7.0 // indirect
 
-require (
-	github.com/emirpasic/gods v1.18.1 // indirect
-	github.com/tkuchiki/go-timezone v0.2.0 // indirect
-)
+require github.com/emirpasic/gods v1.18.1 // indirect
@@ -0,0 +1,432 @@
+package opencypher
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func init() {
+	RegisterGlobalProcedure(
+		Procedure{
+			Name:    "algo.wcc",
+			MaxArgs: 1,
+			Args:    []string{"config"},
+			Outputs: []string{"node", "componentId"},
+			Func:    graphAlgoProc("componentId", wccAlgo),
+		},
+		Procedure{
+			Name:    "algo.scc",
+			MaxArgs: 1,
+			Args:    []string{"config"},
+			Outputs: []string{"node", "componentId"},
+			Func:    graphAlgoProc("componentId", sccAlgo),
+		},
+		Procedure{
+			Name:    "algo.pageRank",
+			MaxArgs: 1,
+			Args:    []string{"config"},
+			Outputs: []string{"node", "score"},
+			Func:    graphAlgoProc("score", pageRankAlgo),
+		},
+		Procedure{
+			Name:    "algo.degree",
+			MaxArgs: 1,
+			Args:    []string{"config"},
+			Outputs: []string{"node", "degree"},
+			Func:    graphAlgoProc("degree", degreeAlgo),
+		},
+		Procedure{
+			Name:    "algo.triangleCount",
+			MaxArgs: 1,
+			Args:    []string{"config"},
+			Outputs: []string{"node", "triangleCount"},
+			Func:    graphAlgoProc("triangleCount", triangleCountAlgo),
+		},
+		Procedure{
+			Name:    "algo.labelPropagation",
+			MaxArgs: 1,
+			Args:    []string{"config"},
+			Outputs: []string{"node", "communityId"},
+			Func:    graphAlgoProc("communityId", labelPropagationAlgo),
+		},
+	)
+}
+
+// algoConfig is the configuration map accepted by the graph algorithm
+// procedures:
+//
+//	nodeLabels: Only nodes with one of these labels are included. Default is all nodes.
+//	relTypes: Only edges with one of these labels are included. Default is all edges.
+//	direction: OUTGOING, INCOMING, or BOTH, for degree centrality. Default is OUTGOING.
+//	iterations: Maximum number of iterations for pageRank and labelPropagation.
+//	dampingFactor: PageRank damping factor. Default is 0.85.
+//	tolerance: PageRank stops when scores change less than this. Default is 1e-7.
+//	writeProperty: If given, the result is also written to this node property.
+type algoConfig struct {
+	nodeLabels    []string
+	relTypes      []string
+	outgoing      bool
+	incoming      bool
+	iterations    int
+	dampingFactor float64
+	tolerance     float64
+	writeProperty string
+}
+
+func parseAlgoConfig(args []Value) (algoConfig, error) {
+	ret := algoConfig{
+		outgoing:      true,
+		dampingFactor: 0.85,
+		tolerance:     1e-7,
+	}
+	if len(args) == 0 {
+		return ret, nil
+	}
+	err := parseConfig(args[0], map[string]func(Value) error{
+		"nodeLabels": func(v Value) (err error) {
+			ret.nodeLabels, err = valueAsStrings(v)
+			return
+		},
+		"relTypes": func(v Value) (err error) {
+			ret.relTypes, err = valueAsStrings(v)
+			return
+		},
+		"direction": func(v Value) (err error) {
+			ret.outgoing, ret.incoming, err = parseDirection(v)
+			return
+		},
+		"iterations": func(v Value) (err error) {
+			ret.iterations, err = ValueAsInt(v)
+			return
+		},
+		"dampingFactor": func(v Value) (err error) {
+			ret.dampingFactor, err = valueAsFloat(v)
+			return
+		},
+		"tolerance": func(v Value) (err error) {
+			ret.tolerance, err = valueAsFloat(v)
+			return
+		},
+		"writeProperty": func(v Value) (err error) {
+			ret.writeProperty, err = ValueAsString(v)
+			return
+		},
+	})
+	return ret, err
+}
+
+// algoGraph is the subgraph an algorithm runs on. Nodes are indexed
+// in the order of their IDs, and edges are kept as adjacency lists
+// of node indexes.
+type algoGraph struct {
+	nodes []*lpg.Node
+	out   [][]int
+	in    [][]int
+}
+
+func newAlgoGraph(g *lpg.Graph, cfg algoConfig) algoGraph {
+	ret := algoGraph{}
+	labels := lpg.NewStringSet(cfg.nodeLabels...)
+	for nodes := g.GetNodes(); nodes.Next(); {
+		if node := nodes.Node(); labels.Len() == 0 || node.GetLabels().HasAnySet(labels) {
+			ret.nodes = append(ret.nodes, node)
+		}
+	}
+	sort.Slice(ret.nodes, func(i, j int) bool { return ret.nodes[i].GetID() < ret.nodes[j].GetID() })
+	index := make(map[*lpg.Node]int, len(ret.nodes))
+	for i, node := range ret.nodes {
+		index[node] = i
+	}
+	ret.out = make([][]int, len(ret.nodes))
+	ret.in = make([][]int, len(ret.nodes))
+	edgeFilter := lpg.GetEdgeFilterFunc(lpg.NewStringSet(cfg.relTypes...), nil)
+	for i, node := range ret.nodes {
+		for edges := node.GetEdges(lpg.OutgoingEdge); edges.Next(); {
+			edge := edges.Edge()
+			if !edgeFilter(edge) {
+				continue
+			}
+			j, ok := index[edge.GetTo()]
+			if !ok {
+				continue
+			}
+			ret.out[i] = append(ret.out[i], j)
+			ret.in[j] = append(ret.in[j], i)
+		}
+	}
+	return ret
+}
+
+// neighbors returns the distinct neighbors of node i ignoring
+// direction, excluding i itself
+func (g algoGraph) neighbors(i int) []int {
+	seen := make(map[int]struct{})
+	ret := make([]int, 0, len(g.out[i])+len(g.in[i]))
+	for _, list := range [][]int{g.out[i], g.in[i]} {
+		for _, j := range list {
+			if _, ok := seen[j]; ok || j == i {
+				continue
+			}
+			seen[j] = struct{}{}
+			ret = append(ret, j)
+		}
+	}
+	sort.Ints(ret)
+	return ret
+}
+
+// graphAlgoProc returns a procedure that runs the algorithm and
+// emits a row for each node, writing the results to the nodes if
+// the config has writeProperty
+func graphAlgoProc(column string, algo func(algoGraph, algoConfig) []interface{}) func(*EvalContext, []Value, func(map[string]Value) error) error {
+	return func(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+		cfg, err := parseAlgoConfig(args)
+		if err != nil {
+			return err
+		}
+		g := newAlgoGraph(ctx.graph, cfg)
+		results := algo(g, cfg)
+		for i, node := range g.nodes {
+			if len(cfg.writeProperty) > 0 {
+				node.SetProperty(cfg.writeProperty, ctx.PropertyValueFromNative(cfg.writeProperty, results[i]))
+			}
+			if err := emit(map[string]Value{"node": RValue{Value: node}, column: RValue{Value: results[i]}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// componentIds assigns the smallest node ID in each component as the
+// component ID
+func (g algoGraph) componentIds(component []int) []interface{} {
+	ids := make(map[int]int)
+	for i, c := range component {
+		if id, ok := ids[c]; !ok || g.nodes[i].GetID() < id {
+			ids[c] = g.nodes[i].GetID()
+		}
+	}
+	ret := make([]interface{}, len(component))
+	for i, c := range component {
+		ret[i] = ids[c]
+	}
+	return ret
+}
+
+// wccAlgo finds weakly connected components using union-find
+func wccAlgo(g algoGraph, cfg algoConfig) []interface{} {
+	parent := make([]int, len(g.nodes))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i, list := range g.out {
+		for _, j := range list {
+			if a, b := find(i), find(j); a != b {
+				parent[b] = a
+			}
+		}
+	}
+	component := make([]int, len(g.nodes))
+	for i := range component {
+		component[i] = find(i)
+	}
+	return g.componentIds(component)
+}
+
+// sccAlgo finds strongly connected components using Tarjan's
+// algorithm
+func sccAlgo(g algoGraph, cfg algoConfig) []interface{} {
+	n := len(g.nodes)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	component := make([]int, n)
+	for i := range index {
+		index[i] = -1
+	}
+	stack := make([]int, 0)
+	counter, nComponents := 0, 0
+	var connect func(int)
+	connect = func(v int) {
+		index[v], low[v] = counter, counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range g.out[v] {
+			if index[w] == -1 {
+				connect(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && index[w] < low[v] {
+				low[v] = index[w]
+			}
+		}
+		if low[v] == index[v] {
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component[w] = nComponents
+				if w == v {
+					break
+				}
+			}
+			nComponents++
+		}
+	}
+	for i := 0; i < n; i++ {
+		if index[i] == -1 {
+			connect(i)
+		}
+	}
+	return g.componentIds(component)
+}
+
+// pageRankAlgo computes PageRank scores using power iteration. Nodes
+// without outgoing edges do not distribute their scores.
+func pageRankAlgo(g algoGraph, cfg algoConfig) []interface{} {
+	iterations := cfg.iterations
+	if iterations <= 0 {
+		iterations = 20
+	}
+	n := len(g.nodes)
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 - cfg.dampingFactor
+	}
+	for iter := 0; iter < iterations; iter++ {
+		next := make([]float64, n)
+		delta := 0.0
+		for i := range next {
+			sum := 0.0
+			for _, j := range g.in[i] {
+				sum += scores[j] / float64(len(g.out[j]))
+			}
+			next[i] = 1 - cfg.dampingFactor + cfg.dampingFactor*sum
+			delta = math.Max(delta, math.Abs(next[i]-scores[i]))
+		}
+		scores = next
+		if delta < cfg.tolerance {
+			break
+		}
+	}
+	ret := make([]interface{}, n)
+	for i, s := range scores {
+		ret[i] = s
+	}
+	return ret
+}
+
+// degreeAlgo computes the number of edges of each node in the
+// configured direction
+func degreeAlgo(g algoGraph, cfg algoConfig) []interface{} {
+	ret := make([]interface{}, len(g.nodes))
+	for i := range g.nodes {
+		degree := 0
+		if cfg.outgoing {
+			degree += len(g.out[i])
+		}
+		if cfg.incoming {
+			degree += len(g.in[i])
+		}
+		ret[i] = degree
+	}
+	return ret
+}
+
+// triangleCountAlgo counts the triangles each node is part of,
+// ignoring edge directions, self loops, and parallel edges
+func triangleCountAlgo(g algoGraph, cfg algoConfig) []interface{} {
+	n := len(g.nodes)
+	adj := make([]map[int]struct{}, n)
+	neighbors := make([][]int, n)
+	for i := range adj {
+		neighbors[i] = g.neighbors(i)
+		adj[i] = make(map[int]struct{}, len(neighbors[i]))
+		for _, j := range neighbors[i] {
+			adj[i][j] = struct{}{}
+		}
+	}
+	counts := make([]int, n)
+	for u := 0; u < n; u++ {
+		for _, v := range neighbors[u] {
+			if v <= u {
+				continue
+			}
+			for _, w := range neighbors[v] {
+				if w <= v {
+					continue
+				}
+				if _, ok := adj[u][w]; ok {
+					counts[u]++
+					counts[v]++
+					counts[w]++
+				}
+			}
+		}
+	}
+	ret := make([]interface{}, n)
+	for i, c := range counts {
+		ret[i] = c
+	}
+	return ret
+}
+
+// labelPropagationAlgo detects communities by repeatedly assigning
+// each node the most frequent label among its neighbors, ignoring
+// edge directions. Ties are broken using the smallest label. Labels
+// are initialized to node IDs.
+func labelPropagationAlgo(g algoGraph, cfg algoConfig) []interface{} {
+	iterations := cfg.iterations
+	if iterations <= 0 {
+		iterations = 10
+	}
+	n := len(g.nodes)
+	labels := make([]int, n)
+	neighbors := make([][]int, n)
+	for i := range labels {
+		labels[i] = g.nodes[i].GetID()
+		neighbors[i] = g.neighbors(i)
+	}
+	for iter := 0; iter < iterations; iter++ {
+		changed := false
+		for i := range labels {
+			if len(neighbors[i]) == 0 {
+				continue
+			}
+			freq := make(map[int]int)
+			for _, j := range neighbors[i] {
+				freq[labels[j]]++
+			}
+			best, bestCount := labels[i], 0
+			for label, count := range freq {
+				if count > bestCount || (count == bestCount && label < best) {
+					best, bestCount = label, count
+				}
+			}
+			if best != labels[i] {
+				labels[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	ret := make([]interface{}, n)
+	for i, l := range labels {
+		ret[i] = l
+	}
+	return ret
+}
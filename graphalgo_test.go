@@ -0,0 +1,71 @@
+package opencypher
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func algoResults(t *testing.T, query string, g *lpg.Graph, column string) map[string]interface{} {
+	rs := runTestMatch(t, query, g)
+	ret := make(map[string]interface{})
+	for _, row := range rs.Rows {
+		name, _ := row["node"].Get().(*lpg.Node).GetProperty("name")
+		ret[name.(string)] = row[column].Get()
+	}
+	return ret
+}
+
+func TestGraphAlgorithms(t *testing.T) {
+	// Two components:
+	//
+	//	a -> b -> c -> a, c -> d, d -> e  (a, b, c form a cycle and a triangle)
+	//	f -> g
+	g, _ := getNamedGraph("Host", []string{"a", "b", "c", "d", "e", "f", "g"}, [][3]string{{"a", "b", "LINK"}, {"b", "c", "LINK"}, {"c", "a", "LINK"}, {"c", "d", "LINK"}, {"d", "e", "LINK"}, {"f", "g", "LINK"}})
+	wcc := algoResults(t, `CALL algo.wcc()`, g, "componentId")
+	if len(wcc) != 7 || wcc["a"] != wcc["e"] || wcc["f"] != wcc["g"] || wcc["a"] == wcc["f"] {
+		t.Errorf("Wrong wcc: %v", wcc)
+	}
+	scc := algoResults(t, `CALL algo.scc()`, g, "componentId")
+	if scc["a"] != scc["b"] || scc["a"] != scc["c"] || scc["a"] == scc["d"] || scc["d"] == scc["e"] {
+		t.Errorf("Wrong scc: %v", scc)
+	}
+	degree := algoResults(t, `CALL algo.degree({direction:'BOTH'})`, g, "degree")
+	if degree["c"] != 3 || degree["e"] != 1 {
+		t.Errorf("Wrong degree: %v", degree)
+	}
+	degree = algoResults(t, `CALL algo.degree({direction:'INCOMING'})`, g, "degree")
+	if degree["c"] != 1 || degree["f"] != 0 {
+		t.Errorf("Wrong degree: %v", degree)
+	}
+	triangles := algoResults(t, `CALL algo.triangleCount()`, g, "triangleCount")
+	if triangles["a"] != 1 || triangles["c"] != 1 || triangles["d"] != 0 {
+		t.Errorf("Wrong triangles: %v", triangles)
+	}
+	communities := algoResults(t, `CALL algo.labelPropagation()`, g, "communityId")
+	if communities["a"] != communities["b"] || communities["f"] != communities["g"] || communities["a"] == communities["f"] {
+		t.Errorf("Wrong communities: %v", communities)
+	}
+	pr := algoResults(t, `CALL algo.pageRank({iterations: 50})`, g, "score")
+	if pr["e"].(float64) <= pr["d"].(float64) || math.Abs(pr["f"].(float64)-0.15) > 1e-9 || math.Abs(pr["g"].(float64)-0.2775) > 1e-9 {
+		t.Errorf("Wrong pageRank: %v", pr)
+	}
+
+	// Filtered and written back
+	runTestMatch(t, `CALL algo.wcc({relTypes:['OTHER'], writeProperty:'component'}) YIELD node RETURN count(node)`, g)
+	rs := runTestMatch(t, `MATCH (n:Host) WITH count(DISTINCT n.component) AS c RETURN c AS c`, g)
+	if rs.Rows[0]["c"].Get() != 7 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `CALL algo.pageRank({nodeLabels:['Host'], writeProperty:'rank'}) YIELD node, score WHERE score > 0.5 RETURN node.name AS name, node.rank = score AS same ORDER BY name`, g)
+	if len(rs.Rows) == 0 || rs.Rows[0]["same"].Get() != true {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	if rs := runTestMatch(t, `CALL algo.degree({nodeLabels:'Router'})`, g); len(rs.Rows) != 0 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	if _, err := ParseAndEvaluate(`CALL algo.pageRank({damping:0.5})`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error")
+	}
+}
@@ -27,6 +27,10 @@ func (lst *errorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymb
 	}
 }
 
+// regexOperator is the token text of the regular expression match
+// operator
+const regexOperator = "=~"
+
 // GetParser returns a parser that will parse the input string
 func GetParser(input string) *parser.CypherParser {
 	lexer := parser.NewCypherLexer(antlr.NewInputStream(input))
@@ -0,0 +1,168 @@
+package opencypher
+
+// iterate evaluates the list of the filter expression, and calls f
+// for each element with the result of the WHERE predicate. The
+// predicate result is nil if it evaluates to null, or if there is no
+// WHERE predicate. Returns false if the list is null.
+func (flt filterExpression) iterate(ctx *EvalContext, f func(elemCtx *EvalContext, elem Value, pred *bool) error) (bool, error) {
+	v, err := flt.inExpr.Evaluate(ctx)
+	if err != nil {
+		return false, err
+	}
+	if v.Get() == nil {
+		return false, nil
+	}
+	list, ok := v.Get().([]Value)
+	if !ok {
+		return false, ErrNotAList
+	}
+	for _, elem := range list {
+		elemCtx := ctx.SubContext()
+		elemCtx.SetVar(string(flt.variable), RValue{Value: elem.Get()})
+		var pred *bool
+		if flt.where != nil {
+			result, err := flt.where.Evaluate(elemCtx)
+			if err != nil {
+				return false, err
+			}
+			pred, err = predicateValue(result)
+			if err != nil {
+				return false, err
+			}
+		}
+		if err := f(elemCtx, elem, pred); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// predicateValue returns nil if the value is null, otherwise it
+// returns the boolean value
+func predicateValue(v Value) (*bool, error) {
+	if v.Get() == nil {
+		return nil, nil
+	}
+	b, ok := v.Get().(bool)
+	if !ok {
+		return nil, ErrNotABooleanExpression
+	}
+	return &b, nil
+}
+
+func (ls listComprehension) Evaluate(ctx *EvalContext) (Value, error) {
+	ret := make([]Value, 0)
+	ok, err := ls.filter.iterate(ctx, func(elemCtx *EvalContext, elem Value, pred *bool) error {
+		if ls.filter.where != nil && (pred == nil || !*pred) {
+			return nil
+		}
+		if ls.expr == nil {
+			ret = append(ret, RValue{Value: elem.Get()})
+			return nil
+		}
+		v, err := ls.expr.Evaluate(elemCtx)
+		if err != nil {
+			return err
+		}
+		ret = append(ret, RValue{Value: v.Get()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: ret}, nil
+}
+
+// Evaluate ALL, ANY, NONE, and SINGLE list predicates using
+// three-valued logic: if the result cannot be decided because some
+// predicates are null, the result is null.
+func (flt filterAtom) Evaluate(ctx *EvalContext) (Value, error) {
+	var nTrue, nFalse, nNull int
+	ok, err := flt.filter.iterate(ctx, func(elemCtx *EvalContext, elem Value, pred *bool) error {
+		if flt.filter.where == nil {
+			// The elements are the predicates
+			var err error
+			if pred, err = predicateValue(elem); err != nil {
+				return err
+			}
+		}
+		switch {
+		case pred == nil:
+			nNull++
+		case *pred:
+			nTrue++
+		default:
+			nFalse++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	switch flt.op {
+	case "ALL":
+		if nFalse > 0 {
+			return RValue{Value: false}, nil
+		}
+	case "ANY":
+		if nTrue > 0 {
+			return RValue{Value: true}, nil
+		}
+	case "NONE":
+		if nTrue > 0 {
+			return RValue{Value: false}, nil
+		}
+	case "SINGLE":
+		if nTrue > 1 {
+			return RValue{Value: false}, nil
+		}
+	}
+	if nNull > 0 {
+		return RValue{}, nil
+	}
+	switch flt.op {
+	case "ALL":
+		return RValue{Value: true}, nil
+	case "ANY":
+		return RValue{Value: false}, nil
+	case "NONE":
+		return RValue{Value: true}, nil
+	}
+	return RValue{Value: nTrue == 1}, nil
+}
+
+// Evaluate evaluates expr for each element of the list with the
+// accumulator set to the result of the previous element. Returns null
+// if the list is null.
+func (r reduceExpression) Evaluate(ctx *EvalContext) (Value, error) {
+	acc, err := r.init.Evaluate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	acc = RValue{Value: acc.Get()}
+	ok, err := r.filter.iterate(ctx, func(elemCtx *EvalContext, elem Value, pred *bool) error {
+		if r.filter.where != nil && (pred == nil || !*pred) {
+			return nil
+		}
+		elemCtx.SetVar(string(r.accumulator), acc)
+		v, err := r.expr.Evaluate(elemCtx)
+		if err != nil {
+			return err
+		}
+		acc = RValue{Value: v.Get()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return acc, nil
+}
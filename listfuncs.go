@@ -0,0 +1,235 @@
+package opencypher
+
+import (
+	"fmt"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "head",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: headFunc,
+		},
+		Function{
+			Name:      "last",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: lastFunc,
+		},
+		Function{
+			Name:      "tail",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: tailFunc,
+		},
+		Function{
+			Name:      "keys",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: keysFunc,
+		},
+		Function{
+			Name:      "properties",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: propertiesFunc,
+		},
+		Function{
+			Name:      "coalesce",
+			MinArgs:   1,
+			MaxArgs:   -1,
+			ValueFunc: coalesceFunc,
+		},
+		Function{
+			Name:      "coll.toSet",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: collToSetFunc,
+		},
+		Function{
+			Name:      "coll.setUnion",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: collSetUnionFunc,
+		},
+		Function{
+			Name:      "coll.setIntersection",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: collSetIntersectionFunc,
+		},
+	)
+}
+
+// valueAsList returns the list, or nil if the value is null
+func valueAsList(fname string, v Value) ([]Value, error) {
+	if v.Get() == nil {
+		return nil, nil
+	}
+	list, ok := v.Get().([]Value)
+	if !ok {
+		return nil, fmt.Errorf("In %s: %w", fname, ErrNotAList)
+	}
+	return list, nil
+}
+
+func headFunc(ctx *EvalContext, args []Value) (Value, error) {
+	list, err := valueAsList("head", args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return RValue{}, nil
+	}
+	return RValue{Value: list[0].Get()}, nil
+}
+
+func lastFunc(ctx *EvalContext, args []Value) (Value, error) {
+	list, err := valueAsList("last", args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return RValue{}, nil
+	}
+	return RValue{Value: list[len(list)-1].Get()}, nil
+}
+
+func tailFunc(ctx *EvalContext, args []Value) (Value, error) {
+	list, err := valueAsList("tail", args[0])
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return RValue{}, nil
+	}
+	out := make([]Value, 0, len(list))
+	if len(list) > 1 {
+		out = append(out, list[1:]...)
+	}
+	return RValue{Value: out}, nil
+}
+
+// keysFunc returns the sorted property keys of a node, edge, or map
+func keysFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	props, ok := valueProperties(args[0].Get())
+	if !ok {
+		return nil, fmt.Errorf("In keys: Value does not have properties: %v", args[0].Get())
+	}
+	out := make([]Value, 0, len(props))
+	for _, k := range sortedKeys(props) {
+		out = append(out, RValue{Value: k})
+	}
+	return RValue{Value: out}, nil
+}
+
+// propertiesFunc returns the properties of a node, edge, or map as a
+// map
+func propertiesFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	props, ok := valueProperties(args[0].Get())
+	if !ok {
+		return nil, fmt.Errorf("In properties: Value does not have properties: %v", args[0].Get())
+	}
+	out := make(map[string]Value, len(props))
+	for k, v := range props {
+		out[k] = RValue{Value: v.Get()}
+	}
+	return RValue{Value: out}, nil
+}
+
+// coalesceFunc returns the first non-null argument
+func coalesceFunc(ctx *EvalContext, args []Value) (Value, error) {
+	for _, arg := range args {
+		if arg.Get() != nil {
+			return RValue{Value: arg.Get()}, nil
+		}
+	}
+	return RValue{}, nil
+}
+
+// distinctValues appends the elements of the lists that are not
+// already in seen to out
+func distinctValues(out []Value, seen map[string]struct{}, lists ...[]Value) []Value {
+	for _, list := range lists {
+		for _, x := range list {
+			key := valueHashKey(x)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			out = append(out, RValue{Value: x.Get()})
+		}
+	}
+	return out
+}
+
+// collToSetFunc returns the distinct elements of a list, keeping
+// the order of their first occurrence
+func collToSetFunc(ctx *EvalContext, args []Value) (Value, error) {
+	list, err := valueAsList("coll.toSet", args[0])
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return RValue{}, nil
+	}
+	return RValue{Value: distinctValues(make([]Value, 0, len(list)), make(map[string]struct{}), list)}, nil
+}
+
+// collSetUnionFunc returns the distinct elements of both lists
+func collSetUnionFunc(ctx *EvalContext, args []Value) (Value, error) {
+	list1, err := valueAsList("coll.setUnion", args[0])
+	if err != nil {
+		return nil, err
+	}
+	list2, err := valueAsList("coll.setUnion", args[1])
+	if err != nil {
+		return nil, err
+	}
+	if list1 == nil || list2 == nil {
+		return RValue{}, nil
+	}
+	return RValue{Value: distinctValues(make([]Value, 0, len(list1)+len(list2)), make(map[string]struct{}), list1, list2)}, nil
+}
+
+// collSetIntersectionFunc returns the distinct elements of the first
+// list that are also in the second list
+func collSetIntersectionFunc(ctx *EvalContext, args []Value) (Value, error) {
+	list1, err := valueAsList("coll.setIntersection", args[0])
+	if err != nil {
+		return nil, err
+	}
+	list2, err := valueAsList("coll.setIntersection", args[1])
+	if err != nil {
+		return nil, err
+	}
+	if list1 == nil || list2 == nil {
+		return RValue{}, nil
+	}
+	in2 := make(map[string]struct{}, len(list2))
+	for _, x := range list2 {
+		in2[valueHashKey(x)] = struct{}{}
+	}
+	out := make([]Value, 0)
+	seen := make(map[string]struct{})
+	for _, x := range list1 {
+		key := valueHashKey(x)
+		if _, ok := in2[key]; !ok {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, RValue{Value: x.Get()})
+	}
+	return RValue{Value: out}, nil
+}
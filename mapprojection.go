@@ -0,0 +1,133 @@
+package opencypher
+
+import (
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+// mapProjection is a map projection of the form
+//
+//	n {.name, .*, key: expr, x}
+type mapProjection struct {
+	variable variable
+	items    []mapProjectionItem
+}
+
+// mapProjectionItem is either a property selector, or a key-value
+// pair. Property is "*" for all properties.
+type mapProjectionItem struct {
+	property string
+	key      string
+	value    Expression
+}
+
+// valueProperties returns the properties of a node, edge, or map. The
+// second return value is false if the value does not have
+// properties.
+func valueProperties(v interface{}) (map[string]Value, bool) {
+	var props interface {
+		ForEachProperty(func(string, interface{}) bool) bool
+	}
+	switch val := v.(type) {
+	case map[string]Value:
+		return val, true
+	case *lpg.Node:
+		props = val
+	case *lpg.Edge:
+		props = val
+	case *lpg.Path:
+		if val.NumEdges() != 1 {
+			return nil, false
+		}
+		props = val.GetEdge(0)
+	default:
+		return nil, false
+	}
+	ret := make(map[string]Value)
+	props.ForEachProperty(func(key string, value interface{}) bool {
+		ret[key] = propertyValue(value)
+		return true
+	})
+	return ret, true
+}
+
+// valueProperty returns the property of a node, edge, or map, or the
+// component of a temporal value or a point. The second return value is false if
+// the value does not have properties.
+func valueProperty(v interface{}, key string) (Value, bool) {
+	var props interface {
+		GetProperty(string) (interface{}, bool)
+	}
+	switch val := v.(type) {
+	case map[string]Value:
+		if x, ok := val[key]; ok {
+			return x, true
+		}
+		return RValue{}, true
+	case *lpg.Node:
+		props = val
+	case *lpg.Edge:
+		props = val
+	case *lpg.Path:
+		if val.NumEdges() != 1 {
+			return nil, false
+		}
+		props = val.GetEdge(0)
+	default:
+		if c, hasComponents, err := valueComponent(v, key); hasComponents && err == nil {
+			return c, true
+		}
+		return nil, false
+	}
+	prop, ok := props.GetProperty(key)
+	if !ok {
+		return RValue{}, true
+	}
+	return propertyValue(prop), true
+}
+
+// propertyValue returns the value for a node or edge property
+func propertyValue(prop interface{}) Value {
+	if n, ok := prop.(interface{ GetNativeValue() interface{} }); ok {
+		return ValueOf(n.GetNativeValue())
+	}
+	return ValueOf(prop)
+}
+
+// Evaluate builds a map from the properties of the variable and the
+// key-value pairs. Returns null if the variable is null.
+func (mp mapProjection) Evaluate(ctx *EvalContext) (Value, error) {
+	v, err := mp.variable.Evaluate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v.Get() == nil {
+		return RValue{}, nil
+	}
+	ret := make(map[string]Value)
+	for _, item := range mp.items {
+		if item.value != nil {
+			val, err := item.value.Evaluate(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ret[item.key] = RValue{Value: val.Get()}
+			continue
+		}
+		if item.property == "*" {
+			props, ok := valueProperties(v.Get())
+			if !ok {
+				return nil, ErrValueDoesNotHaveProperties{Value: v.Get(), Property: item.property}
+			}
+			for k, x := range props {
+				ret[k] = RValue{Value: x.Get()}
+			}
+			continue
+		}
+		val, ok := valueProperty(v.Get(), item.property)
+		if !ok {
+			return nil, ErrValueDoesNotHaveProperties{Value: v.Get(), Property: item.property}
+		}
+		ret[item.property] = RValue{Value: val.Get()}
+	}
+	return RValue{Value: ret}, nil
+}
@@ -0,0 +1,256 @@
+package opencypher
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "abs",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: absFunc,
+		},
+		Function{
+			Name:      "sign",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: signFunc,
+		},
+		Function{
+			Name:      "round",
+			MinArgs:   1,
+			MaxArgs:   3,
+			ValueFunc: roundFunc,
+		},
+		Function{
+			Name:      "isNaN",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: isNaNFunc,
+		},
+		Function{
+			Name:      "atan2",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: atan2Func,
+		},
+		Function{
+			Name:    "rand",
+			MinArgs: 0,
+			MaxArgs: 0,
+			ValueFunc: func(ctx *EvalContext, args []Value) (Value, error) {
+				return RValue{Value: rand.Float64()}, nil
+			},
+		},
+		Function{
+			Name:    "e",
+			MinArgs: 0,
+			MaxArgs: 0,
+			ValueFunc: func(ctx *EvalContext, args []Value) (Value, error) {
+				return RValue{Value: math.E, Const: true}, nil
+			},
+		},
+		Function{
+			Name:    "pi",
+			MinArgs: 0,
+			MaxArgs: 0,
+			ValueFunc: func(ctx *EvalContext, args []Value) (Value, error) {
+				return RValue{Value: math.Pi, Const: true}, nil
+			},
+		},
+		floatFunc("ceil", math.Ceil),
+		floatFunc("floor", math.Floor),
+		floatFunc("sqrt", math.Sqrt),
+		floatFunc("exp", math.Exp),
+		floatFunc("log", math.Log),
+		floatFunc("log10", math.Log10),
+		floatFunc("sin", math.Sin),
+		floatFunc("cos", math.Cos),
+		floatFunc("tan", math.Tan),
+		floatFunc("cot", func(x float64) float64 { return 1 / math.Tan(x) }),
+		floatFunc("asin", math.Asin),
+		floatFunc("acos", math.Acos),
+		floatFunc("atan", math.Atan),
+		floatFunc("degrees", func(x float64) float64 { return x * 180 / math.Pi }),
+		floatFunc("radians", func(x float64) float64 { return x * math.Pi / 180 }),
+		floatFunc("haversin", func(x float64) float64 { return (1 - math.Cos(x)) / 2 }),
+	)
+}
+
+// floatFunc returns a function that calls f with its numeric
+// argument converted to float64
+func floatFunc(name string, f func(float64) float64) Function {
+	return Function{
+		Name:    name,
+		MinArgs: 1,
+		MaxArgs: 1,
+		ValueFunc: func(ctx *EvalContext, args []Value) (Value, error) {
+			if args[0].Get() == nil {
+				return RValue{}, nil
+			}
+			x, err := valueAsFloat(args[0])
+			if err != nil {
+				return nil, fmt.Errorf("In %s: %w", name, err)
+			}
+			return RValue{Value: f(x)}, nil
+		},
+	}
+}
+
+func absFunc(ctx *EvalContext, args []Value) (Value, error) {
+	switch val := args[0].Get().(type) {
+	case nil:
+		return RValue{}, nil
+	case int:
+		if val < 0 {
+			return RValue{Value: -val}, nil
+		}
+		return RValue{Value: val}, nil
+	case float64:
+		return RValue{Value: math.Abs(val)}, nil
+	}
+	return nil, fmt.Errorf("In abs: Numeric value expected: %v", args[0].Get())
+}
+
+func signFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	x, err := valueAsFloat(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In sign: %w", err)
+	}
+	switch {
+	case x > 0:
+		return RValue{Value: 1}, nil
+	case x < 0:
+		return RValue{Value: -1}, nil
+	}
+	return RValue{Value: 0}, nil
+}
+
+func isNaNFunc(ctx *EvalContext, args []Value) (Value, error) {
+	switch val := args[0].Get().(type) {
+	case nil:
+		return RValue{}, nil
+	case int:
+		return RValue{Value: false}, nil
+	case float64:
+		return RValue{Value: math.IsNaN(val)}, nil
+	}
+	return nil, fmt.Errorf("In isNaN: Numeric value expected: %v", args[0].Get())
+}
+
+func atan2Func(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil || args[1].Get() == nil {
+		return RValue{}, nil
+	}
+	y, err := valueAsFloat(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In atan2: %w", err)
+	}
+	x, err := valueAsFloat(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("In atan2: %w", err)
+	}
+	return RValue{Value: math.Atan2(y, x)}, nil
+}
+
+// roundFunc implements round(x), round(x, precision), and round(x,
+// precision, mode). Without precision, half-way values are rounded
+// towards positive infinity. With precision, the default mode is
+// HALF_UP.
+func roundFunc(ctx *EvalContext, args []Value) (Value, error) {
+	for _, arg := range args {
+		if arg.Get() == nil {
+			return RValue{}, nil
+		}
+	}
+	x, err := valueAsFloat(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In round: %w", err)
+	}
+	if len(args) == 1 {
+		return RValue{Value: math.Floor(x + 0.5)}, nil
+	}
+	precision, err := ValueAsInt(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("In round: %w", err)
+	}
+	mode := "HALF_UP"
+	if len(args) == 3 {
+		if mode, err = ValueAsString(args[2]); err != nil {
+			return nil, fmt.Errorf("In round: %w", err)
+		}
+	}
+	ret, err := roundDecimal(x, precision, strings.ToUpper(mode))
+	if err != nil {
+		return nil, fmt.Errorf("In round: %w", err)
+	}
+	return RValue{Value: ret}, nil
+}
+
+// roundDecimal rounds the shortest decimal representation of x to
+// the given number of decimal places using the rounding mode
+func roundDecimal(x float64, precision int, mode string) (float64, error) {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return x, nil
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(x, 'g', -1, 64))
+	if !ok {
+		return 0, fmt.Errorf("Cannot round %v", x)
+	}
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(precision))), nil))
+	if precision >= 0 {
+		r.Mul(r, scale)
+	} else {
+		r.Quo(r, scale)
+	}
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	sign := int64(r.Sign())
+	// half compares the remainder with one half
+	half := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(r.Denom())
+	adjust := false
+	switch mode {
+	case "UP":
+		adjust = rem.Sign() != 0
+	case "DOWN":
+	case "CEILING":
+		adjust = rem.Sign() != 0 && sign > 0
+	case "FLOOR":
+		adjust = rem.Sign() != 0 && sign < 0
+	case "HALF_UP":
+		adjust = half >= 0
+	case "HALF_DOWN":
+		adjust = half > 0
+	case "HALF_EVEN":
+		adjust = half > 0 || (half == 0 && q.Bit(0) == 1)
+	default:
+		return 0, fmt.Errorf("Unknown rounding mode: %s", mode)
+	}
+	if adjust {
+		q.Add(q, big.NewInt(sign))
+	}
+	result := new(big.Rat).SetInt(q)
+	if precision >= 0 {
+		result.Quo(result, scale)
+	} else {
+		result.Mul(result, scale)
+	}
+	f, _ := result.Float64()
+	return f, nil
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
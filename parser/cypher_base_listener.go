@@ -243,6 +243,12 @@ func (s *BaseCypherListener) EnterOC_AnonymousPatternPart(ctx *OC_AnonymousPatte
 // ExitOC_AnonymousPatternPart is called when production oC_AnonymousPatternPart is exited.
 func (s *BaseCypherListener) ExitOC_AnonymousPatternPart(ctx *OC_AnonymousPatternPartContext) {}
 
+// EnterOC_ShortestPathPattern is called when production oC_ShortestPathPattern is entered.
+func (s *BaseCypherListener) EnterOC_ShortestPathPattern(ctx *OC_ShortestPathPatternContext) {}
+
+// ExitOC_ShortestPathPattern is called when production oC_ShortestPathPattern is exited.
+func (s *BaseCypherListener) ExitOC_ShortestPathPattern(ctx *OC_ShortestPathPatternContext) {}
+
 // EnterOC_PatternElement is called when production oC_PatternElement is entered.
 func (s *BaseCypherListener) EnterOC_PatternElement(ctx *OC_PatternElementContext) {}
 
@@ -422,6 +428,24 @@ func (s *BaseCypherListener) EnterOC_Atom(ctx *OC_AtomContext) {}
 // ExitOC_Atom is called when production oC_Atom is exited.
 func (s *BaseCypherListener) ExitOC_Atom(ctx *OC_AtomContext) {}
 
+// EnterOC_Reduce is called when production oC_Reduce is entered.
+func (s *BaseCypherListener) EnterOC_Reduce(ctx *OC_ReduceContext) {}
+
+// ExitOC_Reduce is called when production oC_Reduce is exited.
+func (s *BaseCypherListener) ExitOC_Reduce(ctx *OC_ReduceContext) {}
+
+// EnterOC_MapProjection is called when production oC_MapProjection is entered.
+func (s *BaseCypherListener) EnterOC_MapProjection(ctx *OC_MapProjectionContext) {}
+
+// ExitOC_MapProjection is called when production oC_MapProjection is exited.
+func (s *BaseCypherListener) ExitOC_MapProjection(ctx *OC_MapProjectionContext) {}
+
+// EnterOC_MapProjectionItem is called when production oC_MapProjectionItem is entered.
+func (s *BaseCypherListener) EnterOC_MapProjectionItem(ctx *OC_MapProjectionItemContext) {}
+
+// ExitOC_MapProjectionItem is called when production oC_MapProjectionItem is exited.
+func (s *BaseCypherListener) ExitOC_MapProjectionItem(ctx *OC_MapProjectionItemContext) {}
+
 // EnterOC_Literal is called when production oC_Literal is entered.
 func (s *BaseCypherListener) EnterOC_Literal(ctx *OC_LiteralContext) {}
 
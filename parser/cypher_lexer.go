@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 129, 993,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 133, 1041,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -44,110 +44,116 @@ var serializedLexerAtn = []uint16{
 	9, 133, 4, 134, 9, 134, 4, 135, 9, 135, 4, 136, 9, 136, 4, 137, 9, 137,
 	4, 138, 9, 138, 4, 139, 9, 139, 4, 140, 9, 140, 4, 141, 9, 141, 4, 142,
 	9, 142, 4, 143, 9, 143, 4, 144, 9, 144, 4, 145, 9, 145, 4, 146, 9, 146,
-	4, 147, 9, 147, 4, 148, 9, 148, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3,
-	5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15,
-	3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3,
-	20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24,
-	3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3,
+	4, 147, 9, 147, 4, 148, 9, 148, 4, 149, 9, 149, 4, 150, 9, 150, 4, 151,
+	9, 151, 4, 152, 9, 152, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5,
+	3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3,
+	11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15,
+	3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20, 3,
+	20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 25,
+	3, 25, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3,
 	29, 3, 30, 3, 30, 3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34,
 	3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39, 3, 39, 3,
 	40, 3, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 3, 44, 3, 44, 3, 45,
-	3, 45, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3,
-	48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3,
-	51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53,
-	3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3,
-	55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
-	3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3,
-	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60,
-	3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3,
-	62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3,
-	65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68,
-	3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3,
-	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3,
-	72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74,
-	3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3,
-	77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3,
-	81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 83,
-	3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3,
-	85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87,
-	3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3,
-	89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90,
-	3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3,
-	92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94,
-	3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 7, 96, 671, 10,
-	96, 12, 96, 14, 96, 674, 11, 96, 3, 96, 3, 96, 3, 96, 3, 96, 7, 96, 680,
-	10, 96, 12, 96, 14, 96, 683, 11, 96, 3, 96, 5, 96, 686, 10, 96, 3, 97,
-	3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3,
-	97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 5, 97, 706, 10, 97, 3, 98,
-	3, 98, 3, 98, 3, 98, 6, 98, 712, 10, 98, 13, 98, 14, 98, 713, 3, 99, 3,
-	99, 3, 99, 7, 99, 719, 10, 99, 12, 99, 14, 99, 722, 11, 99, 5, 99, 724,
-	10, 99, 3, 100, 3, 100, 6, 100, 728, 10, 100, 13, 100, 14, 100, 729, 3,
-	101, 5, 101, 733, 10, 101, 3, 102, 3, 102, 5, 102, 737, 10, 102, 3, 103,
-	3, 103, 5, 103, 741, 10, 103, 3, 104, 3, 104, 5, 104, 745, 10, 104, 3,
-	105, 3, 105, 3, 106, 3, 106, 5, 106, 751, 10, 106, 3, 107, 3, 107, 3, 108,
-	6, 108, 756, 10, 108, 13, 108, 14, 108, 757, 3, 108, 6, 108, 761, 10, 108,
-	13, 108, 14, 108, 762, 3, 108, 3, 108, 6, 108, 767, 10, 108, 13, 108, 14,
-	108, 768, 3, 108, 3, 108, 6, 108, 773, 10, 108, 13, 108, 14, 108, 774,
-	5, 108, 777, 10, 108, 3, 108, 3, 108, 5, 108, 781, 10, 108, 3, 108, 6,
-	108, 784, 10, 108, 13, 108, 14, 108, 785, 3, 109, 7, 109, 789, 10, 109,
-	12, 109, 14, 109, 792, 11, 109, 3, 109, 3, 109, 6, 109, 796, 10, 109, 13,
-	109, 14, 109, 797, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110,
-	3, 110, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111, 3, 111, 3, 112, 3, 112,
-	3, 112, 3, 112, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113,
-	3, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 115,
-	3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115,
-	3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 117, 3, 117,
-	3, 117, 3, 118, 3, 118, 3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119,
-	3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 121,
-	3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122,
-	7, 122, 879, 10, 122, 12, 122, 14, 122, 882, 11, 122, 3, 123, 3, 123, 5,
-	123, 886, 10, 123, 3, 124, 3, 124, 5, 124, 890, 10, 124, 3, 125, 3, 125,
-	7, 125, 894, 10, 125, 12, 125, 14, 125, 897, 11, 125, 3, 125, 6, 125, 900,
-	10, 125, 13, 125, 14, 125, 901, 3, 126, 6, 126, 905, 10, 126, 13, 126,
-	14, 126, 906, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3,
-	127, 3, 127, 3, 127, 3, 127, 3, 127, 5, 127, 921, 10, 127, 3, 128, 3, 128,
-	3, 128, 3, 128, 3, 128, 3, 128, 7, 128, 929, 10, 128, 12, 128, 14, 128,
-	932, 11, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 7, 128, 940,
-	10, 128, 12, 128, 14, 128, 943, 11, 128, 3, 128, 5, 128, 946, 10, 128,
-	3, 128, 3, 128, 5, 128, 950, 10, 128, 5, 128, 952, 10, 128, 3, 129, 3,
-	129, 3, 130, 3, 130, 3, 131, 3, 131, 3, 132, 3, 132, 3, 133, 3, 133, 3,
-	134, 3, 134, 3, 135, 3, 135, 3, 136, 3, 136, 3, 137, 3, 137, 3, 138, 3,
-	138, 3, 139, 3, 139, 3, 140, 3, 140, 3, 141, 3, 141, 3, 142, 3, 142, 3,
-	143, 3, 143, 3, 144, 3, 144, 3, 145, 3, 145, 3, 146, 3, 146, 3, 147, 3,
-	147, 3, 148, 3, 148, 2, 2, 149, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15,
-	9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33,
-	18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51,
-	27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69,
-	36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87,
-	45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105,
-	54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121,
-	62, 123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137,
-	70, 139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149, 76, 151, 77, 153,
-	78, 155, 79, 157, 80, 159, 81, 161, 82, 163, 83, 165, 84, 167, 85, 169,
-	86, 171, 87, 173, 88, 175, 89, 177, 90, 179, 91, 181, 92, 183, 93, 185,
-	94, 187, 95, 189, 96, 191, 97, 193, 98, 195, 99, 197, 100, 199, 101, 201,
-	102, 203, 103, 205, 104, 207, 105, 209, 106, 211, 107, 213, 108, 215, 109,
-	217, 110, 219, 111, 221, 112, 223, 113, 225, 114, 227, 115, 229, 116, 231,
-	117, 233, 118, 235, 119, 237, 120, 239, 121, 241, 122, 243, 123, 245, 124,
-	247, 125, 249, 126, 251, 127, 253, 128, 255, 129, 257, 2, 259, 2, 261,
-	2, 263, 2, 265, 2, 267, 2, 269, 2, 271, 2, 273, 2, 275, 2, 277, 2, 279,
-	2, 281, 2, 283, 2, 285, 2, 287, 2, 289, 2, 291, 2, 293, 2, 295, 2, 3, 2,
-	47, 4, 2, 87, 87, 119, 119, 4, 2, 80, 80, 112, 112, 4, 2, 75, 75, 107,
-	107, 4, 2, 81, 81, 113, 113, 4, 2, 67, 67, 99, 99, 4, 2, 78, 78, 110, 110,
-	4, 2, 82, 82, 114, 114, 4, 2, 86, 86, 118, 118, 4, 2, 79, 79, 111, 111,
-	4, 2, 69, 69, 101, 101, 4, 2, 74, 74, 106, 106, 4, 2, 89, 89, 121, 121,
-	4, 2, 70, 70, 102, 102, 4, 2, 85, 85, 117, 117, 4, 2, 71, 71, 103, 103,
-	4, 2, 84, 84, 116, 116, 4, 2, 73, 73, 105, 105, 4, 2, 88, 88, 120, 120,
-	4, 2, 91, 91, 123, 123, 4, 2, 68, 68, 100, 100, 4, 2, 77, 77, 109, 109,
-	4, 2, 90, 90, 122, 122, 4, 2, 72, 72, 104, 104, 15, 2, 36, 36, 41, 41,
-	68, 68, 72, 72, 80, 80, 84, 84, 86, 86, 94, 94, 100, 100, 104, 104, 112,
-	112, 116, 116, 118, 118, 4, 2, 67, 72, 99, 104, 4, 2, 83, 83, 115, 115,
-	10, 2, 162, 162, 5762, 5762, 6160, 6160, 8194, 8204, 8234, 8235, 8241,
+	3, 45, 3, 46, 3, 46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3,
+	48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50,
+	3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3,
+	52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54,
+	3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3,
+	56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58,
+	3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3,
+	59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61,
+	3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3,
+	63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65,
+	3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3,
+	66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68,
+	3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3,
+	70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 71,
+	3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3,
+	72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74,
+	3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76,
+	3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3,
+	77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79,
+	3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3,
+	82, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84,
+	3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3,
+	86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87,
+	3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3,
+	89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91,
+	3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3,
+	93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95,
+	3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 97, 3,
+	97, 3, 97, 3, 97, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99,
+	3, 99, 3, 99, 3, 100, 3, 100, 3, 100, 7, 100, 719, 10, 100, 12, 100, 14,
+	100, 722, 11, 100, 3, 100, 3, 100, 3, 100, 3, 100, 7, 100, 728, 10, 100,
+	12, 100, 14, 100, 731, 11, 100, 3, 100, 5, 100, 734, 10, 100, 3, 101, 3,
+	101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3,
+	101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 5, 101, 754,
+	10, 101, 3, 102, 3, 102, 3, 102, 3, 102, 6, 102, 760, 10, 102, 13, 102,
+	14, 102, 761, 3, 103, 3, 103, 3, 103, 7, 103, 767, 10, 103, 12, 103, 14,
+	103, 770, 11, 103, 5, 103, 772, 10, 103, 3, 104, 3, 104, 6, 104, 776, 10,
+	104, 13, 104, 14, 104, 777, 3, 105, 5, 105, 781, 10, 105, 3, 106, 3, 106,
+	5, 106, 785, 10, 106, 3, 107, 3, 107, 5, 107, 789, 10, 107, 3, 108, 3,
+	108, 5, 108, 793, 10, 108, 3, 109, 3, 109, 3, 110, 3, 110, 5, 110, 799,
+	10, 110, 3, 111, 3, 111, 3, 112, 6, 112, 804, 10, 112, 13, 112, 14, 112,
+	805, 3, 112, 6, 112, 809, 10, 112, 13, 112, 14, 112, 810, 3, 112, 3, 112,
+	6, 112, 815, 10, 112, 13, 112, 14, 112, 816, 3, 112, 3, 112, 6, 112, 821,
+	10, 112, 13, 112, 14, 112, 822, 5, 112, 825, 10, 112, 3, 112, 3, 112, 5,
+	112, 829, 10, 112, 3, 112, 6, 112, 832, 10, 112, 13, 112, 14, 112, 833,
+	3, 113, 7, 113, 837, 10, 113, 12, 113, 14, 113, 840, 11, 113, 3, 113, 3,
+	113, 6, 113, 844, 10, 113, 13, 113, 14, 113, 845, 3, 114, 3, 114, 3, 114,
+	3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 115,
+	3, 115, 3, 115, 3, 116, 3, 116, 3, 116, 3, 116, 3, 117, 3, 117, 3, 117,
+	3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 118, 3, 118, 3, 118, 3, 118,
+	3, 118, 3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119,
+	3, 119, 3, 119, 3, 119, 3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120,
+	3, 120, 3, 120, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122, 3, 122, 3, 122,
+	3, 123, 3, 123, 3, 123, 3, 123, 3, 123, 3, 124, 3, 124, 3, 124, 3, 124,
+	3, 124, 3, 124, 3, 124, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125,
+	3, 125, 3, 125, 3, 126, 3, 126, 7, 126, 927, 10, 126, 12, 126, 14, 126,
+	930, 11, 126, 3, 127, 3, 127, 5, 127, 934, 10, 127, 3, 128, 3, 128, 5,
+	128, 938, 10, 128, 3, 129, 3, 129, 7, 129, 942, 10, 129, 12, 129, 14, 129,
+	945, 11, 129, 3, 129, 6, 129, 948, 10, 129, 13, 129, 14, 129, 949, 3, 130,
+	6, 130, 953, 10, 130, 13, 130, 14, 130, 954, 3, 131, 3, 131, 3, 131, 3,
+	131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 5,
+	131, 969, 10, 131, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 7, 132,
+	977, 10, 132, 12, 132, 14, 132, 980, 11, 132, 3, 132, 3, 132, 3, 132, 3,
+	132, 3, 132, 3, 132, 7, 132, 988, 10, 132, 12, 132, 14, 132, 991, 11, 132,
+	3, 132, 5, 132, 994, 10, 132, 3, 132, 3, 132, 5, 132, 998, 10, 132, 5,
+	132, 1000, 10, 132, 3, 133, 3, 133, 3, 134, 3, 134, 3, 135, 3, 135, 3,
+	136, 3, 136, 3, 137, 3, 137, 3, 138, 3, 138, 3, 139, 3, 139, 3, 140, 3,
+	140, 3, 141, 3, 141, 3, 142, 3, 142, 3, 143, 3, 143, 3, 144, 3, 144, 3,
+	145, 3, 145, 3, 146, 3, 146, 3, 147, 3, 147, 3, 148, 3, 148, 3, 149, 3,
+	149, 3, 150, 3, 150, 3, 151, 3, 151, 3, 152, 3, 152, 2, 2, 153, 3, 3, 5,
+	4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25,
+	14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43,
+	23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61,
+	32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79,
+	41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97,
+	50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113,
+	58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129,
+	66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 72, 143, 73, 145,
+	74, 147, 75, 149, 76, 151, 77, 153, 78, 155, 79, 157, 80, 159, 81, 161,
+	82, 163, 83, 165, 84, 167, 85, 169, 86, 171, 87, 173, 88, 175, 89, 177,
+	90, 179, 91, 181, 92, 183, 93, 185, 94, 187, 95, 189, 96, 191, 97, 193,
+	98, 195, 99, 197, 100, 199, 101, 201, 102, 203, 103, 205, 104, 207, 105,
+	209, 106, 211, 107, 213, 108, 215, 109, 217, 110, 219, 111, 221, 112, 223,
+	113, 225, 114, 227, 115, 229, 116, 231, 117, 233, 118, 235, 119, 237, 120,
+	239, 121, 241, 122, 243, 123, 245, 124, 247, 125, 249, 126, 251, 127, 253,
+	128, 255, 129, 257, 130, 259, 131, 261, 132, 263, 133, 265, 2, 267, 2,
+	269, 2, 271, 2, 273, 2, 275, 2, 277, 2, 279, 2, 281, 2, 283, 2, 285, 2,
+	287, 2, 289, 2, 291, 2, 293, 2, 295, 2, 297, 2, 299, 2, 301, 2, 303, 2,
+	3, 2, 47, 4, 2, 87, 87, 119, 119, 4, 2, 80, 80, 112, 112, 4, 2, 75, 75,
+	107, 107, 4, 2, 81, 81, 113, 113, 4, 2, 67, 67, 99, 99, 4, 2, 78, 78, 110,
+	110, 4, 2, 82, 82, 114, 114, 4, 2, 86, 86, 118, 118, 4, 2, 79, 79, 111,
+	111, 4, 2, 69, 69, 101, 101, 4, 2, 74, 74, 106, 106, 4, 2, 89, 89, 121,
+	121, 4, 2, 70, 70, 102, 102, 4, 2, 85, 85, 117, 117, 4, 2, 71, 71, 103,
+	103, 4, 2, 84, 84, 116, 116, 4, 2, 73, 73, 105, 105, 4, 2, 88, 88, 120,
+	120, 4, 2, 91, 91, 123, 123, 4, 2, 68, 68, 100, 100, 4, 2, 77, 77, 109,
+	109, 4, 2, 90, 90, 122, 122, 4, 2, 72, 72, 104, 104, 15, 2, 36, 36, 41,
+	41, 68, 68, 72, 72, 80, 80, 84, 84, 86, 86, 94, 94, 100, 100, 104, 104,
+	112, 112, 116, 116, 118, 118, 4, 2, 67, 72, 99, 104, 4, 2, 83, 83, 115,
+	115, 10, 2, 162, 162, 5762, 5762, 6160, 6160, 8194, 8204, 8234, 8235, 8241,
 	8241, 8289, 8289, 12290, 12290, 3, 2, 14, 14, 3, 2, 98, 98, 3, 2, 32, 32,
 	3, 2, 44, 44, 4, 2, 41, 41, 94, 94, 4, 2, 12, 12, 15, 15, 3, 2, 49, 49,
 	3, 2, 31, 31, 3, 2, 30, 30, 3, 2, 15, 15, 19, 2, 38, 38, 164, 167, 1425,
@@ -479,7 +485,7 @@ var serializedLexerAtn = []uint16{
 	61056, 3, 61058, 3, 61067, 3, 61069, 3, 61085, 3, 61091, 3, 61093, 3, 61095,
 	3, 61099, 3, 61101, 3, 61117, 3, 2, 4, 42712, 4, 42754, 4, 46902, 4, 46914,
 	4, 47135, 4, 47138, 4, 52899, 4, 52914, 4, 60386, 4, 63490, 4, 64031, 4,
-	1020, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2,
+	1068, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2,
 	2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3,
 	2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25,
 	3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2,
@@ -513,287 +519,303 @@ var serializedLexerAtn = []uint16{
 	3, 2, 2, 2, 2, 233, 3, 2, 2, 2, 2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2,
 	2, 239, 3, 2, 2, 2, 2, 241, 3, 2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3,
 	2, 2, 2, 2, 247, 3, 2, 2, 2, 2, 249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2,
-	253, 3, 2, 2, 2, 2, 255, 3, 2, 2, 2, 3, 297, 3, 2, 2, 2, 5, 299, 3, 2,
-	2, 2, 7, 301, 3, 2, 2, 2, 9, 303, 3, 2, 2, 2, 11, 306, 3, 2, 2, 2, 13,
-	308, 3, 2, 2, 2, 15, 310, 3, 2, 2, 2, 17, 312, 3, 2, 2, 2, 19, 314, 3,
-	2, 2, 2, 21, 316, 3, 2, 2, 2, 23, 318, 3, 2, 2, 2, 25, 320, 3, 2, 2, 2,
-	27, 323, 3, 2, 2, 2, 29, 325, 3, 2, 2, 2, 31, 327, 3, 2, 2, 2, 33, 329,
-	3, 2, 2, 2, 35, 331, 3, 2, 2, 2, 37, 333, 3, 2, 2, 2, 39, 336, 3, 2, 2,
-	2, 41, 338, 3, 2, 2, 2, 43, 340, 3, 2, 2, 2, 45, 343, 3, 2, 2, 2, 47, 346,
-	3, 2, 2, 2, 49, 348, 3, 2, 2, 2, 51, 350, 3, 2, 2, 2, 53, 352, 3, 2, 2,
-	2, 55, 354, 3, 2, 2, 2, 57, 356, 3, 2, 2, 2, 59, 358, 3, 2, 2, 2, 61, 360,
-	3, 2, 2, 2, 63, 362, 3, 2, 2, 2, 65, 364, 3, 2, 2, 2, 67, 366, 3, 2, 2,
-	2, 69, 368, 3, 2, 2, 2, 71, 370, 3, 2, 2, 2, 73, 372, 3, 2, 2, 2, 75, 374,
-	3, 2, 2, 2, 77, 376, 3, 2, 2, 2, 79, 378, 3, 2, 2, 2, 81, 380, 3, 2, 2,
-	2, 83, 382, 3, 2, 2, 2, 85, 384, 3, 2, 2, 2, 87, 386, 3, 2, 2, 2, 89, 388,
-	3, 2, 2, 2, 91, 390, 3, 2, 2, 2, 93, 392, 3, 2, 2, 2, 95, 398, 3, 2, 2,
-	2, 97, 402, 3, 2, 2, 2, 99, 411, 3, 2, 2, 2, 101, 417, 3, 2, 2, 2, 103,
-	424, 3, 2, 2, 2, 105, 427, 3, 2, 2, 2, 107, 433, 3, 2, 2, 2, 109, 436,
-	3, 2, 2, 2, 111, 443, 3, 2, 2, 2, 113, 447, 3, 2, 2, 2, 115, 454, 3, 2,
-	2, 2, 117, 461, 3, 2, 2, 2, 119, 468, 3, 2, 2, 2, 121, 473, 3, 2, 2, 2,
-	123, 479, 3, 2, 2, 2, 125, 484, 3, 2, 2, 2, 127, 491, 3, 2, 2, 2, 129,
-	500, 3, 2, 2, 2, 131, 506, 3, 2, 2, 2, 133, 509, 3, 2, 2, 2, 135, 514,
-	3, 2, 2, 2, 137, 520, 3, 2, 2, 2, 139, 530, 3, 2, 2, 2, 141, 534, 3, 2,
-	2, 2, 143, 545, 3, 2, 2, 2, 145, 550, 3, 2, 2, 2, 147, 556, 3, 2, 2, 2,
-	149, 559, 3, 2, 2, 2, 151, 563, 3, 2, 2, 2, 153, 567, 3, 2, 2, 2, 155,
-	571, 3, 2, 2, 2, 157, 574, 3, 2, 2, 2, 159, 581, 3, 2, 2, 2, 161, 586,
-	3, 2, 2, 2, 163, 595, 3, 2, 2, 2, 165, 598, 3, 2, 2, 2, 167, 603, 3, 2,
-	2, 2, 169, 609, 3, 2, 2, 2, 171, 613, 3, 2, 2, 2, 173, 618, 3, 2, 2, 2,
-	175, 625, 3, 2, 2, 2, 177, 630, 3, 2, 2, 2, 179, 636, 3, 2, 2, 2, 181,
-	643, 3, 2, 2, 2, 183, 648, 3, 2, 2, 2, 185, 653, 3, 2, 2, 2, 187, 657,
-	3, 2, 2, 2, 189, 662, 3, 2, 2, 2, 191, 685, 3, 2, 2, 2, 193, 687, 3, 2,
-	2, 2, 195, 707, 3, 2, 2, 2, 197, 723, 3, 2, 2, 2, 199, 725, 3, 2, 2, 2,
-	201, 732, 3, 2, 2, 2, 203, 736, 3, 2, 2, 2, 205, 740, 3, 2, 2, 2, 207,
-	744, 3, 2, 2, 2, 209, 746, 3, 2, 2, 2, 211, 750, 3, 2, 2, 2, 213, 752,
-	3, 2, 2, 2, 215, 776, 3, 2, 2, 2, 217, 790, 3, 2, 2, 2, 219, 799, 3, 2,
-	2, 2, 221, 810, 3, 2, 2, 2, 223, 813, 3, 2, 2, 2, 225, 817, 3, 2, 2, 2,
-	227, 825, 3, 2, 2, 2, 229, 832, 3, 2, 2, 2, 231, 842, 3, 2, 2, 2, 233,
-	849, 3, 2, 2, 2, 235, 852, 3, 2, 2, 2, 237, 856, 3, 2, 2, 2, 239, 861,
-	3, 2, 2, 2, 241, 868, 3, 2, 2, 2, 243, 876, 3, 2, 2, 2, 245, 885, 3, 2,
-	2, 2, 247, 889, 3, 2, 2, 2, 249, 899, 3, 2, 2, 2, 251, 904, 3, 2, 2, 2,
-	253, 920, 3, 2, 2, 2, 255, 951, 3, 2, 2, 2, 257, 953, 3, 2, 2, 2, 259,
-	955, 3, 2, 2, 2, 261, 957, 3, 2, 2, 2, 263, 959, 3, 2, 2, 2, 265, 961,
-	3, 2, 2, 2, 267, 963, 3, 2, 2, 2, 269, 965, 3, 2, 2, 2, 271, 967, 3, 2,
-	2, 2, 273, 969, 3, 2, 2, 2, 275, 971, 3, 2, 2, 2, 277, 973, 3, 2, 2, 2,
-	279, 975, 3, 2, 2, 2, 281, 977, 3, 2, 2, 2, 283, 979, 3, 2, 2, 2, 285,
-	981, 3, 2, 2, 2, 287, 983, 3, 2, 2, 2, 289, 985, 3, 2, 2, 2, 291, 987,
-	3, 2, 2, 2, 293, 989, 3, 2, 2, 2, 295, 991, 3, 2, 2, 2, 297, 298, 7, 61,
-	2, 2, 298, 4, 3, 2, 2, 2, 299, 300, 7, 46, 2, 2, 300, 6, 3, 2, 2, 2, 301,
-	302, 7, 63, 2, 2, 302, 8, 3, 2, 2, 2, 303, 304, 7, 45, 2, 2, 304, 305,
-	7, 63, 2, 2, 305, 10, 3, 2, 2, 2, 306, 307, 7, 44, 2, 2, 307, 12, 3, 2,
-	2, 2, 308, 309, 7, 42, 2, 2, 309, 14, 3, 2, 2, 2, 310, 311, 7, 43, 2, 2,
-	311, 16, 3, 2, 2, 2, 312, 313, 7, 93, 2, 2, 313, 18, 3, 2, 2, 2, 314, 315,
-	7, 95, 2, 2, 315, 20, 3, 2, 2, 2, 316, 317, 7, 60, 2, 2, 317, 22, 3, 2,
-	2, 2, 318, 319, 7, 126, 2, 2, 319, 24, 3, 2, 2, 2, 320, 321, 7, 48, 2,
-	2, 321, 322, 7, 48, 2, 2, 322, 26, 3, 2, 2, 2, 323, 324, 7, 45, 2, 2, 324,
-	28, 3, 2, 2, 2, 325, 326, 7, 47, 2, 2, 326, 30, 3, 2, 2, 2, 327, 328, 7,
-	49, 2, 2, 328, 32, 3, 2, 2, 2, 329, 330, 7, 39, 2, 2, 330, 34, 3, 2, 2,
-	2, 331, 332, 7, 96, 2, 2, 332, 36, 3, 2, 2, 2, 333, 334, 7, 62, 2, 2, 334,
-	335, 7, 64, 2, 2, 335, 38, 3, 2, 2, 2, 336, 337, 7, 62, 2, 2, 337, 40,
-	3, 2, 2, 2, 338, 339, 7, 64, 2, 2, 339, 42, 3, 2, 2, 2, 340, 341, 7, 62,
-	2, 2, 341, 342, 7, 63, 2, 2, 342, 44, 3, 2, 2, 2, 343, 344, 7, 64, 2, 2,
-	344, 345, 7, 63, 2, 2, 345, 46, 3, 2, 2, 2, 346, 347, 7, 48, 2, 2, 347,
-	48, 3, 2, 2, 2, 348, 349, 7, 125, 2, 2, 349, 50, 3, 2, 2, 2, 350, 351,
-	7, 127, 2, 2, 351, 52, 3, 2, 2, 2, 352, 353, 7, 38, 2, 2, 353, 54, 3, 2,
-	2, 2, 354, 355, 7, 10218, 2, 2, 355, 56, 3, 2, 2, 2, 356, 357, 7, 12298,
-	2, 2, 357, 58, 3, 2, 2, 2, 358, 359, 7, 65126, 2, 2, 359, 60, 3, 2, 2,
-	2, 360, 361, 7, 65310, 2, 2, 361, 62, 3, 2, 2, 2, 362, 363, 7, 10219, 2,
-	2, 363, 64, 3, 2, 2, 2, 364, 365, 7, 12299, 2, 2, 365, 66, 3, 2, 2, 2,
-	366, 367, 7, 65127, 2, 2, 367, 68, 3, 2, 2, 2, 368, 369, 7, 65312, 2, 2,
-	369, 70, 3, 2, 2, 2, 370, 371, 7, 175, 2, 2, 371, 72, 3, 2, 2, 2, 372,
-	373, 7, 8210, 2, 2, 373, 74, 3, 2, 2, 2, 374, 375, 7, 8211, 2, 2, 375,
-	76, 3, 2, 2, 2, 376, 377, 7, 8212, 2, 2, 377, 78, 3, 2, 2, 2, 378, 379,
-	7, 8213, 2, 2, 379, 80, 3, 2, 2, 2, 380, 381, 7, 8214, 2, 2, 381, 82, 3,
-	2, 2, 2, 382, 383, 7, 8215, 2, 2, 383, 84, 3, 2, 2, 2, 384, 385, 7, 8724,
-	2, 2, 385, 86, 3, 2, 2, 2, 386, 387, 7, 65114, 2, 2, 387, 88, 3, 2, 2,
-	2, 388, 389, 7, 65125, 2, 2, 389, 90, 3, 2, 2, 2, 390, 391, 7, 65295, 2,
-	2, 391, 92, 3, 2, 2, 2, 392, 393, 9, 2, 2, 2, 393, 394, 9, 3, 2, 2, 394,
-	395, 9, 4, 2, 2, 395, 396, 9, 5, 2, 2, 396, 397, 9, 3, 2, 2, 397, 94, 3,
-	2, 2, 2, 398, 399, 9, 6, 2, 2, 399, 400, 9, 7, 2, 2, 400, 401, 9, 7, 2,
-	2, 401, 96, 3, 2, 2, 2, 402, 403, 9, 5, 2, 2, 403, 404, 9, 8, 2, 2, 404,
-	405, 9, 9, 2, 2, 405, 406, 9, 4, 2, 2, 406, 407, 9, 5, 2, 2, 407, 408,
-	9, 3, 2, 2, 408, 409, 9, 6, 2, 2, 409, 410, 9, 7, 2, 2, 410, 98, 3, 2,
-	2, 2, 411, 412, 9, 10, 2, 2, 412, 413, 9, 6, 2, 2, 413, 414, 9, 9, 2, 2,
-	414, 415, 9, 11, 2, 2, 415, 416, 9, 12, 2, 2, 416, 100, 3, 2, 2, 2, 417,
-	418, 9, 2, 2, 2, 418, 419, 9, 3, 2, 2, 419, 420, 9, 13, 2, 2, 420, 421,
-	9, 4, 2, 2, 421, 422, 9, 3, 2, 2, 422, 423, 9, 14, 2, 2, 423, 102, 3, 2,
-	2, 2, 424, 425, 9, 6, 2, 2, 425, 426, 9, 15, 2, 2, 426, 104, 3, 2, 2, 2,
-	427, 428, 9, 10, 2, 2, 428, 429, 9, 16, 2, 2, 429, 430, 9, 17, 2, 2, 430,
-	431, 9, 18, 2, 2, 431, 432, 9, 16, 2, 2, 432, 106, 3, 2, 2, 2, 433, 434,
-	9, 5, 2, 2, 434, 435, 9, 3, 2, 2, 435, 108, 3, 2, 2, 2, 436, 437, 9, 11,
-	2, 2, 437, 438, 9, 17, 2, 2, 438, 439, 9, 16, 2, 2, 439, 440, 9, 6, 2,
-	2, 440, 441, 9, 9, 2, 2, 441, 442, 9, 16, 2, 2, 442, 110, 3, 2, 2, 2, 443,
-	444, 9, 15, 2, 2, 444, 445, 9, 16, 2, 2, 445, 446, 9, 9, 2, 2, 446, 112,
-	3, 2, 2, 2, 447, 448, 9, 14, 2, 2, 448, 449, 9, 16, 2, 2, 449, 450, 9,
-	9, 2, 2, 450, 451, 9, 6, 2, 2, 451, 452, 9, 11, 2, 2, 452, 453, 9, 12,
-	2, 2, 453, 114, 3, 2, 2, 2, 454, 455, 9, 14, 2, 2, 455, 456, 9, 16, 2,
-	2, 456, 457, 9, 7, 2, 2, 457, 458, 9, 16, 2, 2, 458, 459, 9, 9, 2, 2, 459,
-	460, 9, 16, 2, 2, 460, 116, 3, 2, 2, 2, 461, 462, 9, 17, 2, 2, 462, 463,
-	9, 16, 2, 2, 463, 464, 9, 10, 2, 2, 464, 465, 9, 5, 2, 2, 465, 466, 9,
-	19, 2, 2, 466, 467, 9, 16, 2, 2, 467, 118, 3, 2, 2, 2, 468, 469, 9, 11,
-	2, 2, 469, 470, 9, 6, 2, 2, 470, 471, 9, 7, 2, 2, 471, 472, 9, 7, 2, 2,
-	472, 120, 3, 2, 2, 2, 473, 474, 9, 20, 2, 2, 474, 475, 9, 4, 2, 2, 475,
-	476, 9, 16, 2, 2, 476, 477, 9, 7, 2, 2, 477, 478, 9, 14, 2, 2, 478, 122,
-	3, 2, 2, 2, 479, 480, 9, 13, 2, 2, 480, 481, 9, 4, 2, 2, 481, 482, 9, 9,
-	2, 2, 482, 483, 9, 12, 2, 2, 483, 124, 3, 2, 2, 2, 484, 485, 9, 17, 2,
-	2, 485, 486, 9, 16, 2, 2, 486, 487, 9, 9, 2, 2, 487, 488, 9, 2, 2, 2, 488,
-	489, 9, 17, 2, 2, 489, 490, 9, 3, 2, 2, 490, 126, 3, 2, 2, 2, 491, 492,
-	9, 14, 2, 2, 492, 493, 9, 4, 2, 2, 493, 494, 9, 15, 2, 2, 494, 495, 9,
-	9, 2, 2, 495, 496, 9, 4, 2, 2, 496, 497, 9, 3, 2, 2, 497, 498, 9, 11, 2,
-	2, 498, 499, 9, 9, 2, 2, 499, 128, 3, 2, 2, 2, 500, 501, 9, 5, 2, 2, 501,
-	502, 9, 17, 2, 2, 502, 503, 9, 14, 2, 2, 503, 504, 9, 16, 2, 2, 504, 505,
-	9, 17, 2, 2, 505, 130, 3, 2, 2, 2, 506, 507, 9, 21, 2, 2, 507, 508, 9,
-	20, 2, 2, 508, 132, 3, 2, 2, 2, 509, 510, 9, 15, 2, 2, 510, 511, 9, 22,
-	2, 2, 511, 512, 9, 4, 2, 2, 512, 513, 9, 8, 2, 2, 513, 134, 3, 2, 2, 2,
-	514, 515, 9, 7, 2, 2, 515, 516, 9, 4, 2, 2, 516, 517, 9, 10, 2, 2, 517,
-	518, 9, 4, 2, 2, 518, 519, 9, 9, 2, 2, 519, 136, 3, 2, 2, 2, 520, 521,
-	9, 6, 2, 2, 521, 522, 9, 15, 2, 2, 522, 523, 9, 11, 2, 2, 523, 524, 9,
-	16, 2, 2, 524, 525, 9, 3, 2, 2, 525, 526, 9, 14, 2, 2, 526, 527, 9, 4,
-	2, 2, 527, 528, 9, 3, 2, 2, 528, 529, 9, 18, 2, 2, 529, 138, 3, 2, 2, 2,
-	530, 531, 9, 6, 2, 2, 531, 532, 9, 15, 2, 2, 532, 533, 9, 11, 2, 2, 533,
-	140, 3, 2, 2, 2, 534, 535, 9, 14, 2, 2, 535, 536, 9, 16, 2, 2, 536, 537,
-	9, 15, 2, 2, 537, 538, 9, 11, 2, 2, 538, 539, 9, 16, 2, 2, 539, 540, 9,
-	3, 2, 2, 540, 541, 9, 14, 2, 2, 541, 542, 9, 4, 2, 2, 542, 543, 9, 3, 2,
-	2, 543, 544, 9, 18, 2, 2, 544, 142, 3, 2, 2, 2, 545, 546, 9, 14, 2, 2,
-	546, 547, 9, 16, 2, 2, 547, 548, 9, 15, 2, 2, 548, 549, 9, 11, 2, 2, 549,
-	144, 3, 2, 2, 2, 550, 551, 9, 13, 2, 2, 551, 552, 9, 12, 2, 2, 552, 553,
-	9, 16, 2, 2, 553, 554, 9, 17, 2, 2, 554, 555, 9, 16, 2, 2, 555, 146, 3,
-	2, 2, 2, 556, 557, 9, 5, 2, 2, 557, 558, 9, 17, 2, 2, 558, 148, 3, 2, 2,
-	2, 559, 560, 9, 23, 2, 2, 560, 561, 9, 5, 2, 2, 561, 562, 9, 17, 2, 2,
-	562, 150, 3, 2, 2, 2, 563, 564, 9, 6, 2, 2, 564, 565, 9, 3, 2, 2, 565,
-	566, 9, 14, 2, 2, 566, 152, 3, 2, 2, 2, 567, 568, 9, 3, 2, 2, 568, 569,
-	9, 5, 2, 2, 569, 570, 9, 9, 2, 2, 570, 154, 3, 2, 2, 2, 571, 572, 9, 4,
-	2, 2, 572, 573, 9, 3, 2, 2, 573, 156, 3, 2, 2, 2, 574, 575, 9, 15, 2, 2,
-	575, 576, 9, 9, 2, 2, 576, 577, 9, 6, 2, 2, 577, 578, 9, 17, 2, 2, 578,
-	579, 9, 9, 2, 2, 579, 580, 9, 15, 2, 2, 580, 158, 3, 2, 2, 2, 581, 582,
-	9, 16, 2, 2, 582, 583, 9, 3, 2, 2, 583, 584, 9, 14, 2, 2, 584, 585, 9,
-	15, 2, 2, 585, 160, 3, 2, 2, 2, 586, 587, 9, 11, 2, 2, 587, 588, 9, 5,
-	2, 2, 588, 589, 9, 3, 2, 2, 589, 590, 9, 9, 2, 2, 590, 591, 9, 6, 2, 2,
-	591, 592, 9, 4, 2, 2, 592, 593, 9, 3, 2, 2, 593, 594, 9, 15, 2, 2, 594,
-	162, 3, 2, 2, 2, 595, 596, 9, 4, 2, 2, 596, 597, 9, 15, 2, 2, 597, 164,
-	3, 2, 2, 2, 598, 599, 9, 3, 2, 2, 599, 600, 9, 2, 2, 2, 600, 601, 9, 7,
-	2, 2, 601, 602, 9, 7, 2, 2, 602, 166, 3, 2, 2, 2, 603, 604, 9, 11, 2, 2,
-	604, 605, 9, 5, 2, 2, 605, 606, 9, 2, 2, 2, 606, 607, 9, 3, 2, 2, 607,
-	608, 9, 9, 2, 2, 608, 168, 3, 2, 2, 2, 609, 610, 9, 6, 2, 2, 610, 611,
-	9, 3, 2, 2, 611, 612, 9, 20, 2, 2, 612, 170, 3, 2, 2, 2, 613, 614, 9, 3,
-	2, 2, 614, 615, 9, 5, 2, 2, 615, 616, 9, 3, 2, 2, 616, 617, 9, 16, 2, 2,
-	617, 172, 3, 2, 2, 2, 618, 619, 9, 15, 2, 2, 619, 620, 9, 4, 2, 2, 620,
-	621, 9, 3, 2, 2, 621, 622, 9, 18, 2, 2, 622, 623, 9, 7, 2, 2, 623, 624,
-	9, 16, 2, 2, 624, 174, 3, 2, 2, 2, 625, 626, 9, 9, 2, 2, 626, 627, 9, 17,
-	2, 2, 627, 628, 9, 2, 2, 2, 628, 629, 9, 16, 2, 2, 629, 176, 3, 2, 2, 2,
-	630, 631, 9, 24, 2, 2, 631, 632, 9, 6, 2, 2, 632, 633, 9, 7, 2, 2, 633,
-	634, 9, 15, 2, 2, 634, 635, 9, 16, 2, 2, 635, 178, 3, 2, 2, 2, 636, 637,
-	9, 16, 2, 2, 637, 638, 9, 23, 2, 2, 638, 639, 9, 4, 2, 2, 639, 640, 9,
-	15, 2, 2, 640, 641, 9, 9, 2, 2, 641, 642, 9, 15, 2, 2, 642, 180, 3, 2,
-	2, 2, 643, 644, 9, 11, 2, 2, 644, 645, 9, 6, 2, 2, 645, 646, 9, 15, 2,
-	2, 646, 647, 9, 16, 2, 2, 647, 182, 3, 2, 2, 2, 648, 649, 9, 16, 2, 2,
-	649, 650, 9, 7, 2, 2, 650, 651, 9, 15, 2, 2, 651, 652, 9, 16, 2, 2, 652,
-	184, 3, 2, 2, 2, 653, 654, 9, 16, 2, 2, 654, 655, 9, 3, 2, 2, 655, 656,
-	9, 14, 2, 2, 656, 186, 3, 2, 2, 2, 657, 658, 9, 13, 2, 2, 658, 659, 9,
-	12, 2, 2, 659, 660, 9, 16, 2, 2, 660, 661, 9, 3, 2, 2, 661, 188, 3, 2,
-	2, 2, 662, 663, 9, 9, 2, 2, 663, 664, 9, 12, 2, 2, 664, 665, 9, 16, 2,
-	2, 665, 666, 9, 3, 2, 2, 666, 190, 3, 2, 2, 2, 667, 672, 7, 36, 2, 2, 668,
-	671, 5, 287, 144, 2, 669, 671, 5, 193, 97, 2, 670, 668, 3, 2, 2, 2, 670,
-	669, 3, 2, 2, 2, 671, 674, 3, 2, 2, 2, 672, 670, 3, 2, 2, 2, 672, 673,
-	3, 2, 2, 2, 673, 675, 3, 2, 2, 2, 674, 672, 3, 2, 2, 2, 675, 686, 7, 36,
-	2, 2, 676, 681, 7, 41, 2, 2, 677, 680, 5, 267, 134, 2, 678, 680, 5, 193,
-	97, 2, 679, 677, 3, 2, 2, 2, 679, 678, 3, 2, 2, 2, 680, 683, 3, 2, 2, 2,
-	681, 679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682, 684, 3, 2, 2, 2, 683,
-	681, 3, 2, 2, 2, 684, 686, 7, 41, 2, 2, 685, 667, 3, 2, 2, 2, 685, 676,
-	3, 2, 2, 2, 686, 192, 3, 2, 2, 2, 687, 705, 7, 94, 2, 2, 688, 706, 9, 25,
-	2, 2, 689, 690, 9, 2, 2, 2, 690, 691, 5, 203, 102, 2, 691, 692, 5, 203,
-	102, 2, 692, 693, 5, 203, 102, 2, 693, 694, 5, 203, 102, 2, 694, 706, 3,
-	2, 2, 2, 695, 696, 9, 2, 2, 2, 696, 697, 5, 203, 102, 2, 697, 698, 5, 203,
-	102, 2, 698, 699, 5, 203, 102, 2, 699, 700, 5, 203, 102, 2, 700, 701, 5,
-	203, 102, 2, 701, 702, 5, 203, 102, 2, 702, 703, 5, 203, 102, 2, 703, 704,
-	5, 203, 102, 2, 704, 706, 3, 2, 2, 2, 705, 688, 3, 2, 2, 2, 705, 689, 3,
-	2, 2, 2, 705, 695, 3, 2, 2, 2, 706, 194, 3, 2, 2, 2, 707, 708, 7, 50, 2,
-	2, 708, 709, 7, 122, 2, 2, 709, 711, 3, 2, 2, 2, 710, 712, 5, 203, 102,
-	2, 711, 710, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 711, 3, 2, 2, 2, 713,
-	714, 3, 2, 2, 2, 714, 196, 3, 2, 2, 2, 715, 724, 5, 213, 107, 2, 716, 720,
-	5, 207, 104, 2, 717, 719, 5, 205, 103, 2, 718, 717, 3, 2, 2, 2, 719, 722,
-	3, 2, 2, 2, 720, 718, 3, 2, 2, 2, 720, 721, 3, 2, 2, 2, 721, 724, 3, 2,
-	2, 2, 722, 720, 3, 2, 2, 2, 723, 715, 3, 2, 2, 2, 723, 716, 3, 2, 2, 2,
-	724, 198, 3, 2, 2, 2, 725, 727, 5, 213, 107, 2, 726, 728, 5, 211, 106,
-	2, 727, 726, 3, 2, 2, 2, 728, 729, 3, 2, 2, 2, 729, 727, 3, 2, 2, 2, 729,
-	730, 3, 2, 2, 2, 730, 200, 3, 2, 2, 2, 731, 733, 9, 26, 2, 2, 732, 731,
-	3, 2, 2, 2, 733, 202, 3, 2, 2, 2, 734, 737, 5, 205, 103, 2, 735, 737, 5,
-	201, 101, 2, 736, 734, 3, 2, 2, 2, 736, 735, 3, 2, 2, 2, 737, 204, 3, 2,
-	2, 2, 738, 741, 5, 213, 107, 2, 739, 741, 5, 207, 104, 2, 740, 738, 3,
-	2, 2, 2, 740, 739, 3, 2, 2, 2, 741, 206, 3, 2, 2, 2, 742, 745, 5, 209,
-	105, 2, 743, 745, 4, 58, 59, 2, 744, 742, 3, 2, 2, 2, 744, 743, 3, 2, 2,
-	2, 745, 208, 3, 2, 2, 2, 746, 747, 4, 51, 57, 2, 747, 210, 3, 2, 2, 2,
-	748, 751, 5, 213, 107, 2, 749, 751, 5, 209, 105, 2, 750, 748, 3, 2, 2,
-	2, 750, 749, 3, 2, 2, 2, 751, 212, 3, 2, 2, 2, 752, 753, 7, 50, 2, 2, 753,
-	214, 3, 2, 2, 2, 754, 756, 5, 205, 103, 2, 755, 754, 3, 2, 2, 2, 756, 757,
-	3, 2, 2, 2, 757, 755, 3, 2, 2, 2, 757, 758, 3, 2, 2, 2, 758, 777, 3, 2,
-	2, 2, 759, 761, 5, 205, 103, 2, 760, 759, 3, 2, 2, 2, 761, 762, 3, 2, 2,
-	2, 762, 760, 3, 2, 2, 2, 762, 763, 3, 2, 2, 2, 763, 764, 3, 2, 2, 2, 764,
-	766, 7, 48, 2, 2, 765, 767, 5, 205, 103, 2, 766, 765, 3, 2, 2, 2, 767,
-	768, 3, 2, 2, 2, 768, 766, 3, 2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 777,
-	3, 2, 2, 2, 770, 772, 7, 48, 2, 2, 771, 773, 5, 205, 103, 2, 772, 771,
-	3, 2, 2, 2, 773, 774, 3, 2, 2, 2, 774, 772, 3, 2, 2, 2, 774, 775, 3, 2,
-	2, 2, 775, 777, 3, 2, 2, 2, 776, 755, 3, 2, 2, 2, 776, 760, 3, 2, 2, 2,
-	776, 770, 3, 2, 2, 2, 777, 778, 3, 2, 2, 2, 778, 780, 9, 16, 2, 2, 779,
-	781, 7, 47, 2, 2, 780, 779, 3, 2, 2, 2, 780, 781, 3, 2, 2, 2, 781, 783,
-	3, 2, 2, 2, 782, 784, 5, 205, 103, 2, 783, 782, 3, 2, 2, 2, 784, 785, 3,
-	2, 2, 2, 785, 783, 3, 2, 2, 2, 785, 786, 3, 2, 2, 2, 786, 216, 3, 2, 2,
-	2, 787, 789, 5, 205, 103, 2, 788, 787, 3, 2, 2, 2, 789, 792, 3, 2, 2, 2,
-	790, 788, 3, 2, 2, 2, 790, 791, 3, 2, 2, 2, 791, 793, 3, 2, 2, 2, 792,
-	790, 3, 2, 2, 2, 793, 795, 7, 48, 2, 2, 794, 796, 5, 205, 103, 2, 795,
-	794, 3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797, 795, 3, 2, 2, 2, 797, 798,
-	3, 2, 2, 2, 798, 218, 3, 2, 2, 2, 799, 800, 9, 11, 2, 2, 800, 801, 9, 5,
-	2, 2, 801, 802, 9, 3, 2, 2, 802, 803, 9, 15, 2, 2, 803, 804, 9, 9, 2, 2,
-	804, 805, 9, 17, 2, 2, 805, 806, 9, 6, 2, 2, 806, 807, 9, 4, 2, 2, 807,
-	808, 9, 3, 2, 2, 808, 809, 9, 9, 2, 2, 809, 220, 3, 2, 2, 2, 810, 811,
-	9, 14, 2, 2, 811, 812, 9, 5, 2, 2, 812, 222, 3, 2, 2, 2, 813, 814, 9, 24,
-	2, 2, 814, 815, 9, 5, 2, 2, 815, 816, 9, 17, 2, 2, 816, 224, 3, 2, 2, 2,
-	817, 818, 9, 17, 2, 2, 818, 819, 9, 16, 2, 2, 819, 820, 9, 27, 2, 2, 820,
-	821, 9, 2, 2, 2, 821, 822, 9, 4, 2, 2, 822, 823, 9, 17, 2, 2, 823, 824,
-	9, 16, 2, 2, 824, 226, 3, 2, 2, 2, 825, 826, 9, 2, 2, 2, 826, 827, 9, 3,
-	2, 2, 827, 828, 9, 4, 2, 2, 828, 829, 9, 27, 2, 2, 829, 830, 9, 2, 2, 2,
-	830, 831, 9, 16, 2, 2, 831, 228, 3, 2, 2, 2, 832, 833, 9, 10, 2, 2, 833,
-	834, 9, 6, 2, 2, 834, 835, 9, 3, 2, 2, 835, 836, 9, 14, 2, 2, 836, 837,
-	9, 6, 2, 2, 837, 838, 9, 9, 2, 2, 838, 839, 9, 5, 2, 2, 839, 840, 9, 17,
-	2, 2, 840, 841, 9, 20, 2, 2, 841, 230, 3, 2, 2, 2, 842, 843, 9, 15, 2,
-	2, 843, 844, 9, 11, 2, 2, 844, 845, 9, 6, 2, 2, 845, 846, 9, 7, 2, 2, 846,
-	847, 9, 6, 2, 2, 847, 848, 9, 17, 2, 2, 848, 232, 3, 2, 2, 2, 849, 850,
-	9, 5, 2, 2, 850, 851, 9, 24, 2, 2, 851, 234, 3, 2, 2, 2, 852, 853, 9, 6,
-	2, 2, 853, 854, 9, 14, 2, 2, 854, 855, 9, 14, 2, 2, 855, 236, 3, 2, 2,
-	2, 856, 857, 9, 14, 2, 2, 857, 858, 9, 17, 2, 2, 858, 859, 9, 5, 2, 2,
-	859, 860, 9, 8, 2, 2, 860, 238, 3, 2, 2, 2, 861, 862, 9, 24, 2, 2, 862,
-	863, 9, 4, 2, 2, 863, 864, 9, 7, 2, 2, 864, 865, 9, 9, 2, 2, 865, 866,
-	9, 16, 2, 2, 866, 867, 9, 17, 2, 2, 867, 240, 3, 2, 2, 2, 868, 869, 9,
-	16, 2, 2, 869, 870, 9, 23, 2, 2, 870, 871, 9, 9, 2, 2, 871, 872, 9, 17,
-	2, 2, 872, 873, 9, 6, 2, 2, 873, 874, 9, 11, 2, 2, 874, 875, 9, 9, 2, 2,
-	875, 242, 3, 2, 2, 2, 876, 880, 5, 245, 123, 2, 877, 879, 5, 247, 124,
-	2, 878, 877, 3, 2, 2, 2, 879, 882, 3, 2, 2, 2, 880, 878, 3, 2, 2, 2, 880,
-	881, 3, 2, 2, 2, 881, 244, 3, 2, 2, 2, 882, 880, 3, 2, 2, 2, 883, 886,
-	5, 295, 148, 2, 884, 886, 5, 283, 142, 2, 885, 883, 3, 2, 2, 2, 885, 884,
-	3, 2, 2, 2, 886, 246, 3, 2, 2, 2, 887, 890, 5, 263, 132, 2, 888, 890, 5,
-	279, 140, 2, 889, 887, 3, 2, 2, 2, 889, 888, 3, 2, 2, 2, 890, 248, 3, 2,
-	2, 2, 891, 895, 7, 98, 2, 2, 892, 894, 5, 259, 130, 2, 893, 892, 3, 2,
-	2, 2, 894, 897, 3, 2, 2, 2, 895, 893, 3, 2, 2, 2, 895, 896, 3, 2, 2, 2,
-	896, 898, 3, 2, 2, 2, 897, 895, 3, 2, 2, 2, 898, 900, 7, 98, 2, 2, 899,
-	891, 3, 2, 2, 2, 900, 901, 3, 2, 2, 2, 901, 899, 3, 2, 2, 2, 901, 902,
-	3, 2, 2, 2, 902, 250, 3, 2, 2, 2, 903, 905, 5, 253, 127, 2, 904, 903, 3,
-	2, 2, 2, 905, 906, 3, 2, 2, 2, 906, 904, 3, 2, 2, 2, 906, 907, 3, 2, 2,
-	2, 907, 252, 3, 2, 2, 2, 908, 921, 5, 281, 141, 2, 909, 921, 5, 285, 143,
-	2, 910, 921, 5, 289, 145, 2, 911, 921, 5, 291, 146, 2, 912, 921, 5, 257,
-	129, 2, 913, 921, 5, 277, 139, 2, 914, 921, 5, 275, 138, 2, 915, 921, 5,
-	273, 137, 2, 916, 921, 5, 261, 131, 2, 917, 921, 5, 293, 147, 2, 918, 921,
-	9, 28, 2, 2, 919, 921, 5, 255, 128, 2, 920, 908, 3, 2, 2, 2, 920, 909,
-	3, 2, 2, 2, 920, 910, 3, 2, 2, 2, 920, 911, 3, 2, 2, 2, 920, 912, 3, 2,
-	2, 2, 920, 913, 3, 2, 2, 2, 920, 914, 3, 2, 2, 2, 920, 915, 3, 2, 2, 2,
-	920, 916, 3, 2, 2, 2, 920, 917, 3, 2, 2, 2, 920, 918, 3, 2, 2, 2, 920,
-	919, 3, 2, 2, 2, 921, 254, 3, 2, 2, 2, 922, 923, 7, 49, 2, 2, 923, 924,
-	7, 44, 2, 2, 924, 930, 3, 2, 2, 2, 925, 929, 5, 265, 133, 2, 926, 927,
-	7, 44, 2, 2, 927, 929, 5, 271, 136, 2, 928, 925, 3, 2, 2, 2, 928, 926,
-	3, 2, 2, 2, 929, 932, 3, 2, 2, 2, 930, 928, 3, 2, 2, 2, 930, 931, 3, 2,
-	2, 2, 931, 933, 3, 2, 2, 2, 932, 930, 3, 2, 2, 2, 933, 934, 7, 44, 2, 2,
-	934, 952, 7, 49, 2, 2, 935, 936, 7, 49, 2, 2, 936, 937, 7, 49, 2, 2, 937,
-	941, 3, 2, 2, 2, 938, 940, 5, 269, 135, 2, 939, 938, 3, 2, 2, 2, 940, 943,
-	3, 2, 2, 2, 941, 939, 3, 2, 2, 2, 941, 942, 3, 2, 2, 2, 942, 945, 3, 2,
-	2, 2, 943, 941, 3, 2, 2, 2, 944, 946, 5, 277, 139, 2, 945, 944, 3, 2, 2,
-	2, 945, 946, 3, 2, 2, 2, 946, 949, 3, 2, 2, 2, 947, 950, 5, 289, 145, 2,
-	948, 950, 7, 2, 2, 3, 949, 947, 3, 2, 2, 2, 949, 948, 3, 2, 2, 2, 950,
-	952, 3, 2, 2, 2, 951, 922, 3, 2, 2, 2, 951, 935, 3, 2, 2, 2, 952, 256,
-	3, 2, 2, 2, 953, 954, 9, 29, 2, 2, 954, 258, 3, 2, 2, 2, 955, 956, 10,
-	30, 2, 2, 956, 260, 3, 2, 2, 2, 957, 958, 9, 31, 2, 2, 958, 262, 3, 2,
-	2, 2, 959, 960, 9, 47, 2, 2, 960, 264, 3, 2, 2, 2, 961, 962, 10, 32, 2,
-	2, 962, 266, 3, 2, 2, 2, 963, 964, 10, 33, 2, 2, 964, 268, 3, 2, 2, 2,
-	965, 966, 10, 34, 2, 2, 966, 270, 3, 2, 2, 2, 967, 968, 10, 35, 2, 2, 968,
-	272, 3, 2, 2, 2, 969, 970, 9, 36, 2, 2, 970, 274, 3, 2, 2, 2, 971, 972,
-	9, 37, 2, 2, 972, 276, 3, 2, 2, 2, 973, 974, 9, 38, 2, 2, 974, 278, 3,
-	2, 2, 2, 975, 976, 9, 39, 2, 2, 976, 280, 3, 2, 2, 2, 977, 978, 9, 40,
-	2, 2, 978, 282, 3, 2, 2, 2, 979, 980, 9, 41, 2, 2, 980, 284, 3, 2, 2, 2,
-	981, 982, 9, 42, 2, 2, 982, 286, 3, 2, 2, 2, 983, 984, 10, 43, 2, 2, 984,
-	288, 3, 2, 2, 2, 985, 986, 9, 44, 2, 2, 986, 290, 3, 2, 2, 2, 987, 988,
-	9, 45, 2, 2, 988, 292, 3, 2, 2, 2, 989, 990, 9, 46, 2, 2, 990, 294, 3,
-	2, 2, 2, 991, 992, 9, 48, 2, 2, 992, 296, 3, 2, 2, 2, 40, 2, 670, 672,
-	679, 681, 685, 705, 713, 720, 723, 729, 732, 736, 740, 744, 750, 757, 762,
-	768, 774, 776, 780, 785, 790, 797, 880, 885, 889, 895, 901, 906, 920, 928,
-	930, 941, 945, 949, 951, 2,
+	253, 3, 2, 2, 2, 2, 255, 3, 2, 2, 2, 2, 257, 3, 2, 2, 2, 2, 259, 3, 2,
+	2, 2, 2, 261, 3, 2, 2, 2, 2, 263, 3, 2, 2, 2, 3, 305, 3, 2, 2, 2, 5, 307,
+	3, 2, 2, 2, 7, 309, 3, 2, 2, 2, 9, 311, 3, 2, 2, 2, 11, 314, 3, 2, 2, 2,
+	13, 316, 3, 2, 2, 2, 15, 318, 3, 2, 2, 2, 17, 320, 3, 2, 2, 2, 19, 322,
+	3, 2, 2, 2, 21, 324, 3, 2, 2, 2, 23, 326, 3, 2, 2, 2, 25, 328, 3, 2, 2,
+	2, 27, 331, 3, 2, 2, 2, 29, 333, 3, 2, 2, 2, 31, 335, 3, 2, 2, 2, 33, 337,
+	3, 2, 2, 2, 35, 339, 3, 2, 2, 2, 37, 341, 3, 2, 2, 2, 39, 344, 3, 2, 2,
+	2, 41, 346, 3, 2, 2, 2, 43, 348, 3, 2, 2, 2, 45, 350, 3, 2, 2, 2, 47, 353,
+	3, 2, 2, 2, 49, 355, 3, 2, 2, 2, 51, 357, 3, 2, 2, 2, 53, 360, 3, 2, 2,
+	2, 55, 363, 3, 2, 2, 2, 57, 365, 3, 2, 2, 2, 59, 367, 3, 2, 2, 2, 61, 369,
+	3, 2, 2, 2, 63, 371, 3, 2, 2, 2, 65, 373, 3, 2, 2, 2, 67, 375, 3, 2, 2,
+	2, 69, 377, 3, 2, 2, 2, 71, 379, 3, 2, 2, 2, 73, 381, 3, 2, 2, 2, 75, 383,
+	3, 2, 2, 2, 77, 385, 3, 2, 2, 2, 79, 387, 3, 2, 2, 2, 81, 389, 3, 2, 2,
+	2, 83, 391, 3, 2, 2, 2, 85, 393, 3, 2, 2, 2, 87, 395, 3, 2, 2, 2, 89, 397,
+	3, 2, 2, 2, 91, 399, 3, 2, 2, 2, 93, 401, 3, 2, 2, 2, 95, 403, 3, 2, 2,
+	2, 97, 409, 3, 2, 2, 2, 99, 413, 3, 2, 2, 2, 101, 422, 3, 2, 2, 2, 103,
+	428, 3, 2, 2, 2, 105, 435, 3, 2, 2, 2, 107, 438, 3, 2, 2, 2, 109, 444,
+	3, 2, 2, 2, 111, 447, 3, 2, 2, 2, 113, 454, 3, 2, 2, 2, 115, 458, 3, 2,
+	2, 2, 117, 465, 3, 2, 2, 2, 119, 472, 3, 2, 2, 2, 121, 479, 3, 2, 2, 2,
+	123, 484, 3, 2, 2, 2, 125, 490, 3, 2, 2, 2, 127, 495, 3, 2, 2, 2, 129,
+	502, 3, 2, 2, 2, 131, 511, 3, 2, 2, 2, 133, 517, 3, 2, 2, 2, 135, 520,
+	3, 2, 2, 2, 137, 525, 3, 2, 2, 2, 139, 531, 3, 2, 2, 2, 141, 541, 3, 2,
+	2, 2, 143, 545, 3, 2, 2, 2, 145, 556, 3, 2, 2, 2, 147, 561, 3, 2, 2, 2,
+	149, 567, 3, 2, 2, 2, 151, 580, 3, 2, 2, 2, 153, 597, 3, 2, 2, 2, 155,
+	600, 3, 2, 2, 2, 157, 604, 3, 2, 2, 2, 159, 608, 3, 2, 2, 2, 161, 612,
+	3, 2, 2, 2, 163, 615, 3, 2, 2, 2, 165, 622, 3, 2, 2, 2, 167, 627, 3, 2,
+	2, 2, 169, 636, 3, 2, 2, 2, 171, 639, 3, 2, 2, 2, 173, 644, 3, 2, 2, 2,
+	175, 651, 3, 2, 2, 2, 177, 657, 3, 2, 2, 2, 179, 661, 3, 2, 2, 2, 181,
+	666, 3, 2, 2, 2, 183, 673, 3, 2, 2, 2, 185, 678, 3, 2, 2, 2, 187, 684,
+	3, 2, 2, 2, 189, 691, 3, 2, 2, 2, 191, 696, 3, 2, 2, 2, 193, 701, 3, 2,
+	2, 2, 195, 705, 3, 2, 2, 2, 197, 710, 3, 2, 2, 2, 199, 733, 3, 2, 2, 2,
+	201, 735, 3, 2, 2, 2, 203, 755, 3, 2, 2, 2, 205, 771, 3, 2, 2, 2, 207,
+	773, 3, 2, 2, 2, 209, 780, 3, 2, 2, 2, 211, 784, 3, 2, 2, 2, 213, 788,
+	3, 2, 2, 2, 215, 792, 3, 2, 2, 2, 217, 794, 3, 2, 2, 2, 219, 798, 3, 2,
+	2, 2, 221, 800, 3, 2, 2, 2, 223, 824, 3, 2, 2, 2, 225, 838, 3, 2, 2, 2,
+	227, 847, 3, 2, 2, 2, 229, 858, 3, 2, 2, 2, 231, 861, 3, 2, 2, 2, 233,
+	865, 3, 2, 2, 2, 235, 873, 3, 2, 2, 2, 237, 880, 3, 2, 2, 2, 239, 890,
+	3, 2, 2, 2, 241, 897, 3, 2, 2, 2, 243, 900, 3, 2, 2, 2, 245, 904, 3, 2,
+	2, 2, 247, 909, 3, 2, 2, 2, 249, 916, 3, 2, 2, 2, 251, 924, 3, 2, 2, 2,
+	253, 933, 3, 2, 2, 2, 255, 937, 3, 2, 2, 2, 257, 947, 3, 2, 2, 2, 259,
+	952, 3, 2, 2, 2, 261, 968, 3, 2, 2, 2, 263, 999, 3, 2, 2, 2, 265, 1001,
+	3, 2, 2, 2, 267, 1003, 3, 2, 2, 2, 269, 1005, 3, 2, 2, 2, 271, 1007, 3,
+	2, 2, 2, 273, 1009, 3, 2, 2, 2, 275, 1011, 3, 2, 2, 2, 277, 1013, 3, 2,
+	2, 2, 279, 1015, 3, 2, 2, 2, 281, 1017, 3, 2, 2, 2, 283, 1019, 3, 2, 2,
+	2, 285, 1021, 3, 2, 2, 2, 287, 1023, 3, 2, 2, 2, 289, 1025, 3, 2, 2, 2,
+	291, 1027, 3, 2, 2, 2, 293, 1029, 3, 2, 2, 2, 295, 1031, 3, 2, 2, 2, 297,
+	1033, 3, 2, 2, 2, 299, 1035, 3, 2, 2, 2, 301, 1037, 3, 2, 2, 2, 303, 1039,
+	3, 2, 2, 2, 305, 306, 7, 61, 2, 2, 306, 4, 3, 2, 2, 2, 307, 308, 7, 46,
+	2, 2, 308, 6, 3, 2, 2, 2, 309, 310, 7, 63, 2, 2, 310, 8, 3, 2, 2, 2, 311,
+	312, 7, 45, 2, 2, 312, 313, 7, 63, 2, 2, 313, 10, 3, 2, 2, 2, 314, 315,
+	7, 44, 2, 2, 315, 12, 3, 2, 2, 2, 316, 317, 7, 42, 2, 2, 317, 14, 3, 2,
+	2, 2, 318, 319, 7, 43, 2, 2, 319, 16, 3, 2, 2, 2, 320, 321, 7, 93, 2, 2,
+	321, 18, 3, 2, 2, 2, 322, 323, 7, 95, 2, 2, 323, 20, 3, 2, 2, 2, 324, 325,
+	7, 60, 2, 2, 325, 22, 3, 2, 2, 2, 326, 327, 7, 126, 2, 2, 327, 24, 3, 2,
+	2, 2, 328, 329, 7, 48, 2, 2, 329, 330, 7, 48, 2, 2, 330, 26, 3, 2, 2, 2,
+	331, 332, 7, 45, 2, 2, 332, 28, 3, 2, 2, 2, 333, 334, 7, 47, 2, 2, 334,
+	30, 3, 2, 2, 2, 335, 336, 7, 49, 2, 2, 336, 32, 3, 2, 2, 2, 337, 338, 7,
+	39, 2, 2, 338, 34, 3, 2, 2, 2, 339, 340, 7, 96, 2, 2, 340, 36, 3, 2, 2,
+	2, 341, 342, 7, 63, 2, 2, 342, 343, 7, 128, 2, 2, 343, 38, 3, 2, 2, 2,
+	344, 345, 7, 125, 2, 2, 345, 40, 3, 2, 2, 2, 346, 347, 7, 127, 2, 2, 347,
+	42, 3, 2, 2, 2, 348, 349, 7, 48, 2, 2, 349, 44, 3, 2, 2, 2, 350, 351, 7,
+	62, 2, 2, 351, 352, 7, 64, 2, 2, 352, 46, 3, 2, 2, 2, 353, 354, 7, 62,
+	2, 2, 354, 48, 3, 2, 2, 2, 355, 356, 7, 64, 2, 2, 356, 50, 3, 2, 2, 2,
+	357, 358, 7, 62, 2, 2, 358, 359, 7, 63, 2, 2, 359, 52, 3, 2, 2, 2, 360,
+	361, 7, 64, 2, 2, 361, 362, 7, 63, 2, 2, 362, 54, 3, 2, 2, 2, 363, 364,
+	7, 38, 2, 2, 364, 56, 3, 2, 2, 2, 365, 366, 7, 10218, 2, 2, 366, 58, 3,
+	2, 2, 2, 367, 368, 7, 12298, 2, 2, 368, 60, 3, 2, 2, 2, 369, 370, 7, 65126,
+	2, 2, 370, 62, 3, 2, 2, 2, 371, 372, 7, 65310, 2, 2, 372, 64, 3, 2, 2,
+	2, 373, 374, 7, 10219, 2, 2, 374, 66, 3, 2, 2, 2, 375, 376, 7, 12299, 2,
+	2, 376, 68, 3, 2, 2, 2, 377, 378, 7, 65127, 2, 2, 378, 70, 3, 2, 2, 2,
+	379, 380, 7, 65312, 2, 2, 380, 72, 3, 2, 2, 2, 381, 382, 7, 175, 2, 2,
+	382, 74, 3, 2, 2, 2, 383, 384, 7, 8210, 2, 2, 384, 76, 3, 2, 2, 2, 385,
+	386, 7, 8211, 2, 2, 386, 78, 3, 2, 2, 2, 387, 388, 7, 8212, 2, 2, 388,
+	80, 3, 2, 2, 2, 389, 390, 7, 8213, 2, 2, 390, 82, 3, 2, 2, 2, 391, 392,
+	7, 8214, 2, 2, 392, 84, 3, 2, 2, 2, 393, 394, 7, 8215, 2, 2, 394, 86, 3,
+	2, 2, 2, 395, 396, 7, 8724, 2, 2, 396, 88, 3, 2, 2, 2, 397, 398, 7, 65114,
+	2, 2, 398, 90, 3, 2, 2, 2, 399, 400, 7, 65125, 2, 2, 400, 92, 3, 2, 2,
+	2, 401, 402, 7, 65295, 2, 2, 402, 94, 3, 2, 2, 2, 403, 404, 9, 2, 2, 2,
+	404, 405, 9, 3, 2, 2, 405, 406, 9, 4, 2, 2, 406, 407, 9, 5, 2, 2, 407,
+	408, 9, 3, 2, 2, 408, 96, 3, 2, 2, 2, 409, 410, 9, 6, 2, 2, 410, 411, 9,
+	7, 2, 2, 411, 412, 9, 7, 2, 2, 412, 98, 3, 2, 2, 2, 413, 414, 9, 5, 2,
+	2, 414, 415, 9, 8, 2, 2, 415, 416, 9, 9, 2, 2, 416, 417, 9, 4, 2, 2, 417,
+	418, 9, 5, 2, 2, 418, 419, 9, 3, 2, 2, 419, 420, 9, 6, 2, 2, 420, 421,
+	9, 7, 2, 2, 421, 100, 3, 2, 2, 2, 422, 423, 9, 10, 2, 2, 423, 424, 9, 6,
+	2, 2, 424, 425, 9, 9, 2, 2, 425, 426, 9, 11, 2, 2, 426, 427, 9, 12, 2,
+	2, 427, 102, 3, 2, 2, 2, 428, 429, 9, 2, 2, 2, 429, 430, 9, 3, 2, 2, 430,
+	431, 9, 13, 2, 2, 431, 432, 9, 4, 2, 2, 432, 433, 9, 3, 2, 2, 433, 434,
+	9, 14, 2, 2, 434, 104, 3, 2, 2, 2, 435, 436, 9, 6, 2, 2, 436, 437, 9, 15,
+	2, 2, 437, 106, 3, 2, 2, 2, 438, 439, 9, 10, 2, 2, 439, 440, 9, 16, 2,
+	2, 440, 441, 9, 17, 2, 2, 441, 442, 9, 18, 2, 2, 442, 443, 9, 16, 2, 2,
+	443, 108, 3, 2, 2, 2, 444, 445, 9, 5, 2, 2, 445, 446, 9, 3, 2, 2, 446,
+	110, 3, 2, 2, 2, 447, 448, 9, 11, 2, 2, 448, 449, 9, 17, 2, 2, 449, 450,
+	9, 16, 2, 2, 450, 451, 9, 6, 2, 2, 451, 452, 9, 9, 2, 2, 452, 453, 9, 16,
+	2, 2, 453, 112, 3, 2, 2, 2, 454, 455, 9, 15, 2, 2, 455, 456, 9, 16, 2,
+	2, 456, 457, 9, 9, 2, 2, 457, 114, 3, 2, 2, 2, 458, 459, 9, 14, 2, 2, 459,
+	460, 9, 16, 2, 2, 460, 461, 9, 9, 2, 2, 461, 462, 9, 6, 2, 2, 462, 463,
+	9, 11, 2, 2, 463, 464, 9, 12, 2, 2, 464, 116, 3, 2, 2, 2, 465, 466, 9,
+	14, 2, 2, 466, 467, 9, 16, 2, 2, 467, 468, 9, 7, 2, 2, 468, 469, 9, 16,
+	2, 2, 469, 470, 9, 9, 2, 2, 470, 471, 9, 16, 2, 2, 471, 118, 3, 2, 2, 2,
+	472, 473, 9, 17, 2, 2, 473, 474, 9, 16, 2, 2, 474, 475, 9, 10, 2, 2, 475,
+	476, 9, 5, 2, 2, 476, 477, 9, 19, 2, 2, 477, 478, 9, 16, 2, 2, 478, 120,
+	3, 2, 2, 2, 479, 480, 9, 11, 2, 2, 480, 481, 9, 6, 2, 2, 481, 482, 9, 7,
+	2, 2, 482, 483, 9, 7, 2, 2, 483, 122, 3, 2, 2, 2, 484, 485, 9, 20, 2, 2,
+	485, 486, 9, 4, 2, 2, 486, 487, 9, 16, 2, 2, 487, 488, 9, 7, 2, 2, 488,
+	489, 9, 14, 2, 2, 489, 124, 3, 2, 2, 2, 490, 491, 9, 13, 2, 2, 491, 492,
+	9, 4, 2, 2, 492, 493, 9, 9, 2, 2, 493, 494, 9, 12, 2, 2, 494, 126, 3, 2,
+	2, 2, 495, 496, 9, 17, 2, 2, 496, 497, 9, 16, 2, 2, 497, 498, 9, 9, 2,
+	2, 498, 499, 9, 2, 2, 2, 499, 500, 9, 17, 2, 2, 500, 501, 9, 3, 2, 2, 501,
+	128, 3, 2, 2, 2, 502, 503, 9, 14, 2, 2, 503, 504, 9, 4, 2, 2, 504, 505,
+	9, 15, 2, 2, 505, 506, 9, 9, 2, 2, 506, 507, 9, 4, 2, 2, 507, 508, 9, 3,
+	2, 2, 508, 509, 9, 11, 2, 2, 509, 510, 9, 9, 2, 2, 510, 130, 3, 2, 2, 2,
+	511, 512, 9, 5, 2, 2, 512, 513, 9, 17, 2, 2, 513, 514, 9, 14, 2, 2, 514,
+	515, 9, 16, 2, 2, 515, 516, 9, 17, 2, 2, 516, 132, 3, 2, 2, 2, 517, 518,
+	9, 21, 2, 2, 518, 519, 9, 20, 2, 2, 519, 134, 3, 2, 2, 2, 520, 521, 9,
+	15, 2, 2, 521, 522, 9, 22, 2, 2, 522, 523, 9, 4, 2, 2, 523, 524, 9, 8,
+	2, 2, 524, 136, 3, 2, 2, 2, 525, 526, 9, 7, 2, 2, 526, 527, 9, 4, 2, 2,
+	527, 528, 9, 10, 2, 2, 528, 529, 9, 4, 2, 2, 529, 530, 9, 9, 2, 2, 530,
+	138, 3, 2, 2, 2, 531, 532, 9, 6, 2, 2, 532, 533, 9, 15, 2, 2, 533, 534,
+	9, 11, 2, 2, 534, 535, 9, 16, 2, 2, 535, 536, 9, 3, 2, 2, 536, 537, 9,
+	14, 2, 2, 537, 538, 9, 4, 2, 2, 538, 539, 9, 3, 2, 2, 539, 540, 9, 18,
+	2, 2, 540, 140, 3, 2, 2, 2, 541, 542, 9, 6, 2, 2, 542, 543, 9, 15, 2, 2,
+	543, 544, 9, 11, 2, 2, 544, 142, 3, 2, 2, 2, 545, 546, 9, 14, 2, 2, 546,
+	547, 9, 16, 2, 2, 547, 548, 9, 15, 2, 2, 548, 549, 9, 11, 2, 2, 549, 550,
+	9, 16, 2, 2, 550, 551, 9, 3, 2, 2, 551, 552, 9, 14, 2, 2, 552, 553, 9,
+	4, 2, 2, 553, 554, 9, 3, 2, 2, 554, 555, 9, 18, 2, 2, 555, 144, 3, 2, 2,
+	2, 556, 557, 9, 14, 2, 2, 557, 558, 9, 16, 2, 2, 558, 559, 9, 15, 2, 2,
+	559, 560, 9, 11, 2, 2, 560, 146, 3, 2, 2, 2, 561, 562, 9, 13, 2, 2, 562,
+	563, 9, 12, 2, 2, 563, 564, 9, 16, 2, 2, 564, 565, 9, 17, 2, 2, 565, 566,
+	9, 16, 2, 2, 566, 148, 3, 2, 2, 2, 567, 568, 9, 15, 2, 2, 568, 569, 9,
+	12, 2, 2, 569, 570, 9, 5, 2, 2, 570, 571, 9, 17, 2, 2, 571, 572, 9, 9,
+	2, 2, 572, 573, 9, 16, 2, 2, 573, 574, 9, 15, 2, 2, 574, 575, 9, 9, 2,
+	2, 575, 576, 9, 8, 2, 2, 576, 577, 9, 6, 2, 2, 577, 578, 9, 9, 2, 2, 578,
+	579, 9, 12, 2, 2, 579, 150, 3, 2, 2, 2, 580, 581, 9, 6, 2, 2, 581, 582,
+	9, 7, 2, 2, 582, 583, 9, 7, 2, 2, 583, 584, 9, 15, 2, 2, 584, 585, 9, 12,
+	2, 2, 585, 586, 9, 5, 2, 2, 586, 587, 9, 17, 2, 2, 587, 588, 9, 9, 2, 2,
+	588, 589, 9, 16, 2, 2, 589, 590, 9, 15, 2, 2, 590, 591, 9, 9, 2, 2, 591,
+	592, 9, 8, 2, 2, 592, 593, 9, 6, 2, 2, 593, 594, 9, 9, 2, 2, 594, 595,
+	9, 12, 2, 2, 595, 596, 9, 15, 2, 2, 596, 152, 3, 2, 2, 2, 597, 598, 9,
+	5, 2, 2, 598, 599, 9, 17, 2, 2, 599, 154, 3, 2, 2, 2, 600, 601, 9, 23,
+	2, 2, 601, 602, 9, 5, 2, 2, 602, 603, 9, 17, 2, 2, 603, 156, 3, 2, 2, 2,
+	604, 605, 9, 6, 2, 2, 605, 606, 9, 3, 2, 2, 606, 607, 9, 14, 2, 2, 607,
+	158, 3, 2, 2, 2, 608, 609, 9, 3, 2, 2, 609, 610, 9, 5, 2, 2, 610, 611,
+	9, 9, 2, 2, 611, 160, 3, 2, 2, 2, 612, 613, 9, 4, 2, 2, 613, 614, 9, 3,
+	2, 2, 614, 162, 3, 2, 2, 2, 615, 616, 9, 15, 2, 2, 616, 617, 9, 9, 2, 2,
+	617, 618, 9, 6, 2, 2, 618, 619, 9, 17, 2, 2, 619, 620, 9, 9, 2, 2, 620,
+	621, 9, 15, 2, 2, 621, 164, 3, 2, 2, 2, 622, 623, 9, 16, 2, 2, 623, 624,
+	9, 3, 2, 2, 624, 625, 9, 14, 2, 2, 625, 626, 9, 15, 2, 2, 626, 166, 3,
+	2, 2, 2, 627, 628, 9, 11, 2, 2, 628, 629, 9, 5, 2, 2, 629, 630, 9, 3, 2,
+	2, 630, 631, 9, 9, 2, 2, 631, 632, 9, 6, 2, 2, 632, 633, 9, 4, 2, 2, 633,
+	634, 9, 3, 2, 2, 634, 635, 9, 15, 2, 2, 635, 168, 3, 2, 2, 2, 636, 637,
+	9, 4, 2, 2, 637, 638, 9, 15, 2, 2, 638, 170, 3, 2, 2, 2, 639, 640, 9, 3,
+	2, 2, 640, 641, 9, 2, 2, 2, 641, 642, 9, 7, 2, 2, 642, 643, 9, 7, 2, 2,
+	643, 172, 3, 2, 2, 2, 644, 645, 9, 17, 2, 2, 645, 646, 9, 16, 2, 2, 646,
+	647, 9, 14, 2, 2, 647, 648, 9, 2, 2, 2, 648, 649, 9, 11, 2, 2, 649, 650,
+	9, 16, 2, 2, 650, 174, 3, 2, 2, 2, 651, 652, 9, 11, 2, 2, 652, 653, 9,
+	5, 2, 2, 653, 654, 9, 2, 2, 2, 654, 655, 9, 3, 2, 2, 655, 656, 9, 9, 2,
+	2, 656, 176, 3, 2, 2, 2, 657, 658, 9, 6, 2, 2, 658, 659, 9, 3, 2, 2, 659,
+	660, 9, 20, 2, 2, 660, 178, 3, 2, 2, 2, 661, 662, 9, 3, 2, 2, 662, 663,
+	9, 5, 2, 2, 663, 664, 9, 3, 2, 2, 664, 665, 9, 16, 2, 2, 665, 180, 3, 2,
+	2, 2, 666, 667, 9, 15, 2, 2, 667, 668, 9, 4, 2, 2, 668, 669, 9, 3, 2, 2,
+	669, 670, 9, 18, 2, 2, 670, 671, 9, 7, 2, 2, 671, 672, 9, 16, 2, 2, 672,
+	182, 3, 2, 2, 2, 673, 674, 9, 9, 2, 2, 674, 675, 9, 17, 2, 2, 675, 676,
+	9, 2, 2, 2, 676, 677, 9, 16, 2, 2, 677, 184, 3, 2, 2, 2, 678, 679, 9, 24,
+	2, 2, 679, 680, 9, 6, 2, 2, 680, 681, 9, 7, 2, 2, 681, 682, 9, 15, 2, 2,
+	682, 683, 9, 16, 2, 2, 683, 186, 3, 2, 2, 2, 684, 685, 9, 16, 2, 2, 685,
+	686, 9, 23, 2, 2, 686, 687, 9, 4, 2, 2, 687, 688, 9, 15, 2, 2, 688, 689,
+	9, 9, 2, 2, 689, 690, 9, 15, 2, 2, 690, 188, 3, 2, 2, 2, 691, 692, 9, 11,
+	2, 2, 692, 693, 9, 6, 2, 2, 693, 694, 9, 15, 2, 2, 694, 695, 9, 16, 2,
+	2, 695, 190, 3, 2, 2, 2, 696, 697, 9, 16, 2, 2, 697, 698, 9, 7, 2, 2, 698,
+	699, 9, 15, 2, 2, 699, 700, 9, 16, 2, 2, 700, 192, 3, 2, 2, 2, 701, 702,
+	9, 16, 2, 2, 702, 703, 9, 3, 2, 2, 703, 704, 9, 14, 2, 2, 704, 194, 3,
+	2, 2, 2, 705, 706, 9, 13, 2, 2, 706, 707, 9, 12, 2, 2, 707, 708, 9, 16,
+	2, 2, 708, 709, 9, 3, 2, 2, 709, 196, 3, 2, 2, 2, 710, 711, 9, 9, 2, 2,
+	711, 712, 9, 12, 2, 2, 712, 713, 9, 16, 2, 2, 713, 714, 9, 3, 2, 2, 714,
+	198, 3, 2, 2, 2, 715, 720, 7, 36, 2, 2, 716, 719, 5, 295, 148, 2, 717,
+	719, 5, 201, 101, 2, 718, 716, 3, 2, 2, 2, 718, 717, 3, 2, 2, 2, 719, 722,
+	3, 2, 2, 2, 720, 718, 3, 2, 2, 2, 720, 721, 3, 2, 2, 2, 721, 723, 3, 2,
+	2, 2, 722, 720, 3, 2, 2, 2, 723, 734, 7, 36, 2, 2, 724, 729, 7, 41, 2,
+	2, 725, 728, 5, 275, 138, 2, 726, 728, 5, 201, 101, 2, 727, 725, 3, 2,
+	2, 2, 727, 726, 3, 2, 2, 2, 728, 731, 3, 2, 2, 2, 729, 727, 3, 2, 2, 2,
+	729, 730, 3, 2, 2, 2, 730, 732, 3, 2, 2, 2, 731, 729, 3, 2, 2, 2, 732,
+	734, 7, 41, 2, 2, 733, 715, 3, 2, 2, 2, 733, 724, 3, 2, 2, 2, 734, 200,
+	3, 2, 2, 2, 735, 753, 7, 94, 2, 2, 736, 754, 9, 25, 2, 2, 737, 738, 9,
+	2, 2, 2, 738, 739, 5, 211, 106, 2, 739, 740, 5, 211, 106, 2, 740, 741,
+	5, 211, 106, 2, 741, 742, 5, 211, 106, 2, 742, 754, 3, 2, 2, 2, 743, 744,
+	9, 2, 2, 2, 744, 745, 5, 211, 106, 2, 745, 746, 5, 211, 106, 2, 746, 747,
+	5, 211, 106, 2, 747, 748, 5, 211, 106, 2, 748, 749, 5, 211, 106, 2, 749,
+	750, 5, 211, 106, 2, 750, 751, 5, 211, 106, 2, 751, 752, 5, 211, 106, 2,
+	752, 754, 3, 2, 2, 2, 753, 736, 3, 2, 2, 2, 753, 737, 3, 2, 2, 2, 753,
+	743, 3, 2, 2, 2, 754, 202, 3, 2, 2, 2, 755, 756, 7, 50, 2, 2, 756, 757,
+	7, 122, 2, 2, 757, 759, 3, 2, 2, 2, 758, 760, 5, 211, 106, 2, 759, 758,
+	3, 2, 2, 2, 760, 761, 3, 2, 2, 2, 761, 759, 3, 2, 2, 2, 761, 762, 3, 2,
+	2, 2, 762, 204, 3, 2, 2, 2, 763, 772, 5, 221, 111, 2, 764, 768, 5, 215,
+	108, 2, 765, 767, 5, 213, 107, 2, 766, 765, 3, 2, 2, 2, 767, 770, 3, 2,
+	2, 2, 768, 766, 3, 2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 772, 3, 2, 2, 2,
+	770, 768, 3, 2, 2, 2, 771, 763, 3, 2, 2, 2, 771, 764, 3, 2, 2, 2, 772,
+	206, 3, 2, 2, 2, 773, 775, 5, 221, 111, 2, 774, 776, 5, 219, 110, 2, 775,
+	774, 3, 2, 2, 2, 776, 777, 3, 2, 2, 2, 777, 775, 3, 2, 2, 2, 777, 778,
+	3, 2, 2, 2, 778, 208, 3, 2, 2, 2, 779, 781, 9, 26, 2, 2, 780, 779, 3, 2,
+	2, 2, 781, 210, 3, 2, 2, 2, 782, 785, 5, 213, 107, 2, 783, 785, 5, 209,
+	105, 2, 784, 782, 3, 2, 2, 2, 784, 783, 3, 2, 2, 2, 785, 212, 3, 2, 2,
+	2, 786, 789, 5, 221, 111, 2, 787, 789, 5, 215, 108, 2, 788, 786, 3, 2,
+	2, 2, 788, 787, 3, 2, 2, 2, 789, 214, 3, 2, 2, 2, 790, 793, 5, 217, 109,
+	2, 791, 793, 4, 58, 59, 2, 792, 790, 3, 2, 2, 2, 792, 791, 3, 2, 2, 2,
+	793, 216, 3, 2, 2, 2, 794, 795, 4, 51, 57, 2, 795, 218, 3, 2, 2, 2, 796,
+	799, 5, 221, 111, 2, 797, 799, 5, 217, 109, 2, 798, 796, 3, 2, 2, 2, 798,
+	797, 3, 2, 2, 2, 799, 220, 3, 2, 2, 2, 800, 801, 7, 50, 2, 2, 801, 222,
+	3, 2, 2, 2, 802, 804, 5, 213, 107, 2, 803, 802, 3, 2, 2, 2, 804, 805, 3,
+	2, 2, 2, 805, 803, 3, 2, 2, 2, 805, 806, 3, 2, 2, 2, 806, 825, 3, 2, 2,
+	2, 807, 809, 5, 213, 107, 2, 808, 807, 3, 2, 2, 2, 809, 810, 3, 2, 2, 2,
+	810, 808, 3, 2, 2, 2, 810, 811, 3, 2, 2, 2, 811, 812, 3, 2, 2, 2, 812,
+	814, 7, 48, 2, 2, 813, 815, 5, 213, 107, 2, 814, 813, 3, 2, 2, 2, 815,
+	816, 3, 2, 2, 2, 816, 814, 3, 2, 2, 2, 816, 817, 3, 2, 2, 2, 817, 825,
+	3, 2, 2, 2, 818, 820, 7, 48, 2, 2, 819, 821, 5, 213, 107, 2, 820, 819,
+	3, 2, 2, 2, 821, 822, 3, 2, 2, 2, 822, 820, 3, 2, 2, 2, 822, 823, 3, 2,
+	2, 2, 823, 825, 3, 2, 2, 2, 824, 803, 3, 2, 2, 2, 824, 808, 3, 2, 2, 2,
+	824, 818, 3, 2, 2, 2, 825, 826, 3, 2, 2, 2, 826, 828, 9, 16, 2, 2, 827,
+	829, 7, 47, 2, 2, 828, 827, 3, 2, 2, 2, 828, 829, 3, 2, 2, 2, 829, 831,
+	3, 2, 2, 2, 830, 832, 5, 213, 107, 2, 831, 830, 3, 2, 2, 2, 832, 833, 3,
+	2, 2, 2, 833, 831, 3, 2, 2, 2, 833, 834, 3, 2, 2, 2, 834, 224, 3, 2, 2,
+	2, 835, 837, 5, 213, 107, 2, 836, 835, 3, 2, 2, 2, 837, 840, 3, 2, 2, 2,
+	838, 836, 3, 2, 2, 2, 838, 839, 3, 2, 2, 2, 839, 841, 3, 2, 2, 2, 840,
+	838, 3, 2, 2, 2, 841, 843, 7, 48, 2, 2, 842, 844, 5, 213, 107, 2, 843,
+	842, 3, 2, 2, 2, 844, 845, 3, 2, 2, 2, 845, 843, 3, 2, 2, 2, 845, 846,
+	3, 2, 2, 2, 846, 226, 3, 2, 2, 2, 847, 848, 9, 11, 2, 2, 848, 849, 9, 5,
+	2, 2, 849, 850, 9, 3, 2, 2, 850, 851, 9, 15, 2, 2, 851, 852, 9, 9, 2, 2,
+	852, 853, 9, 17, 2, 2, 853, 854, 9, 6, 2, 2, 854, 855, 9, 4, 2, 2, 855,
+	856, 9, 3, 2, 2, 856, 857, 9, 9, 2, 2, 857, 228, 3, 2, 2, 2, 858, 859,
+	9, 14, 2, 2, 859, 860, 9, 5, 2, 2, 860, 230, 3, 2, 2, 2, 861, 862, 9, 24,
+	2, 2, 862, 863, 9, 5, 2, 2, 863, 864, 9, 17, 2, 2, 864, 232, 3, 2, 2, 2,
+	865, 866, 9, 17, 2, 2, 866, 867, 9, 16, 2, 2, 867, 868, 9, 27, 2, 2, 868,
+	869, 9, 2, 2, 2, 869, 870, 9, 4, 2, 2, 870, 871, 9, 17, 2, 2, 871, 872,
+	9, 16, 2, 2, 872, 234, 3, 2, 2, 2, 873, 874, 9, 2, 2, 2, 874, 875, 9, 3,
+	2, 2, 875, 876, 9, 4, 2, 2, 876, 877, 9, 27, 2, 2, 877, 878, 9, 2, 2, 2,
+	878, 879, 9, 16, 2, 2, 879, 236, 3, 2, 2, 2, 880, 881, 9, 10, 2, 2, 881,
+	882, 9, 6, 2, 2, 882, 883, 9, 3, 2, 2, 883, 884, 9, 14, 2, 2, 884, 885,
+	9, 6, 2, 2, 885, 886, 9, 9, 2, 2, 886, 887, 9, 5, 2, 2, 887, 888, 9, 17,
+	2, 2, 888, 889, 9, 20, 2, 2, 889, 238, 3, 2, 2, 2, 890, 891, 9, 15, 2,
+	2, 891, 892, 9, 11, 2, 2, 892, 893, 9, 6, 2, 2, 893, 894, 9, 7, 2, 2, 894,
+	895, 9, 6, 2, 2, 895, 896, 9, 17, 2, 2, 896, 240, 3, 2, 2, 2, 897, 898,
+	9, 5, 2, 2, 898, 899, 9, 24, 2, 2, 899, 242, 3, 2, 2, 2, 900, 901, 9, 6,
+	2, 2, 901, 902, 9, 14, 2, 2, 902, 903, 9, 14, 2, 2, 903, 244, 3, 2, 2,
+	2, 904, 905, 9, 14, 2, 2, 905, 906, 9, 17, 2, 2, 906, 907, 9, 5, 2, 2,
+	907, 908, 9, 8, 2, 2, 908, 246, 3, 2, 2, 2, 909, 910, 9, 24, 2, 2, 910,
+	911, 9, 4, 2, 2, 911, 912, 9, 7, 2, 2, 912, 913, 9, 9, 2, 2, 913, 914,
+	9, 16, 2, 2, 914, 915, 9, 17, 2, 2, 915, 248, 3, 2, 2, 2, 916, 917, 9,
+	16, 2, 2, 917, 918, 9, 23, 2, 2, 918, 919, 9, 9, 2, 2, 919, 920, 9, 17,
+	2, 2, 920, 921, 9, 6, 2, 2, 921, 922, 9, 11, 2, 2, 922, 923, 9, 9, 2, 2,
+	923, 250, 3, 2, 2, 2, 924, 928, 5, 253, 127, 2, 925, 927, 5, 255, 128,
+	2, 926, 925, 3, 2, 2, 2, 927, 930, 3, 2, 2, 2, 928, 926, 3, 2, 2, 2, 928,
+	929, 3, 2, 2, 2, 929, 252, 3, 2, 2, 2, 930, 928, 3, 2, 2, 2, 931, 934,
+	5, 303, 152, 2, 932, 934, 5, 291, 146, 2, 933, 931, 3, 2, 2, 2, 933, 932,
+	3, 2, 2, 2, 934, 254, 3, 2, 2, 2, 935, 938, 5, 271, 136, 2, 936, 938, 5,
+	287, 144, 2, 937, 935, 3, 2, 2, 2, 937, 936, 3, 2, 2, 2, 938, 256, 3, 2,
+	2, 2, 939, 943, 7, 98, 2, 2, 940, 942, 5, 267, 134, 2, 941, 940, 3, 2,
+	2, 2, 942, 945, 3, 2, 2, 2, 943, 941, 3, 2, 2, 2, 943, 944, 3, 2, 2, 2,
+	944, 946, 3, 2, 2, 2, 945, 943, 3, 2, 2, 2, 946, 948, 7, 98, 2, 2, 947,
+	939, 3, 2, 2, 2, 948, 949, 3, 2, 2, 2, 949, 947, 3, 2, 2, 2, 949, 950,
+	3, 2, 2, 2, 950, 258, 3, 2, 2, 2, 951, 953, 5, 261, 131, 2, 952, 951, 3,
+	2, 2, 2, 953, 954, 3, 2, 2, 2, 954, 952, 3, 2, 2, 2, 954, 955, 3, 2, 2,
+	2, 955, 260, 3, 2, 2, 2, 956, 969, 5, 289, 145, 2, 957, 969, 5, 293, 147,
+	2, 958, 969, 5, 297, 149, 2, 959, 969, 5, 299, 150, 2, 960, 969, 5, 265,
+	133, 2, 961, 969, 5, 285, 143, 2, 962, 969, 5, 283, 142, 2, 963, 969, 5,
+	281, 141, 2, 964, 969, 5, 269, 135, 2, 965, 969, 5, 301, 151, 2, 966, 969,
+	9, 28, 2, 2, 967, 969, 5, 263, 132, 2, 968, 956, 3, 2, 2, 2, 968, 957,
+	3, 2, 2, 2, 968, 958, 3, 2, 2, 2, 968, 959, 3, 2, 2, 2, 968, 960, 3, 2,
+	2, 2, 968, 961, 3, 2, 2, 2, 968, 962, 3, 2, 2, 2, 968, 963, 3, 2, 2, 2,
+	968, 964, 3, 2, 2, 2, 968, 965, 3, 2, 2, 2, 968, 966, 3, 2, 2, 2, 968,
+	967, 3, 2, 2, 2, 969, 262, 3, 2, 2, 2, 970, 971, 7, 49, 2, 2, 971, 972,
+	7, 44, 2, 2, 972, 978, 3, 2, 2, 2, 973, 977, 5, 273, 137, 2, 974, 975,
+	7, 44, 2, 2, 975, 977, 5, 279, 140, 2, 976, 973, 3, 2, 2, 2, 976, 974,
+	3, 2, 2, 2, 977, 980, 3, 2, 2, 2, 978, 976, 3, 2, 2, 2, 978, 979, 3, 2,
+	2, 2, 979, 981, 3, 2, 2, 2, 980, 978, 3, 2, 2, 2, 981, 982, 7, 44, 2, 2,
+	982, 1000, 7, 49, 2, 2, 983, 984, 7, 49, 2, 2, 984, 985, 7, 49, 2, 2, 985,
+	989, 3, 2, 2, 2, 986, 988, 5, 277, 139, 2, 987, 986, 3, 2, 2, 2, 988, 991,
+	3, 2, 2, 2, 989, 987, 3, 2, 2, 2, 989, 990, 3, 2, 2, 2, 990, 993, 3, 2,
+	2, 2, 991, 989, 3, 2, 2, 2, 992, 994, 5, 285, 143, 2, 993, 992, 3, 2, 2,
+	2, 993, 994, 3, 2, 2, 2, 994, 997, 3, 2, 2, 2, 995, 998, 5, 297, 149, 2,
+	996, 998, 7, 2, 2, 3, 997, 995, 3, 2, 2, 2, 997, 996, 3, 2, 2, 2, 998,
+	1000, 3, 2, 2, 2, 999, 970, 3, 2, 2, 2, 999, 983, 3, 2, 2, 2, 1000, 264,
+	3, 2, 2, 2, 1001, 1002, 9, 29, 2, 2, 1002, 266, 3, 2, 2, 2, 1003, 1004,
+	10, 30, 2, 2, 1004, 268, 3, 2, 2, 2, 1005, 1006, 9, 31, 2, 2, 1006, 270,
+	3, 2, 2, 2, 1007, 1008, 9, 47, 2, 2, 1008, 272, 3, 2, 2, 2, 1009, 1010,
+	10, 32, 2, 2, 1010, 274, 3, 2, 2, 2, 1011, 1012, 10, 33, 2, 2, 1012, 276,
+	3, 2, 2, 2, 1013, 1014, 10, 34, 2, 2, 1014, 278, 3, 2, 2, 2, 1015, 1016,
+	10, 35, 2, 2, 1016, 280, 3, 2, 2, 2, 1017, 1018, 9, 36, 2, 2, 1018, 282,
+	3, 2, 2, 2, 1019, 1020, 9, 37, 2, 2, 1020, 284, 3, 2, 2, 2, 1021, 1022,
+	9, 38, 2, 2, 1022, 286, 3, 2, 2, 2, 1023, 1024, 9, 39, 2, 2, 1024, 288,
+	3, 2, 2, 2, 1025, 1026, 9, 40, 2, 2, 1026, 290, 3, 2, 2, 2, 1027, 1028,
+	9, 41, 2, 2, 1028, 292, 3, 2, 2, 2, 1029, 1030, 9, 42, 2, 2, 1030, 294,
+	3, 2, 2, 2, 1031, 1032, 10, 43, 2, 2, 1032, 296, 3, 2, 2, 2, 1033, 1034,
+	9, 44, 2, 2, 1034, 298, 3, 2, 2, 2, 1035, 1036, 9, 45, 2, 2, 1036, 300,
+	3, 2, 2, 2, 1037, 1038, 9, 46, 2, 2, 1038, 302, 3, 2, 2, 2, 1039, 1040,
+	9, 48, 2, 2, 1040, 304, 3, 2, 2, 2, 40, 2, 718, 720, 727, 729, 733, 753,
+	761, 768, 771, 777, 780, 784, 788, 792, 798, 805, 810, 816, 822, 824, 828,
+	833, 838, 845, 928, 933, 937, 943, 949, 954, 968, 976, 978, 989, 993, 997,
+	999, 2,
 }
 
 var lexerChannelNames = []string{
@@ -806,31 +828,32 @@ var lexerModeNames = []string{
 
 var lexerLiteralNames = []string{
 	"", "';'", "','", "'='", "'+='", "'*'", "'('", "')'", "'['", "']'", "':'",
-	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'", "'<'", "'>'",
-	"'<='", "'>='", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
-	"'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'", "'\u00AD'",
-	"'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'", "'\u2015'",
-	"'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "", "", "", "",
+	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'=~'", "'{'", "'}'",
+	"'.'", "'<>'", "'<'", "'>'", "'<='", "'>='", "'$'", "'\u27E8'", "'\u3008'",
+	"'\uFE64'", "'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'",
+	"'\u00AD'", "'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'",
+	"'\u2015'", "'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"'0'",
+	"", "", "", "", "", "", "'0'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL", "MATCH",
-	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
-	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
-	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
-	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
-	"FILTER", "EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
+	"", "", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL",
+	"MATCH", "UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE",
+	"REMOVE", "CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "SHORTESTPATH",
+	"ALLSHORTESTPATHS", "OR", "XOR", "AND", "NOT", "IN", "STARTS", "ENDS",
+	"CONTAINS", "IS", "NULL", "REDUCE", "COUNT", "ANY", "NONE", "SINGLE", "TRUE",
+	"FALSE", "EXISTS", "CASE", "ELSE", "END", "WHEN", "THEN", "StringLiteral",
+	"EscapedChar", "HexInteger", "DecimalInteger", "OctalInteger", "HexLetter",
+	"HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit", "OctDigit", "ZeroDigit",
+	"ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT", "DO", "FOR",
+	"REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP", "FILTER",
+	"EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
 	"EscapedSymbolicName", "SP", "WHITESPACE", "Comment",
 }
 
@@ -840,17 +863,18 @@ var lexerRuleNames = []string{
 	"T__17", "T__18", "T__19", "T__20", "T__21", "T__22", "T__23", "T__24",
 	"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "T__32",
 	"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
-	"T__41", "T__42", "T__43", "T__44", "UNION", "ALL", "OPTIONAL", "MATCH",
-	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
-	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
-	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
-	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
-	"FILTER", "EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
+	"T__41", "T__42", "T__43", "T__44", "T__45", "UNION", "ALL", "OPTIONAL",
+	"MATCH", "UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE",
+	"REMOVE", "CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "SHORTESTPATH",
+	"ALLSHORTESTPATHS", "OR", "XOR", "AND", "NOT", "IN", "STARTS", "ENDS",
+	"CONTAINS", "IS", "NULL", "REDUCE", "COUNT", "ANY", "NONE", "SINGLE", "TRUE",
+	"FALSE", "EXISTS", "CASE", "ELSE", "END", "WHEN", "THEN", "StringLiteral",
+	"EscapedChar", "HexInteger", "DecimalInteger", "OctalInteger", "HexLetter",
+	"HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit", "OctDigit", "ZeroDigit",
+	"ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT", "DO", "FOR",
+	"REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP", "FILTER",
+	"EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
 	"EscapedSymbolicName", "SP", "WHITESPACE", "Comment", "FF", "EscapedSymbolicName_0",
 	"RS", "ID_Continue", "Comment_1", "StringLiteral_1", "Comment_3", "Comment_2",
 	"GS", "FS", "CR", "Sc", "SPACE", "Pc", "TAB", "StringLiteral_0", "LF",
@@ -939,86 +963,90 @@ const (
 	CypherLexerT__42                 = 43
 	CypherLexerT__43                 = 44
 	CypherLexerT__44                 = 45
-	CypherLexerUNION                 = 46
-	CypherLexerALL                   = 47
-	CypherLexerOPTIONAL              = 48
-	CypherLexerMATCH                 = 49
-	CypherLexerUNWIND                = 50
-	CypherLexerAS                    = 51
-	CypherLexerMERGE                 = 52
-	CypherLexerON                    = 53
-	CypherLexerCREATE                = 54
-	CypherLexerSET                   = 55
-	CypherLexerDETACH                = 56
-	CypherLexerDELETE                = 57
-	CypherLexerREMOVE                = 58
-	CypherLexerCALL                  = 59
-	CypherLexerYIELD                 = 60
-	CypherLexerWITH                  = 61
-	CypherLexerRETURN                = 62
-	CypherLexerDISTINCT              = 63
-	CypherLexerORDER                 = 64
-	CypherLexerBY                    = 65
-	CypherLexerL_SKIP                = 66
-	CypherLexerLIMIT                 = 67
-	CypherLexerASCENDING             = 68
-	CypherLexerASC                   = 69
-	CypherLexerDESCENDING            = 70
-	CypherLexerDESC                  = 71
-	CypherLexerWHERE                 = 72
-	CypherLexerOR                    = 73
-	CypherLexerXOR                   = 74
-	CypherLexerAND                   = 75
-	CypherLexerNOT                   = 76
-	CypherLexerIN                    = 77
-	CypherLexerSTARTS                = 78
-	CypherLexerENDS                  = 79
-	CypherLexerCONTAINS              = 80
-	CypherLexerIS                    = 81
-	CypherLexerNULL                  = 82
-	CypherLexerCOUNT                 = 83
-	CypherLexerANY                   = 84
-	CypherLexerNONE                  = 85
-	CypherLexerSINGLE                = 86
-	CypherLexerTRUE                  = 87
-	CypherLexerFALSE                 = 88
-	CypherLexerEXISTS                = 89
-	CypherLexerCASE                  = 90
-	CypherLexerELSE                  = 91
-	CypherLexerEND                   = 92
-	CypherLexerWHEN                  = 93
-	CypherLexerTHEN                  = 94
-	CypherLexerStringLiteral         = 95
-	CypherLexerEscapedChar           = 96
-	CypherLexerHexInteger            = 97
-	CypherLexerDecimalInteger        = 98
-	CypherLexerOctalInteger          = 99
-	CypherLexerHexLetter             = 100
-	CypherLexerHexDigit              = 101
-	CypherLexerDigit                 = 102
-	CypherLexerNonZeroDigit          = 103
-	CypherLexerNonZeroOctDigit       = 104
-	CypherLexerOctDigit              = 105
-	CypherLexerZeroDigit             = 106
-	CypherLexerExponentDecimalReal   = 107
-	CypherLexerRegularDecimalReal    = 108
-	CypherLexerCONSTRAINT            = 109
-	CypherLexerDO                    = 110
-	CypherLexerFOR                   = 111
-	CypherLexerREQUIRE               = 112
-	CypherLexerUNIQUE                = 113
-	CypherLexerMANDATORY             = 114
-	CypherLexerSCALAR                = 115
-	CypherLexerOF                    = 116
-	CypherLexerADD                   = 117
-	CypherLexerDROP                  = 118
-	CypherLexerFILTER                = 119
-	CypherLexerEXTRACT               = 120
-	CypherLexerUnescapedSymbolicName = 121
-	CypherLexerIdentifierStart       = 122
-	CypherLexerIdentifierPart        = 123
-	CypherLexerEscapedSymbolicName   = 124
-	CypherLexerSP                    = 125
-	CypherLexerWHITESPACE            = 126
-	CypherLexerComment               = 127
+	CypherLexerT__45                 = 46
+	CypherLexerUNION                 = 47
+	CypherLexerALL                   = 48
+	CypherLexerOPTIONAL              = 49
+	CypherLexerMATCH                 = 50
+	CypherLexerUNWIND                = 51
+	CypherLexerAS                    = 52
+	CypherLexerMERGE                 = 53
+	CypherLexerON                    = 54
+	CypherLexerCREATE                = 55
+	CypherLexerSET                   = 56
+	CypherLexerDETACH                = 57
+	CypherLexerDELETE                = 58
+	CypherLexerREMOVE                = 59
+	CypherLexerCALL                  = 60
+	CypherLexerYIELD                 = 61
+	CypherLexerWITH                  = 62
+	CypherLexerRETURN                = 63
+	CypherLexerDISTINCT              = 64
+	CypherLexerORDER                 = 65
+	CypherLexerBY                    = 66
+	CypherLexerL_SKIP                = 67
+	CypherLexerLIMIT                 = 68
+	CypherLexerASCENDING             = 69
+	CypherLexerASC                   = 70
+	CypherLexerDESCENDING            = 71
+	CypherLexerDESC                  = 72
+	CypherLexerWHERE                 = 73
+	CypherLexerSHORTESTPATH          = 74
+	CypherLexerALLSHORTESTPATHS      = 75
+	CypherLexerOR                    = 76
+	CypherLexerXOR                   = 77
+	CypherLexerAND                   = 78
+	CypherLexerNOT                   = 79
+	CypherLexerIN                    = 80
+	CypherLexerSTARTS                = 81
+	CypherLexerENDS                  = 82
+	CypherLexerCONTAINS              = 83
+	CypherLexerIS                    = 84
+	CypherLexerNULL                  = 85
+	CypherLexerREDUCE                = 86
+	CypherLexerCOUNT                 = 87
+	CypherLexerANY                   = 88
+	CypherLexerNONE                  = 89
+	CypherLexerSINGLE                = 90
+	CypherLexerTRUE                  = 91
+	CypherLexerFALSE                 = 92
+	CypherLexerEXISTS                = 93
+	CypherLexerCASE                  = 94
+	CypherLexerELSE                  = 95
+	CypherLexerEND                   = 96
+	CypherLexerWHEN                  = 97
+	CypherLexerTHEN                  = 98
+	CypherLexerStringLiteral         = 99
+	CypherLexerEscapedChar           = 100
+	CypherLexerHexInteger            = 101
+	CypherLexerDecimalInteger        = 102
+	CypherLexerOctalInteger          = 103
+	CypherLexerHexLetter             = 104
+	CypherLexerHexDigit              = 105
+	CypherLexerDigit                 = 106
+	CypherLexerNonZeroDigit          = 107
+	CypherLexerNonZeroOctDigit       = 108
+	CypherLexerOctDigit              = 109
+	CypherLexerZeroDigit             = 110
+	CypherLexerExponentDecimalReal   = 111
+	CypherLexerRegularDecimalReal    = 112
+	CypherLexerCONSTRAINT            = 113
+	CypherLexerDO                    = 114
+	CypherLexerFOR                   = 115
+	CypherLexerREQUIRE               = 116
+	CypherLexerUNIQUE                = 117
+	CypherLexerMANDATORY             = 118
+	CypherLexerSCALAR                = 119
+	CypherLexerOF                    = 120
+	CypherLexerADD                   = 121
+	CypherLexerDROP                  = 122
+	CypherLexerFILTER                = 123
+	CypherLexerEXTRACT               = 124
+	CypherLexerUnescapedSymbolicName = 125
+	CypherLexerIdentifierStart       = 126
+	CypherLexerIdentifierPart        = 127
+	CypherLexerEscapedSymbolicName   = 128
+	CypherLexerSP                    = 129
+	CypherLexerWHITESPACE            = 130
+	CypherLexerComment               = 131
 )
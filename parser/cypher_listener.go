@@ -119,6 +119,9 @@ type CypherListener interface {
 	// EnterOC_AnonymousPatternPart is called when entering the oC_AnonymousPatternPart production.
 	EnterOC_AnonymousPatternPart(c *OC_AnonymousPatternPartContext)
 
+	// EnterOC_ShortestPathPattern is called when entering the oC_ShortestPathPattern production.
+	EnterOC_ShortestPathPattern(c *OC_ShortestPathPatternContext)
+
 	// EnterOC_PatternElement is called when entering the oC_PatternElement production.
 	EnterOC_PatternElement(c *OC_PatternElementContext)
 
@@ -203,6 +206,15 @@ type CypherListener interface {
 	// EnterOC_Atom is called when entering the oC_Atom production.
 	EnterOC_Atom(c *OC_AtomContext)
 
+	// EnterOC_Reduce is called when entering the oC_Reduce production.
+	EnterOC_Reduce(c *OC_ReduceContext)
+
+	// EnterOC_MapProjection is called when entering the oC_MapProjection production.
+	EnterOC_MapProjection(c *OC_MapProjectionContext)
+
+	// EnterOC_MapProjectionItem is called when entering the oC_MapProjectionItem production.
+	EnterOC_MapProjectionItem(c *OC_MapProjectionItemContext)
+
 	// EnterOC_Literal is called when entering the oC_Literal production.
 	EnterOC_Literal(c *OC_LiteralContext)
 
@@ -416,6 +428,9 @@ type CypherListener interface {
 	// ExitOC_AnonymousPatternPart is called when exiting the oC_AnonymousPatternPart production.
 	ExitOC_AnonymousPatternPart(c *OC_AnonymousPatternPartContext)
 
+	// ExitOC_ShortestPathPattern is called when exiting the oC_ShortestPathPattern production.
+	ExitOC_ShortestPathPattern(c *OC_ShortestPathPatternContext)
+
 	// ExitOC_PatternElement is called when exiting the oC_PatternElement production.
 	ExitOC_PatternElement(c *OC_PatternElementContext)
 
@@ -500,6 +515,15 @@ type CypherListener interface {
 	// ExitOC_Atom is called when exiting the oC_Atom production.
 	ExitOC_Atom(c *OC_AtomContext)
 
+	// ExitOC_Reduce is called when exiting the oC_Reduce production.
+	ExitOC_Reduce(c *OC_ReduceContext)
+
+	// ExitOC_MapProjection is called when exiting the oC_MapProjection production.
+	ExitOC_MapProjection(c *OC_MapProjectionContext)
+
+	// ExitOC_MapProjectionItem is called when exiting the oC_MapProjectionItem production.
+	ExitOC_MapProjectionItem(c *OC_MapProjectionItemContext)
+
 	// ExitOC_Literal is called when exiting the oC_Literal production.
 	ExitOC_Literal(c *OC_LiteralContext)
 
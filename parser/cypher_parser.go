@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 129, 1549,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 133, 1663,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -35,791 +35,855 @@ var parserATN = []uint16{
 	81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9, 84, 4, 85, 9, 85, 4, 86, 9, 86,
 	4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89, 4, 90, 9, 90, 4, 91, 9, 91, 4,
 	92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96, 4, 97,
-	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 3, 2, 5, 2, 202, 10,
-	2, 3, 2, 3, 2, 5, 2, 206, 10, 2, 3, 2, 5, 2, 209, 10, 2, 3, 2, 5, 2, 212,
-	10, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 5, 4, 220, 10, 4, 3, 5, 3, 5,
-	5, 5, 224, 10, 5, 3, 5, 7, 5, 227, 10, 5, 12, 5, 14, 5, 230, 11, 5, 3,
-	6, 3, 6, 3, 6, 3, 6, 5, 6, 236, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 241, 10,
-	6, 3, 6, 5, 6, 244, 10, 6, 3, 7, 3, 7, 5, 7, 248, 10, 7, 3, 8, 3, 8, 5,
-	8, 252, 10, 8, 7, 8, 254, 10, 8, 12, 8, 14, 8, 257, 11, 8, 3, 8, 3, 8,
-	3, 8, 5, 8, 262, 10, 8, 7, 8, 264, 10, 8, 12, 8, 14, 8, 267, 11, 8, 3,
-	8, 3, 8, 5, 8, 271, 10, 8, 3, 8, 7, 8, 274, 10, 8, 12, 8, 14, 8, 277, 11,
-	8, 3, 8, 5, 8, 280, 10, 8, 3, 8, 5, 8, 283, 10, 8, 5, 8, 285, 10, 8, 3,
-	9, 3, 9, 5, 9, 289, 10, 9, 7, 9, 291, 10, 9, 12, 9, 14, 9, 294, 11, 9,
-	3, 9, 3, 9, 5, 9, 298, 10, 9, 7, 9, 300, 10, 9, 12, 9, 14, 9, 303, 11,
-	9, 3, 9, 3, 9, 5, 9, 307, 10, 9, 6, 9, 309, 10, 9, 13, 9, 14, 9, 310, 3,
-	9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 320, 10, 10, 3, 11,
-	3, 11, 3, 11, 5, 11, 325, 10, 11, 3, 12, 3, 12, 5, 12, 329, 10, 12, 3,
-	12, 3, 12, 5, 12, 333, 10, 12, 3, 12, 3, 12, 5, 12, 337, 10, 12, 3, 12,
-	5, 12, 340, 10, 12, 3, 13, 3, 13, 5, 13, 344, 10, 13, 3, 13, 3, 13, 3,
-	13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 5, 14, 354, 10, 14, 3, 14, 3, 14,
-	3, 14, 7, 14, 359, 10, 14, 12, 14, 14, 14, 362, 11, 14, 3, 15, 3, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 374, 10, 15,
-	3, 16, 3, 16, 5, 16, 378, 10, 16, 3, 16, 3, 16, 3, 17, 3, 17, 5, 17, 384,
-	10, 17, 3, 17, 3, 17, 5, 17, 388, 10, 17, 3, 17, 3, 17, 5, 17, 392, 10,
-	17, 3, 17, 7, 17, 395, 10, 17, 12, 17, 14, 17, 398, 11, 17, 3, 18, 3, 18,
-	5, 18, 402, 10, 18, 3, 18, 3, 18, 5, 18, 406, 10, 18, 3, 18, 3, 18, 3,
-	18, 3, 18, 5, 18, 412, 10, 18, 3, 18, 3, 18, 5, 18, 416, 10, 18, 3, 18,
-	3, 18, 3, 18, 3, 18, 5, 18, 422, 10, 18, 3, 18, 3, 18, 5, 18, 426, 10,
-	18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 432, 10, 18, 3, 18, 3, 18, 5, 18,
-	436, 10, 18, 3, 19, 3, 19, 5, 19, 440, 10, 19, 3, 19, 3, 19, 5, 19, 444,
-	10, 19, 3, 19, 3, 19, 5, 19, 448, 10, 19, 3, 19, 3, 19, 5, 19, 452, 10,
-	19, 3, 19, 7, 19, 455, 10, 19, 12, 19, 14, 19, 458, 11, 19, 3, 20, 3, 20,
-	3, 20, 3, 20, 5, 20, 464, 10, 20, 3, 20, 3, 20, 5, 20, 468, 10, 20, 3,
-	20, 7, 20, 471, 10, 20, 12, 20, 14, 20, 474, 11, 20, 3, 21, 3, 21, 3, 21,
-	3, 21, 5, 21, 480, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 486, 10,
-	22, 3, 22, 3, 22, 3, 22, 5, 22, 491, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23,
-	5, 23, 497, 10, 23, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 503, 10, 23, 3,
-	24, 3, 24, 3, 24, 5, 24, 508, 10, 24, 3, 24, 3, 24, 5, 24, 512, 10, 24,
-	3, 24, 7, 24, 515, 10, 24, 12, 24, 14, 24, 518, 11, 24, 5, 24, 520, 10,
-	24, 3, 24, 5, 24, 523, 10, 24, 3, 24, 5, 24, 526, 10, 24, 3, 25, 3, 25,
-	3, 25, 3, 25, 3, 25, 5, 25, 533, 10, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3,
-	26, 5, 26, 540, 10, 26, 3, 26, 5, 26, 543, 10, 26, 3, 27, 3, 27, 3, 27,
-	3, 28, 5, 28, 549, 10, 28, 3, 28, 5, 28, 552, 10, 28, 3, 28, 3, 28, 3,
-	28, 3, 28, 5, 28, 558, 10, 28, 3, 28, 3, 28, 5, 28, 562, 10, 28, 3, 28,
-	3, 28, 5, 28, 566, 10, 28, 3, 29, 3, 29, 5, 29, 570, 10, 29, 3, 29, 3,
-	29, 5, 29, 574, 10, 29, 3, 29, 7, 29, 577, 10, 29, 12, 29, 14, 29, 580,
-	11, 29, 3, 29, 3, 29, 5, 29, 584, 10, 29, 3, 29, 3, 29, 5, 29, 588, 10,
-	29, 3, 29, 7, 29, 591, 10, 29, 12, 29, 14, 29, 594, 11, 29, 5, 29, 596,
-	10, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 5, 30, 605, 10,
-	30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 5, 31, 614, 10, 31,
-	3, 31, 7, 31, 617, 10, 31, 12, 31, 14, 31, 620, 11, 31, 3, 32, 3, 32, 3,
-	32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 5, 34, 632, 10, 34,
-	3, 34, 5, 34, 635, 10, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 5,
-	36, 643, 10, 36, 3, 36, 3, 36, 5, 36, 647, 10, 36, 3, 36, 7, 36, 650, 10,
-	36, 12, 36, 14, 36, 653, 11, 36, 3, 37, 3, 37, 5, 37, 657, 10, 37, 3, 37,
-	3, 37, 5, 37, 661, 10, 37, 3, 37, 3, 37, 3, 37, 5, 37, 666, 10, 37, 3,
-	38, 3, 38, 3, 39, 3, 39, 5, 39, 672, 10, 39, 3, 39, 7, 39, 675, 10, 39,
-	12, 39, 14, 39, 678, 11, 39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 684, 10,
-	39, 3, 40, 3, 40, 5, 40, 688, 10, 40, 3, 40, 3, 40, 5, 40, 692, 10, 40,
-	5, 40, 694, 10, 40, 3, 40, 3, 40, 5, 40, 698, 10, 40, 5, 40, 700, 10, 40,
-	3, 40, 3, 40, 5, 40, 704, 10, 40, 5, 40, 706, 10, 40, 3, 40, 3, 40, 3,
-	41, 3, 41, 5, 41, 712, 10, 41, 3, 41, 3, 41, 3, 42, 3, 42, 5, 42, 718,
-	10, 42, 3, 42, 3, 42, 5, 42, 722, 10, 42, 3, 42, 5, 42, 725, 10, 42, 3,
-	42, 5, 42, 728, 10, 42, 3, 42, 3, 42, 5, 42, 732, 10, 42, 3, 42, 3, 42,
-	3, 42, 3, 42, 5, 42, 738, 10, 42, 3, 42, 3, 42, 5, 42, 742, 10, 42, 3,
-	42, 5, 42, 745, 10, 42, 3, 42, 5, 42, 748, 10, 42, 3, 42, 3, 42, 3, 42,
-	3, 42, 5, 42, 754, 10, 42, 3, 42, 5, 42, 757, 10, 42, 3, 42, 5, 42, 760,
-	10, 42, 3, 42, 3, 42, 5, 42, 764, 10, 42, 3, 42, 3, 42, 3, 42, 3, 42, 5,
-	42, 770, 10, 42, 3, 42, 5, 42, 773, 10, 42, 3, 42, 5, 42, 776, 10, 42,
-	3, 42, 3, 42, 5, 42, 780, 10, 42, 3, 43, 3, 43, 5, 43, 784, 10, 43, 3,
-	43, 3, 43, 5, 43, 788, 10, 43, 5, 43, 790, 10, 43, 3, 43, 3, 43, 5, 43,
-	794, 10, 43, 5, 43, 796, 10, 43, 3, 43, 5, 43, 799, 10, 43, 3, 43, 3, 43,
-	5, 43, 803, 10, 43, 5, 43, 805, 10, 43, 3, 43, 3, 43, 3, 44, 3, 44, 5,
-	44, 811, 10, 44, 3, 45, 3, 45, 5, 45, 815, 10, 45, 3, 45, 3, 45, 5, 45,
-	819, 10, 45, 3, 45, 3, 45, 5, 45, 823, 10, 45, 3, 45, 5, 45, 826, 10, 45,
-	3, 45, 7, 45, 829, 10, 45, 12, 45, 14, 45, 832, 11, 45, 3, 46, 3, 46, 5,
-	46, 836, 10, 46, 3, 46, 7, 46, 839, 10, 46, 12, 46, 14, 46, 842, 11, 46,
-	3, 47, 3, 47, 5, 47, 846, 10, 47, 3, 47, 3, 47, 3, 48, 3, 48, 5, 48, 852,
-	10, 48, 3, 48, 3, 48, 5, 48, 856, 10, 48, 5, 48, 858, 10, 48, 3, 48, 3,
-	48, 5, 48, 862, 10, 48, 3, 48, 3, 48, 5, 48, 866, 10, 48, 5, 48, 868, 10,
-	48, 5, 48, 870, 10, 48, 3, 49, 3, 49, 3, 50, 3, 50, 3, 51, 3, 51, 3, 52,
-	3, 52, 3, 52, 3, 52, 3, 52, 7, 52, 883, 10, 52, 12, 52, 14, 52, 886, 11,
-	52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 7, 53, 893, 10, 53, 12, 53, 14,
-	53, 896, 11, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 7, 54, 903, 10, 54,
-	12, 54, 14, 54, 906, 11, 54, 3, 55, 3, 55, 5, 55, 910, 10, 55, 7, 55, 912,
-	10, 55, 12, 55, 14, 55, 915, 11, 55, 3, 55, 3, 55, 3, 56, 3, 56, 5, 56,
-	921, 10, 56, 3, 56, 7, 56, 924, 10, 56, 12, 56, 14, 56, 927, 11, 56, 3,
-	57, 3, 57, 5, 57, 931, 10, 57, 3, 57, 3, 57, 5, 57, 935, 10, 57, 3, 57,
-	3, 57, 5, 57, 939, 10, 57, 3, 57, 3, 57, 5, 57, 943, 10, 57, 3, 57, 7,
-	57, 946, 10, 57, 12, 57, 14, 57, 949, 11, 57, 3, 58, 3, 58, 5, 58, 953,
-	10, 58, 3, 58, 3, 58, 5, 58, 957, 10, 58, 3, 58, 3, 58, 5, 58, 961, 10,
-	58, 3, 58, 3, 58, 5, 58, 965, 10, 58, 3, 58, 3, 58, 5, 58, 969, 10, 58,
-	3, 58, 3, 58, 5, 58, 973, 10, 58, 3, 58, 7, 58, 976, 10, 58, 12, 58, 14,
-	58, 979, 11, 58, 3, 59, 3, 59, 5, 59, 983, 10, 59, 3, 59, 3, 59, 5, 59,
-	987, 10, 59, 3, 59, 7, 59, 990, 10, 59, 12, 59, 14, 59, 993, 11, 59, 3,
-	60, 3, 60, 5, 60, 997, 10, 60, 7, 60, 999, 10, 60, 12, 60, 14, 60, 1002,
-	11, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 7, 61, 1010, 10, 61,
-	12, 61, 14, 61, 1013, 11, 61, 3, 62, 3, 62, 3, 62, 5, 62, 1018, 10, 62,
-	3, 62, 3, 62, 5, 62, 1022, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5,
-	62, 1029, 10, 62, 3, 62, 3, 62, 5, 62, 1033, 10, 62, 3, 62, 3, 62, 5, 62,
-	1037, 10, 62, 3, 62, 5, 62, 1040, 10, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3,
-	63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 5, 63, 1052, 10, 63, 3, 63, 5, 63,
-	1055, 10, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 3, 64, 3, 64, 5, 64, 1069, 10, 64, 3, 65, 3, 65, 5, 65, 1073,
-	10, 65, 3, 65, 7, 65, 1076, 10, 65, 12, 65, 14, 65, 1079, 11, 65, 3, 65,
-	5, 65, 1082, 10, 65, 3, 65, 5, 65, 1085, 10, 65, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 5, 66, 1092, 10, 66, 3, 66, 3, 66, 5, 66, 1096, 10, 66, 3, 66,
-	3, 66, 5, 66, 1100, 10, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1107,
-	10, 66, 3, 66, 3, 66, 5, 66, 1111, 10, 66, 3, 66, 3, 66, 5, 66, 1115, 10,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1121, 10, 66, 3, 66, 3, 66, 5, 66,
-	1125, 10, 66, 3, 66, 3, 66, 5, 66, 1129, 10, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 5, 66, 1135, 10, 66, 3, 66, 3, 66, 5, 66, 1139, 10, 66, 3, 66, 3, 66,
-	5, 66, 1143, 10, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1149, 10, 66, 3,
-	66, 3, 66, 5, 66, 1153, 10, 66, 3, 66, 3, 66, 5, 66, 1157, 10, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1165, 10, 66, 3, 67, 3, 67, 3,
-	67, 3, 67, 3, 67, 3, 67, 5, 67, 1173, 10, 67, 3, 68, 3, 68, 3, 69, 3, 69,
-	5, 69, 1179, 10, 69, 3, 69, 3, 69, 5, 69, 1183, 10, 69, 3, 69, 3, 69, 5,
-	69, 1187, 10, 69, 3, 69, 3, 69, 5, 69, 1191, 10, 69, 7, 69, 1193, 10, 69,
-	12, 69, 14, 69, 1196, 11, 69, 5, 69, 1198, 10, 69, 3, 69, 3, 69, 3, 70,
-	3, 70, 5, 70, 1204, 10, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1209, 10, 70, 3,
-	70, 3, 70, 3, 70, 5, 70, 1214, 10, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1219,
-	10, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1224, 10, 70, 3, 70, 3, 70, 3, 70,
-	5, 70, 1229, 10, 70, 3, 70, 5, 70, 1232, 10, 70, 3, 71, 3, 71, 5, 71, 1236,
-	10, 71, 3, 71, 3, 71, 5, 71, 1240, 10, 71, 3, 71, 3, 71, 3, 72, 3, 72,
-	5, 72, 1246, 10, 72, 3, 72, 6, 72, 1249, 10, 72, 13, 72, 14, 72, 1250,
-	3, 73, 3, 73, 5, 73, 1255, 10, 73, 3, 73, 5, 73, 1258, 10, 73, 3, 74, 3,
-	74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 5, 75, 1268, 10, 75, 3, 75,
-	3, 75, 5, 75, 1272, 10, 75, 3, 75, 3, 75, 5, 75, 1276, 10, 75, 5, 75, 1278,
-	10, 75, 3, 75, 3, 75, 5, 75, 1282, 10, 75, 3, 75, 3, 75, 5, 75, 1286, 10,
-	75, 3, 75, 3, 75, 5, 75, 1290, 10, 75, 7, 75, 1292, 10, 75, 12, 75, 14,
-	75, 1295, 11, 75, 5, 75, 1297, 10, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76,
-	3, 76, 5, 76, 1305, 10, 76, 3, 77, 3, 77, 5, 77, 1309, 10, 77, 3, 77, 3,
-	77, 5, 77, 1313, 10, 77, 3, 77, 3, 77, 5, 77, 1317, 10, 77, 3, 77, 3, 77,
-	5, 77, 1321, 10, 77, 3, 77, 3, 77, 5, 77, 1325, 10, 77, 7, 77, 1327, 10,
-	77, 12, 77, 14, 77, 1330, 11, 77, 5, 77, 1332, 10, 77, 3, 77, 3, 77, 3,
-	78, 3, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 7, 81,
-	1346, 10, 81, 12, 81, 14, 81, 1349, 11, 81, 3, 82, 3, 82, 5, 82, 1353,
-	10, 82, 3, 82, 3, 82, 5, 82, 1357, 10, 82, 3, 82, 3, 82, 5, 82, 1361, 10,
-	82, 3, 82, 5, 82, 1364, 10, 82, 3, 82, 5, 82, 1367, 10, 82, 3, 82, 3, 82,
-	3, 83, 3, 83, 5, 83, 1373, 10, 83, 3, 83, 3, 83, 5, 83, 1377, 10, 83, 3,
-	83, 3, 83, 5, 83, 1381, 10, 83, 5, 83, 1383, 10, 83, 3, 83, 3, 83, 5, 83,
-	1387, 10, 83, 3, 83, 3, 83, 5, 83, 1391, 10, 83, 3, 83, 3, 83, 5, 83, 1395,
-	10, 83, 5, 83, 1397, 10, 83, 3, 83, 3, 83, 5, 83, 1401, 10, 83, 3, 83,
-	3, 83, 5, 83, 1405, 10, 83, 3, 83, 3, 83, 3, 84, 3, 84, 5, 84, 1411, 10,
-	84, 3, 84, 3, 84, 3, 85, 3, 85, 5, 85, 1417, 10, 85, 3, 85, 6, 85, 1420,
-	10, 85, 13, 85, 14, 85, 1421, 3, 85, 3, 85, 5, 85, 1426, 10, 85, 3, 85,
-	3, 85, 5, 85, 1430, 10, 85, 3, 85, 6, 85, 1433, 10, 85, 13, 85, 14, 85,
-	1434, 5, 85, 1437, 10, 85, 3, 85, 5, 85, 1440, 10, 85, 3, 85, 3, 85, 5,
-	85, 1444, 10, 85, 3, 85, 5, 85, 1447, 10, 85, 3, 85, 5, 85, 1450, 10, 85,
-	3, 85, 3, 85, 3, 86, 3, 86, 5, 86, 1456, 10, 86, 3, 86, 3, 86, 5, 86, 1460,
-	10, 86, 3, 86, 3, 86, 5, 86, 1464, 10, 86, 3, 86, 3, 86, 3, 87, 3, 87,
-	3, 88, 3, 88, 5, 88, 1472, 10, 88, 3, 89, 3, 89, 5, 89, 1476, 10, 89, 3,
-	89, 3, 89, 5, 89, 1480, 10, 89, 3, 89, 3, 89, 5, 89, 1484, 10, 89, 3, 89,
-	3, 89, 5, 89, 1488, 10, 89, 3, 89, 3, 89, 5, 89, 1492, 10, 89, 3, 89, 3,
-	89, 5, 89, 1496, 10, 89, 3, 89, 3, 89, 5, 89, 1500, 10, 89, 3, 89, 3, 89,
-	5, 89, 1504, 10, 89, 7, 89, 1506, 10, 89, 12, 89, 14, 89, 1509, 11, 89,
-	5, 89, 1511, 10, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 5, 90, 1518, 10,
-	90, 3, 91, 3, 91, 5, 91, 1522, 10, 91, 3, 91, 6, 91, 1525, 10, 91, 13,
-	91, 14, 91, 1526, 3, 92, 3, 92, 3, 93, 3, 93, 3, 94, 3, 94, 3, 95, 3, 95,
-	5, 95, 1537, 10, 95, 3, 96, 3, 96, 3, 97, 3, 97, 3, 98, 3, 98, 3, 99, 3,
-	99, 3, 100, 3, 100, 3, 100, 2, 2, 101, 2, 4, 6, 8, 10, 12, 14, 16, 18,
-	20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54,
-	56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90,
-	92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120,
-	122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150,
-	152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180,
-	182, 184, 186, 188, 190, 192, 194, 196, 198, 2, 12, 3, 2, 70, 73, 3, 2,
-	15, 16, 3, 2, 89, 90, 3, 2, 99, 101, 3, 2, 109, 110, 6, 2, 48, 60, 63,
-	84, 89, 96, 111, 120, 6, 2, 85, 88, 102, 102, 121, 123, 126, 126, 4, 2,
-	21, 21, 29, 32, 4, 2, 22, 22, 33, 36, 4, 2, 16, 16, 37, 47, 2, 1766, 2,
-	201, 3, 2, 2, 2, 4, 215, 3, 2, 2, 2, 6, 219, 3, 2, 2, 2, 8, 221, 3, 2,
-	2, 2, 10, 243, 3, 2, 2, 2, 12, 247, 3, 2, 2, 2, 14, 284, 3, 2, 2, 2, 16,
-	308, 3, 2, 2, 2, 18, 319, 3, 2, 2, 2, 20, 324, 3, 2, 2, 2, 22, 328, 3,
-	2, 2, 2, 24, 341, 3, 2, 2, 2, 26, 351, 3, 2, 2, 2, 28, 373, 3, 2, 2, 2,
-	30, 375, 3, 2, 2, 2, 32, 381, 3, 2, 2, 2, 34, 435, 3, 2, 2, 2, 36, 439,
-	3, 2, 2, 2, 38, 459, 3, 2, 2, 2, 40, 479, 3, 2, 2, 2, 42, 481, 3, 2, 2,
-	2, 44, 492, 3, 2, 2, 2, 46, 519, 3, 2, 2, 2, 48, 532, 3, 2, 2, 2, 50, 536,
-	3, 2, 2, 2, 52, 544, 3, 2, 2, 2, 54, 551, 3, 2, 2, 2, 56, 595, 3, 2, 2,
-	2, 58, 604, 3, 2, 2, 2, 60, 606, 3, 2, 2, 2, 62, 621, 3, 2, 2, 2, 64, 625,
-	3, 2, 2, 2, 66, 629, 3, 2, 2, 2, 68, 636, 3, 2, 2, 2, 70, 640, 3, 2, 2,
-	2, 72, 665, 3, 2, 2, 2, 74, 667, 3, 2, 2, 2, 76, 683, 3, 2, 2, 2, 78, 685,
-	3, 2, 2, 2, 80, 709, 3, 2, 2, 2, 82, 779, 3, 2, 2, 2, 84, 781, 3, 2, 2,
-	2, 86, 810, 3, 2, 2, 2, 88, 812, 3, 2, 2, 2, 90, 833, 3, 2, 2, 2, 92, 843,
-	3, 2, 2, 2, 94, 849, 3, 2, 2, 2, 96, 871, 3, 2, 2, 2, 98, 873, 3, 2, 2,
-	2, 100, 875, 3, 2, 2, 2, 102, 877, 3, 2, 2, 2, 104, 887, 3, 2, 2, 2, 106,
-	897, 3, 2, 2, 2, 108, 913, 3, 2, 2, 2, 110, 918, 3, 2, 2, 2, 112, 928,
-	3, 2, 2, 2, 114, 950, 3, 2, 2, 2, 116, 980, 3, 2, 2, 2, 118, 1000, 3, 2,
-	2, 2, 120, 1005, 3, 2, 2, 2, 122, 1039, 3, 2, 2, 2, 124, 1051, 3, 2, 2,
-	2, 126, 1068, 3, 2, 2, 2, 128, 1070, 3, 2, 2, 2, 130, 1164, 3, 2, 2, 2,
-	132, 1172, 3, 2, 2, 2, 134, 1174, 3, 2, 2, 2, 136, 1176, 3, 2, 2, 2, 138,
-	1231, 3, 2, 2, 2, 140, 1233, 3, 2, 2, 2, 142, 1243, 3, 2, 2, 2, 144, 1252,
-	3, 2, 2, 2, 146, 1259, 3, 2, 2, 2, 148, 1265, 3, 2, 2, 2, 150, 1304, 3,
-	2, 2, 2, 152, 1306, 3, 2, 2, 2, 154, 1335, 3, 2, 2, 2, 156, 1337, 3, 2,
-	2, 2, 158, 1339, 3, 2, 2, 2, 160, 1347, 3, 2, 2, 2, 162, 1350, 3, 2, 2,
-	2, 164, 1370, 3, 2, 2, 2, 166, 1408, 3, 2, 2, 2, 168, 1436, 3, 2, 2, 2,
-	170, 1453, 3, 2, 2, 2, 172, 1467, 3, 2, 2, 2, 174, 1471, 3, 2, 2, 2, 176,
-	1473, 3, 2, 2, 2, 178, 1514, 3, 2, 2, 2, 180, 1519, 3, 2, 2, 2, 182, 1528,
-	3, 2, 2, 2, 184, 1530, 3, 2, 2, 2, 186, 1532, 3, 2, 2, 2, 188, 1536, 3,
-	2, 2, 2, 190, 1538, 3, 2, 2, 2, 192, 1540, 3, 2, 2, 2, 194, 1542, 3, 2,
-	2, 2, 196, 1544, 3, 2, 2, 2, 198, 1546, 3, 2, 2, 2, 200, 202, 7, 127, 2,
-	2, 201, 200, 3, 2, 2, 2, 201, 202, 3, 2, 2, 2, 202, 203, 3, 2, 2, 2, 203,
-	208, 5, 4, 3, 2, 204, 206, 7, 127, 2, 2, 205, 204, 3, 2, 2, 2, 205, 206,
-	3, 2, 2, 2, 206, 207, 3, 2, 2, 2, 207, 209, 7, 3, 2, 2, 208, 205, 3, 2,
-	2, 2, 208, 209, 3, 2, 2, 2, 209, 211, 3, 2, 2, 2, 210, 212, 7, 127, 2,
-	2, 211, 210, 3, 2, 2, 2, 211, 212, 3, 2, 2, 2, 212, 213, 3, 2, 2, 2, 213,
-	214, 7, 2, 2, 3, 214, 3, 3, 2, 2, 2, 215, 216, 5, 6, 4, 2, 216, 5, 3, 2,
-	2, 2, 217, 220, 5, 8, 5, 2, 218, 220, 5, 44, 23, 2, 219, 217, 3, 2, 2,
-	2, 219, 218, 3, 2, 2, 2, 220, 7, 3, 2, 2, 2, 221, 228, 5, 12, 7, 2, 222,
-	224, 7, 127, 2, 2, 223, 222, 3, 2, 2, 2, 223, 224, 3, 2, 2, 2, 224, 225,
-	3, 2, 2, 2, 225, 227, 5, 10, 6, 2, 226, 223, 3, 2, 2, 2, 227, 230, 3, 2,
-	2, 2, 228, 226, 3, 2, 2, 2, 228, 229, 3, 2, 2, 2, 229, 9, 3, 2, 2, 2, 230,
-	228, 3, 2, 2, 2, 231, 232, 7, 48, 2, 2, 232, 233, 7, 127, 2, 2, 233, 235,
-	7, 49, 2, 2, 234, 236, 7, 127, 2, 2, 235, 234, 3, 2, 2, 2, 235, 236, 3,
-	2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 244, 5, 12, 7, 2, 238, 240, 7, 48,
-	2, 2, 239, 241, 7, 127, 2, 2, 240, 239, 3, 2, 2, 2, 240, 241, 3, 2, 2,
-	2, 241, 242, 3, 2, 2, 2, 242, 244, 5, 12, 7, 2, 243, 231, 3, 2, 2, 2, 243,
-	238, 3, 2, 2, 2, 244, 11, 3, 2, 2, 2, 245, 248, 5, 14, 8, 2, 246, 248,
-	5, 16, 9, 2, 247, 245, 3, 2, 2, 2, 247, 246, 3, 2, 2, 2, 248, 13, 3, 2,
-	2, 2, 249, 251, 5, 20, 11, 2, 250, 252, 7, 127, 2, 2, 251, 250, 3, 2, 2,
-	2, 251, 252, 3, 2, 2, 2, 252, 254, 3, 2, 2, 2, 253, 249, 3, 2, 2, 2, 254,
-	257, 3, 2, 2, 2, 255, 253, 3, 2, 2, 2, 255, 256, 3, 2, 2, 2, 256, 258,
-	3, 2, 2, 2, 257, 255, 3, 2, 2, 2, 258, 285, 5, 52, 27, 2, 259, 261, 5,
-	20, 11, 2, 260, 262, 7, 127, 2, 2, 261, 260, 3, 2, 2, 2, 261, 262, 3, 2,
-	2, 2, 262, 264, 3, 2, 2, 2, 263, 259, 3, 2, 2, 2, 264, 267, 3, 2, 2, 2,
-	265, 263, 3, 2, 2, 2, 265, 266, 3, 2, 2, 2, 266, 268, 3, 2, 2, 2, 267,
-	265, 3, 2, 2, 2, 268, 275, 5, 18, 10, 2, 269, 271, 7, 127, 2, 2, 270, 269,
-	3, 2, 2, 2, 270, 271, 3, 2, 2, 2, 271, 272, 3, 2, 2, 2, 272, 274, 5, 18,
-	10, 2, 273, 270, 3, 2, 2, 2, 274, 277, 3, 2, 2, 2, 275, 273, 3, 2, 2, 2,
-	275, 276, 3, 2, 2, 2, 276, 282, 3, 2, 2, 2, 277, 275, 3, 2, 2, 2, 278,
-	280, 7, 127, 2, 2, 279, 278, 3, 2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 281,
-	3, 2, 2, 2, 281, 283, 5, 52, 27, 2, 282, 279, 3, 2, 2, 2, 282, 283, 3,
-	2, 2, 2, 283, 285, 3, 2, 2, 2, 284, 255, 3, 2, 2, 2, 284, 265, 3, 2, 2,
-	2, 285, 15, 3, 2, 2, 2, 286, 288, 5, 20, 11, 2, 287, 289, 7, 127, 2, 2,
-	288, 287, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 291, 3, 2, 2, 2, 290,
-	286, 3, 2, 2, 2, 291, 294, 3, 2, 2, 2, 292, 290, 3, 2, 2, 2, 292, 293,
-	3, 2, 2, 2, 293, 301, 3, 2, 2, 2, 294, 292, 3, 2, 2, 2, 295, 297, 5, 18,
-	10, 2, 296, 298, 7, 127, 2, 2, 297, 296, 3, 2, 2, 2, 297, 298, 3, 2, 2,
-	2, 298, 300, 3, 2, 2, 2, 299, 295, 3, 2, 2, 2, 300, 303, 3, 2, 2, 2, 301,
-	299, 3, 2, 2, 2, 301, 302, 3, 2, 2, 2, 302, 304, 3, 2, 2, 2, 303, 301,
-	3, 2, 2, 2, 304, 306, 5, 50, 26, 2, 305, 307, 7, 127, 2, 2, 306, 305, 3,
-	2, 2, 2, 306, 307, 3, 2, 2, 2, 307, 309, 3, 2, 2, 2, 308, 292, 3, 2, 2,
-	2, 309, 310, 3, 2, 2, 2, 310, 308, 3, 2, 2, 2, 310, 311, 3, 2, 2, 2, 311,
-	312, 3, 2, 2, 2, 312, 313, 5, 14, 8, 2, 313, 17, 3, 2, 2, 2, 314, 320,
-	5, 30, 16, 2, 315, 320, 5, 26, 14, 2, 316, 320, 5, 36, 19, 2, 317, 320,
-	5, 32, 17, 2, 318, 320, 5, 38, 20, 2, 319, 314, 3, 2, 2, 2, 319, 315, 3,
-	2, 2, 2, 319, 316, 3, 2, 2, 2, 319, 317, 3, 2, 2, 2, 319, 318, 3, 2, 2,
-	2, 320, 19, 3, 2, 2, 2, 321, 325, 5, 22, 12, 2, 322, 325, 5, 24, 13, 2,
-	323, 325, 5, 42, 22, 2, 324, 321, 3, 2, 2, 2, 324, 322, 3, 2, 2, 2, 324,
-	323, 3, 2, 2, 2, 325, 21, 3, 2, 2, 2, 326, 327, 7, 50, 2, 2, 327, 329,
-	7, 127, 2, 2, 328, 326, 3, 2, 2, 2, 328, 329, 3, 2, 2, 2, 329, 330, 3,
-	2, 2, 2, 330, 332, 7, 51, 2, 2, 331, 333, 7, 127, 2, 2, 332, 331, 3, 2,
-	2, 2, 332, 333, 3, 2, 2, 2, 333, 334, 3, 2, 2, 2, 334, 339, 5, 70, 36,
-	2, 335, 337, 7, 127, 2, 2, 336, 335, 3, 2, 2, 2, 336, 337, 3, 2, 2, 2,
-	337, 338, 3, 2, 2, 2, 338, 340, 5, 68, 35, 2, 339, 336, 3, 2, 2, 2, 339,
-	340, 3, 2, 2, 2, 340, 23, 3, 2, 2, 2, 341, 343, 7, 52, 2, 2, 342, 344,
-	7, 127, 2, 2, 343, 342, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2, 344, 345, 3,
-	2, 2, 2, 345, 346, 5, 100, 51, 2, 346, 347, 7, 127, 2, 2, 347, 348, 7,
-	53, 2, 2, 348, 349, 7, 127, 2, 2, 349, 350, 5, 172, 87, 2, 350, 25, 3,
-	2, 2, 2, 351, 353, 7, 54, 2, 2, 352, 354, 7, 127, 2, 2, 353, 352, 3, 2,
-	2, 2, 353, 354, 3, 2, 2, 2, 354, 355, 3, 2, 2, 2, 355, 360, 5, 72, 37,
-	2, 356, 357, 7, 127, 2, 2, 357, 359, 5, 28, 15, 2, 358, 356, 3, 2, 2, 2,
-	359, 362, 3, 2, 2, 2, 360, 358, 3, 2, 2, 2, 360, 361, 3, 2, 2, 2, 361,
-	27, 3, 2, 2, 2, 362, 360, 3, 2, 2, 2, 363, 364, 7, 55, 2, 2, 364, 365,
-	7, 127, 2, 2, 365, 366, 7, 51, 2, 2, 366, 367, 7, 127, 2, 2, 367, 374,
-	5, 32, 17, 2, 368, 369, 7, 55, 2, 2, 369, 370, 7, 127, 2, 2, 370, 371,
-	7, 56, 2, 2, 371, 372, 7, 127, 2, 2, 372, 374, 5, 32, 17, 2, 373, 363,
-	3, 2, 2, 2, 373, 368, 3, 2, 2, 2, 374, 29, 3, 2, 2, 2, 375, 377, 7, 56,
-	2, 2, 376, 378, 7, 127, 2, 2, 377, 376, 3, 2, 2, 2, 377, 378, 3, 2, 2,
-	2, 378, 379, 3, 2, 2, 2, 379, 380, 5, 70, 36, 2, 380, 31, 3, 2, 2, 2, 381,
-	383, 7, 57, 2, 2, 382, 384, 7, 127, 2, 2, 383, 382, 3, 2, 2, 2, 383, 384,
-	3, 2, 2, 2, 384, 385, 3, 2, 2, 2, 385, 396, 5, 34, 18, 2, 386, 388, 7,
-	127, 2, 2, 387, 386, 3, 2, 2, 2, 387, 388, 3, 2, 2, 2, 388, 389, 3, 2,
-	2, 2, 389, 391, 7, 4, 2, 2, 390, 392, 7, 127, 2, 2, 391, 390, 3, 2, 2,
-	2, 391, 392, 3, 2, 2, 2, 392, 393, 3, 2, 2, 2, 393, 395, 5, 34, 18, 2,
-	394, 387, 3, 2, 2, 2, 395, 398, 3, 2, 2, 2, 396, 394, 3, 2, 2, 2, 396,
-	397, 3, 2, 2, 2, 397, 33, 3, 2, 2, 2, 398, 396, 3, 2, 2, 2, 399, 401, 5,
-	180, 91, 2, 400, 402, 7, 127, 2, 2, 401, 400, 3, 2, 2, 2, 401, 402, 3,
-	2, 2, 2, 402, 403, 3, 2, 2, 2, 403, 405, 7, 5, 2, 2, 404, 406, 7, 127,
-	2, 2, 405, 404, 3, 2, 2, 2, 405, 406, 3, 2, 2, 2, 406, 407, 3, 2, 2, 2,
-	407, 408, 5, 100, 51, 2, 408, 436, 3, 2, 2, 2, 409, 411, 5, 172, 87, 2,
-	410, 412, 7, 127, 2, 2, 411, 410, 3, 2, 2, 2, 411, 412, 3, 2, 2, 2, 412,
-	413, 3, 2, 2, 2, 413, 415, 7, 5, 2, 2, 414, 416, 7, 127, 2, 2, 415, 414,
-	3, 2, 2, 2, 415, 416, 3, 2, 2, 2, 416, 417, 3, 2, 2, 2, 417, 418, 5, 100,
-	51, 2, 418, 436, 3, 2, 2, 2, 419, 421, 5, 172, 87, 2, 420, 422, 7, 127,
-	2, 2, 421, 420, 3, 2, 2, 2, 421, 422, 3, 2, 2, 2, 422, 423, 3, 2, 2, 2,
-	423, 425, 7, 6, 2, 2, 424, 426, 7, 127, 2, 2, 425, 424, 3, 2, 2, 2, 425,
-	426, 3, 2, 2, 2, 426, 427, 3, 2, 2, 2, 427, 428, 5, 100, 51, 2, 428, 436,
-	3, 2, 2, 2, 429, 431, 5, 172, 87, 2, 430, 432, 7, 127, 2, 2, 431, 430,
-	3, 2, 2, 2, 431, 432, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434, 5, 90,
-	46, 2, 434, 436, 3, 2, 2, 2, 435, 399, 3, 2, 2, 2, 435, 409, 3, 2, 2, 2,
-	435, 419, 3, 2, 2, 2, 435, 429, 3, 2, 2, 2, 436, 35, 3, 2, 2, 2, 437, 438,
-	7, 58, 2, 2, 438, 440, 7, 127, 2, 2, 439, 437, 3, 2, 2, 2, 439, 440, 3,
-	2, 2, 2, 440, 441, 3, 2, 2, 2, 441, 443, 7, 59, 2, 2, 442, 444, 7, 127,
-	2, 2, 443, 442, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 445, 3, 2, 2, 2,
-	445, 456, 5, 100, 51, 2, 446, 448, 7, 127, 2, 2, 447, 446, 3, 2, 2, 2,
-	447, 448, 3, 2, 2, 2, 448, 449, 3, 2, 2, 2, 449, 451, 7, 4, 2, 2, 450,
-	452, 7, 127, 2, 2, 451, 450, 3, 2, 2, 2, 451, 452, 3, 2, 2, 2, 452, 453,
-	3, 2, 2, 2, 453, 455, 5, 100, 51, 2, 454, 447, 3, 2, 2, 2, 455, 458, 3,
-	2, 2, 2, 456, 454, 3, 2, 2, 2, 456, 457, 3, 2, 2, 2, 457, 37, 3, 2, 2,
-	2, 458, 456, 3, 2, 2, 2, 459, 460, 7, 60, 2, 2, 460, 461, 7, 127, 2, 2,
-	461, 472, 5, 40, 21, 2, 462, 464, 7, 127, 2, 2, 463, 462, 3, 2, 2, 2, 463,
-	464, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465, 467, 7, 4, 2, 2, 466, 468,
-	7, 127, 2, 2, 467, 466, 3, 2, 2, 2, 467, 468, 3, 2, 2, 2, 468, 469, 3,
-	2, 2, 2, 469, 471, 5, 40, 21, 2, 470, 463, 3, 2, 2, 2, 471, 474, 3, 2,
-	2, 2, 472, 470, 3, 2, 2, 2, 472, 473, 3, 2, 2, 2, 473, 39, 3, 2, 2, 2,
-	474, 472, 3, 2, 2, 2, 475, 476, 5, 172, 87, 2, 476, 477, 5, 90, 46, 2,
-	477, 480, 3, 2, 2, 2, 478, 480, 5, 180, 91, 2, 479, 475, 3, 2, 2, 2, 479,
-	478, 3, 2, 2, 2, 480, 41, 3, 2, 2, 2, 481, 482, 7, 61, 2, 2, 482, 483,
-	7, 127, 2, 2, 483, 490, 5, 152, 77, 2, 484, 486, 7, 127, 2, 2, 485, 484,
-	3, 2, 2, 2, 485, 486, 3, 2, 2, 2, 486, 487, 3, 2, 2, 2, 487, 488, 7, 62,
-	2, 2, 488, 489, 7, 127, 2, 2, 489, 491, 5, 46, 24, 2, 490, 485, 3, 2, 2,
-	2, 490, 491, 3, 2, 2, 2, 491, 43, 3, 2, 2, 2, 492, 493, 7, 61, 2, 2, 493,
-	496, 7, 127, 2, 2, 494, 497, 5, 152, 77, 2, 495, 497, 5, 154, 78, 2, 496,
-	494, 3, 2, 2, 2, 496, 495, 3, 2, 2, 2, 497, 502, 3, 2, 2, 2, 498, 499,
-	7, 127, 2, 2, 499, 500, 7, 62, 2, 2, 500, 501, 7, 127, 2, 2, 501, 503,
-	5, 46, 24, 2, 502, 498, 3, 2, 2, 2, 502, 503, 3, 2, 2, 2, 503, 45, 3, 2,
-	2, 2, 504, 520, 7, 7, 2, 2, 505, 516, 5, 48, 25, 2, 506, 508, 7, 127, 2,
-	2, 507, 506, 3, 2, 2, 2, 507, 508, 3, 2, 2, 2, 508, 509, 3, 2, 2, 2, 509,
-	511, 7, 4, 2, 2, 510, 512, 7, 127, 2, 2, 511, 510, 3, 2, 2, 2, 511, 512,
-	3, 2, 2, 2, 512, 513, 3, 2, 2, 2, 513, 515, 5, 48, 25, 2, 514, 507, 3,
-	2, 2, 2, 515, 518, 3, 2, 2, 2, 516, 514, 3, 2, 2, 2, 516, 517, 3, 2, 2,
-	2, 517, 520, 3, 2, 2, 2, 518, 516, 3, 2, 2, 2, 519, 504, 3, 2, 2, 2, 519,
-	505, 3, 2, 2, 2, 520, 525, 3, 2, 2, 2, 521, 523, 7, 127, 2, 2, 522, 521,
-	3, 2, 2, 2, 522, 523, 3, 2, 2, 2, 523, 524, 3, 2, 2, 2, 524, 526, 5, 68,
-	35, 2, 525, 522, 3, 2, 2, 2, 525, 526, 3, 2, 2, 2, 526, 47, 3, 2, 2, 2,
-	527, 528, 5, 156, 79, 2, 528, 529, 7, 127, 2, 2, 529, 530, 7, 53, 2, 2,
-	530, 531, 7, 127, 2, 2, 531, 533, 3, 2, 2, 2, 532, 527, 3, 2, 2, 2, 532,
-	533, 3, 2, 2, 2, 533, 534, 3, 2, 2, 2, 534, 535, 5, 172, 87, 2, 535, 49,
-	3, 2, 2, 2, 536, 537, 7, 63, 2, 2, 537, 542, 5, 54, 28, 2, 538, 540, 7,
-	127, 2, 2, 539, 538, 3, 2, 2, 2, 539, 540, 3, 2, 2, 2, 540, 541, 3, 2,
-	2, 2, 541, 543, 5, 68, 35, 2, 542, 539, 3, 2, 2, 2, 542, 543, 3, 2, 2,
-	2, 543, 51, 3, 2, 2, 2, 544, 545, 7, 64, 2, 2, 545, 546, 5, 54, 28, 2,
-	546, 53, 3, 2, 2, 2, 547, 549, 7, 127, 2, 2, 548, 547, 3, 2, 2, 2, 548,
-	549, 3, 2, 2, 2, 549, 550, 3, 2, 2, 2, 550, 552, 7, 65, 2, 2, 551, 548,
-	3, 2, 2, 2, 551, 552, 3, 2, 2, 2, 552, 553, 3, 2, 2, 2, 553, 554, 7, 127,
-	2, 2, 554, 557, 5, 56, 29, 2, 555, 556, 7, 127, 2, 2, 556, 558, 5, 60,
-	31, 2, 557, 555, 3, 2, 2, 2, 557, 558, 3, 2, 2, 2, 558, 561, 3, 2, 2, 2,
-	559, 560, 7, 127, 2, 2, 560, 562, 5, 62, 32, 2, 561, 559, 3, 2, 2, 2, 561,
-	562, 3, 2, 2, 2, 562, 565, 3, 2, 2, 2, 563, 564, 7, 127, 2, 2, 564, 566,
-	5, 64, 33, 2, 565, 563, 3, 2, 2, 2, 565, 566, 3, 2, 2, 2, 566, 55, 3, 2,
-	2, 2, 567, 578, 7, 7, 2, 2, 568, 570, 7, 127, 2, 2, 569, 568, 3, 2, 2,
-	2, 569, 570, 3, 2, 2, 2, 570, 571, 3, 2, 2, 2, 571, 573, 7, 4, 2, 2, 572,
-	574, 7, 127, 2, 2, 573, 572, 3, 2, 2, 2, 573, 574, 3, 2, 2, 2, 574, 575,
-	3, 2, 2, 2, 575, 577, 5, 58, 30, 2, 576, 569, 3, 2, 2, 2, 577, 580, 3,
-	2, 2, 2, 578, 576, 3, 2, 2, 2, 578, 579, 3, 2, 2, 2, 579, 596, 3, 2, 2,
-	2, 580, 578, 3, 2, 2, 2, 581, 592, 5, 58, 30, 2, 582, 584, 7, 127, 2, 2,
-	583, 582, 3, 2, 2, 2, 583, 584, 3, 2, 2, 2, 584, 585, 3, 2, 2, 2, 585,
-	587, 7, 4, 2, 2, 586, 588, 7, 127, 2, 2, 587, 586, 3, 2, 2, 2, 587, 588,
-	3, 2, 2, 2, 588, 589, 3, 2, 2, 2, 589, 591, 5, 58, 30, 2, 590, 583, 3,
-	2, 2, 2, 591, 594, 3, 2, 2, 2, 592, 590, 3, 2, 2, 2, 592, 593, 3, 2, 2,
-	2, 593, 596, 3, 2, 2, 2, 594, 592, 3, 2, 2, 2, 595, 567, 3, 2, 2, 2, 595,
-	581, 3, 2, 2, 2, 596, 57, 3, 2, 2, 2, 597, 598, 5, 100, 51, 2, 598, 599,
-	7, 127, 2, 2, 599, 600, 7, 53, 2, 2, 600, 601, 7, 127, 2, 2, 601, 602,
-	5, 172, 87, 2, 602, 605, 3, 2, 2, 2, 603, 605, 5, 100, 51, 2, 604, 597,
-	3, 2, 2, 2, 604, 603, 3, 2, 2, 2, 605, 59, 3, 2, 2, 2, 606, 607, 7, 66,
-	2, 2, 607, 608, 7, 127, 2, 2, 608, 609, 7, 67, 2, 2, 609, 610, 7, 127,
-	2, 2, 610, 618, 5, 66, 34, 2, 611, 613, 7, 4, 2, 2, 612, 614, 7, 127, 2,
-	2, 613, 612, 3, 2, 2, 2, 613, 614, 3, 2, 2, 2, 614, 615, 3, 2, 2, 2, 615,
-	617, 5, 66, 34, 2, 616, 611, 3, 2, 2, 2, 617, 620, 3, 2, 2, 2, 618, 616,
-	3, 2, 2, 2, 618, 619, 3, 2, 2, 2, 619, 61, 3, 2, 2, 2, 620, 618, 3, 2,
-	2, 2, 621, 622, 7, 68, 2, 2, 622, 623, 7, 127, 2, 2, 623, 624, 5, 100,
-	51, 2, 624, 63, 3, 2, 2, 2, 625, 626, 7, 69, 2, 2, 626, 627, 7, 127, 2,
-	2, 627, 628, 5, 100, 51, 2, 628, 65, 3, 2, 2, 2, 629, 634, 5, 100, 51,
-	2, 630, 632, 7, 127, 2, 2, 631, 630, 3, 2, 2, 2, 631, 632, 3, 2, 2, 2,
-	632, 633, 3, 2, 2, 2, 633, 635, 9, 2, 2, 2, 634, 631, 3, 2, 2, 2, 634,
-	635, 3, 2, 2, 2, 635, 67, 3, 2, 2, 2, 636, 637, 7, 74, 2, 2, 637, 638,
-	7, 127, 2, 2, 638, 639, 5, 100, 51, 2, 639, 69, 3, 2, 2, 2, 640, 651, 5,
-	72, 37, 2, 641, 643, 7, 127, 2, 2, 642, 641, 3, 2, 2, 2, 642, 643, 3, 2,
-	2, 2, 643, 644, 3, 2, 2, 2, 644, 646, 7, 4, 2, 2, 645, 647, 7, 127, 2,
-	2, 646, 645, 3, 2, 2, 2, 646, 647, 3, 2, 2, 2, 647, 648, 3, 2, 2, 2, 648,
-	650, 5, 72, 37, 2, 649, 642, 3, 2, 2, 2, 650, 653, 3, 2, 2, 2, 651, 649,
-	3, 2, 2, 2, 651, 652, 3, 2, 2, 2, 652, 71, 3, 2, 2, 2, 653, 651, 3, 2,
-	2, 2, 654, 656, 5, 172, 87, 2, 655, 657, 7, 127, 2, 2, 656, 655, 3, 2,
-	2, 2, 656, 657, 3, 2, 2, 2, 657, 658, 3, 2, 2, 2, 658, 660, 7, 5, 2, 2,
-	659, 661, 7, 127, 2, 2, 660, 659, 3, 2, 2, 2, 660, 661, 3, 2, 2, 2, 661,
-	662, 3, 2, 2, 2, 662, 663, 5, 74, 38, 2, 663, 666, 3, 2, 2, 2, 664, 666,
-	5, 74, 38, 2, 665, 654, 3, 2, 2, 2, 665, 664, 3, 2, 2, 2, 666, 73, 3, 2,
-	2, 2, 667, 668, 5, 76, 39, 2, 668, 75, 3, 2, 2, 2, 669, 676, 5, 78, 40,
-	2, 670, 672, 7, 127, 2, 2, 671, 670, 3, 2, 2, 2, 671, 672, 3, 2, 2, 2,
-	672, 673, 3, 2, 2, 2, 673, 675, 5, 80, 41, 2, 674, 671, 3, 2, 2, 2, 675,
-	678, 3, 2, 2, 2, 676, 674, 3, 2, 2, 2, 676, 677, 3, 2, 2, 2, 677, 684,
-	3, 2, 2, 2, 678, 676, 3, 2, 2, 2, 679, 680, 7, 8, 2, 2, 680, 681, 5, 76,
-	39, 2, 681, 682, 7, 9, 2, 2, 682, 684, 3, 2, 2, 2, 683, 669, 3, 2, 2, 2,
-	683, 679, 3, 2, 2, 2, 684, 77, 3, 2, 2, 2, 685, 687, 7, 8, 2, 2, 686, 688,
-	7, 127, 2, 2, 687, 686, 3, 2, 2, 2, 687, 688, 3, 2, 2, 2, 688, 693, 3,
-	2, 2, 2, 689, 691, 5, 172, 87, 2, 690, 692, 7, 127, 2, 2, 691, 690, 3,
-	2, 2, 2, 691, 692, 3, 2, 2, 2, 692, 694, 3, 2, 2, 2, 693, 689, 3, 2, 2,
-	2, 693, 694, 3, 2, 2, 2, 694, 699, 3, 2, 2, 2, 695, 697, 5, 90, 46, 2,
-	696, 698, 7, 127, 2, 2, 697, 696, 3, 2, 2, 2, 697, 698, 3, 2, 2, 2, 698,
-	700, 3, 2, 2, 2, 699, 695, 3, 2, 2, 2, 699, 700, 3, 2, 2, 2, 700, 705,
-	3, 2, 2, 2, 701, 703, 5, 86, 44, 2, 702, 704, 7, 127, 2, 2, 703, 702, 3,
-	2, 2, 2, 703, 704, 3, 2, 2, 2, 704, 706, 3, 2, 2, 2, 705, 701, 3, 2, 2,
-	2, 705, 706, 3, 2, 2, 2, 706, 707, 3, 2, 2, 2, 707, 708, 7, 9, 2, 2, 708,
-	79, 3, 2, 2, 2, 709, 711, 5, 82, 42, 2, 710, 712, 7, 127, 2, 2, 711, 710,
-	3, 2, 2, 2, 711, 712, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 714, 5, 78,
-	40, 2, 714, 81, 3, 2, 2, 2, 715, 717, 5, 194, 98, 2, 716, 718, 7, 127,
-	2, 2, 717, 716, 3, 2, 2, 2, 717, 718, 3, 2, 2, 2, 718, 719, 3, 2, 2, 2,
-	719, 721, 5, 198, 100, 2, 720, 722, 7, 127, 2, 2, 721, 720, 3, 2, 2, 2,
-	721, 722, 3, 2, 2, 2, 722, 724, 3, 2, 2, 2, 723, 725, 5, 84, 43, 2, 724,
-	723, 3, 2, 2, 2, 724, 725, 3, 2, 2, 2, 725, 727, 3, 2, 2, 2, 726, 728,
-	7, 127, 2, 2, 727, 726, 3, 2, 2, 2, 727, 728, 3, 2, 2, 2, 728, 729, 3,
-	2, 2, 2, 729, 731, 5, 198, 100, 2, 730, 732, 7, 127, 2, 2, 731, 730, 3,
-	2, 2, 2, 731, 732, 3, 2, 2, 2, 732, 733, 3, 2, 2, 2, 733, 734, 5, 196,
-	99, 2, 734, 780, 3, 2, 2, 2, 735, 737, 5, 194, 98, 2, 736, 738, 7, 127,
-	2, 2, 737, 736, 3, 2, 2, 2, 737, 738, 3, 2, 2, 2, 738, 739, 3, 2, 2, 2,
-	739, 741, 5, 198, 100, 2, 740, 742, 7, 127, 2, 2, 741, 740, 3, 2, 2, 2,
-	741, 742, 3, 2, 2, 2, 742, 744, 3, 2, 2, 2, 743, 745, 5, 84, 43, 2, 744,
-	743, 3, 2, 2, 2, 744, 745, 3, 2, 2, 2, 745, 747, 3, 2, 2, 2, 746, 748,
-	7, 127, 2, 2, 747, 746, 3, 2, 2, 2, 747, 748, 3, 2, 2, 2, 748, 749, 3,
-	2, 2, 2, 749, 750, 5, 198, 100, 2, 750, 780, 3, 2, 2, 2, 751, 753, 5, 198,
-	100, 2, 752, 754, 7, 127, 2, 2, 753, 752, 3, 2, 2, 2, 753, 754, 3, 2, 2,
-	2, 754, 756, 3, 2, 2, 2, 755, 757, 5, 84, 43, 2, 756, 755, 3, 2, 2, 2,
-	756, 757, 3, 2, 2, 2, 757, 759, 3, 2, 2, 2, 758, 760, 7, 127, 2, 2, 759,
+	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101, 4, 102,
+	9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 3, 2, 5, 2, 210, 10, 2, 3, 2, 3,
+	2, 5, 2, 214, 10, 2, 3, 2, 5, 2, 217, 10, 2, 3, 2, 5, 2, 220, 10, 2, 3,
+	2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 5, 4, 228, 10, 4, 3, 5, 3, 5, 5, 5, 232,
+	10, 5, 3, 5, 7, 5, 235, 10, 5, 12, 5, 14, 5, 238, 11, 5, 3, 6, 3, 6, 3,
+	6, 3, 6, 5, 6, 244, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 249, 10, 6, 3, 6, 5,
+	6, 252, 10, 6, 3, 7, 3, 7, 5, 7, 256, 10, 7, 3, 8, 3, 8, 5, 8, 260, 10,
+	8, 7, 8, 262, 10, 8, 12, 8, 14, 8, 265, 11, 8, 3, 8, 3, 8, 3, 8, 5, 8,
+	270, 10, 8, 7, 8, 272, 10, 8, 12, 8, 14, 8, 275, 11, 8, 3, 8, 3, 8, 5,
+	8, 279, 10, 8, 3, 8, 7, 8, 282, 10, 8, 12, 8, 14, 8, 285, 11, 8, 3, 8,
+	5, 8, 288, 10, 8, 3, 8, 5, 8, 291, 10, 8, 5, 8, 293, 10, 8, 3, 9, 3, 9,
+	5, 9, 297, 10, 9, 7, 9, 299, 10, 9, 12, 9, 14, 9, 302, 11, 9, 3, 9, 3,
+	9, 5, 9, 306, 10, 9, 7, 9, 308, 10, 9, 12, 9, 14, 9, 311, 11, 9, 3, 9,
+	3, 9, 5, 9, 315, 10, 9, 6, 9, 317, 10, 9, 13, 9, 14, 9, 318, 3, 9, 3, 9,
+	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 328, 10, 10, 3, 11, 3, 11, 3,
+	11, 5, 11, 333, 10, 11, 3, 12, 3, 12, 5, 12, 337, 10, 12, 3, 12, 3, 12,
+	5, 12, 341, 10, 12, 3, 12, 3, 12, 5, 12, 345, 10, 12, 3, 12, 5, 12, 348,
+	10, 12, 3, 13, 3, 13, 5, 13, 352, 10, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3,
+	13, 3, 13, 3, 14, 3, 14, 5, 14, 362, 10, 14, 3, 14, 3, 14, 3, 14, 7, 14,
+	367, 10, 14, 12, 14, 14, 14, 370, 11, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3,
+	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 382, 10, 15, 3, 16, 3, 16,
+	5, 16, 386, 10, 16, 3, 16, 3, 16, 3, 17, 3, 17, 5, 17, 392, 10, 17, 3,
+	17, 3, 17, 5, 17, 396, 10, 17, 3, 17, 3, 17, 5, 17, 400, 10, 17, 3, 17,
+	7, 17, 403, 10, 17, 12, 17, 14, 17, 406, 11, 17, 3, 18, 3, 18, 5, 18, 410,
+	10, 18, 3, 18, 3, 18, 5, 18, 414, 10, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5,
+	18, 420, 10, 18, 3, 18, 3, 18, 5, 18, 424, 10, 18, 3, 18, 3, 18, 3, 18,
+	3, 18, 5, 18, 430, 10, 18, 3, 18, 3, 18, 5, 18, 434, 10, 18, 3, 18, 3,
+	18, 3, 18, 3, 18, 5, 18, 440, 10, 18, 3, 18, 3, 18, 5, 18, 444, 10, 18,
+	3, 19, 3, 19, 5, 19, 448, 10, 19, 3, 19, 3, 19, 5, 19, 452, 10, 19, 3,
+	19, 3, 19, 5, 19, 456, 10, 19, 3, 19, 3, 19, 5, 19, 460, 10, 19, 3, 19,
+	7, 19, 463, 10, 19, 12, 19, 14, 19, 466, 11, 19, 3, 20, 3, 20, 3, 20, 3,
+	20, 5, 20, 472, 10, 20, 3, 20, 3, 20, 5, 20, 476, 10, 20, 3, 20, 7, 20,
+	479, 10, 20, 12, 20, 14, 20, 482, 11, 20, 3, 21, 3, 21, 3, 21, 3, 21, 5,
+	21, 488, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 494, 10, 22, 3, 22,
+	3, 22, 3, 22, 5, 22, 499, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 505,
+	10, 23, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 511, 10, 23, 3, 24, 3, 24, 3,
+	24, 5, 24, 516, 10, 24, 3, 24, 3, 24, 5, 24, 520, 10, 24, 3, 24, 7, 24,
+	523, 10, 24, 12, 24, 14, 24, 526, 11, 24, 5, 24, 528, 10, 24, 3, 24, 5,
+	24, 531, 10, 24, 3, 24, 5, 24, 534, 10, 24, 3, 25, 3, 25, 3, 25, 3, 25,
+	3, 25, 5, 25, 541, 10, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 5, 26, 548,
+	10, 26, 3, 26, 5, 26, 551, 10, 26, 3, 27, 3, 27, 3, 27, 3, 28, 5, 28, 557,
+	10, 28, 3, 28, 5, 28, 560, 10, 28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 566,
+	10, 28, 3, 28, 3, 28, 5, 28, 570, 10, 28, 3, 28, 3, 28, 5, 28, 574, 10,
+	28, 3, 29, 3, 29, 5, 29, 578, 10, 29, 3, 29, 3, 29, 5, 29, 582, 10, 29,
+	3, 29, 7, 29, 585, 10, 29, 12, 29, 14, 29, 588, 11, 29, 3, 29, 3, 29, 5,
+	29, 592, 10, 29, 3, 29, 3, 29, 5, 29, 596, 10, 29, 3, 29, 7, 29, 599, 10,
+	29, 12, 29, 14, 29, 602, 11, 29, 5, 29, 604, 10, 29, 3, 30, 3, 30, 3, 30,
+	3, 30, 3, 30, 3, 30, 3, 30, 5, 30, 613, 10, 30, 3, 31, 3, 31, 3, 31, 3,
+	31, 3, 31, 3, 31, 3, 31, 5, 31, 622, 10, 31, 3, 31, 7, 31, 625, 10, 31,
+	12, 31, 14, 31, 628, 11, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33,
+	3, 33, 3, 33, 3, 34, 3, 34, 5, 34, 640, 10, 34, 3, 34, 5, 34, 643, 10,
+	34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 5, 36, 651, 10, 36, 3, 36,
+	3, 36, 5, 36, 655, 10, 36, 3, 36, 7, 36, 658, 10, 36, 12, 36, 14, 36, 661,
+	11, 36, 3, 37, 3, 37, 5, 37, 665, 10, 37, 3, 37, 3, 37, 5, 37, 669, 10,
+	37, 3, 37, 3, 37, 3, 37, 5, 37, 674, 10, 37, 3, 38, 3, 38, 5, 38, 678,
+	10, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39,
+	3, 39, 5, 39, 690, 10, 39, 3, 40, 3, 40, 5, 40, 694, 10, 40, 3, 40, 7,
+	40, 697, 10, 40, 12, 40, 14, 40, 700, 11, 40, 3, 40, 3, 40, 3, 40, 3, 40,
+	5, 40, 706, 10, 40, 3, 41, 3, 41, 5, 41, 710, 10, 41, 3, 41, 3, 41, 5,
+	41, 714, 10, 41, 5, 41, 716, 10, 41, 3, 41, 3, 41, 5, 41, 720, 10, 41,
+	5, 41, 722, 10, 41, 3, 41, 3, 41, 5, 41, 726, 10, 41, 5, 41, 728, 10, 41,
+	3, 41, 3, 41, 3, 42, 3, 42, 5, 42, 734, 10, 42, 3, 42, 3, 42, 3, 43, 3,
+	43, 5, 43, 740, 10, 43, 3, 43, 3, 43, 5, 43, 744, 10, 43, 3, 43, 5, 43,
+	747, 10, 43, 3, 43, 5, 43, 750, 10, 43, 3, 43, 3, 43, 5, 43, 754, 10, 43,
+	3, 43, 3, 43, 3, 43, 3, 43, 5, 43, 760, 10, 43, 3, 43, 3, 43, 5, 43, 764,
+	10, 43, 3, 43, 5, 43, 767, 10, 43, 3, 43, 5, 43, 770, 10, 43, 3, 43, 3,
+	43, 3, 43, 3, 43, 5, 43, 776, 10, 43, 3, 43, 5, 43, 779, 10, 43, 3, 43,
+	5, 43, 782, 10, 43, 3, 43, 3, 43, 5, 43, 786, 10, 43, 3, 43, 3, 43, 3,
+	43, 3, 43, 5, 43, 792, 10, 43, 3, 43, 5, 43, 795, 10, 43, 3, 43, 5, 43,
+	798, 10, 43, 3, 43, 3, 43, 5, 43, 802, 10, 43, 3, 44, 3, 44, 5, 44, 806,
+	10, 44, 3, 44, 3, 44, 5, 44, 810, 10, 44, 5, 44, 812, 10, 44, 3, 44, 3,
+	44, 5, 44, 816, 10, 44, 5, 44, 818, 10, 44, 3, 44, 5, 44, 821, 10, 44,
+	3, 44, 3, 44, 5, 44, 825, 10, 44, 5, 44, 827, 10, 44, 3, 44, 3, 44, 3,
+	45, 3, 45, 5, 45, 833, 10, 45, 3, 46, 3, 46, 5, 46, 837, 10, 46, 3, 46,
+	3, 46, 5, 46, 841, 10, 46, 3, 46, 3, 46, 5, 46, 845, 10, 46, 3, 46, 5,
+	46, 848, 10, 46, 3, 46, 7, 46, 851, 10, 46, 12, 46, 14, 46, 854, 11, 46,
+	3, 47, 3, 47, 5, 47, 858, 10, 47, 3, 47, 7, 47, 861, 10, 47, 12, 47, 14,
+	47, 864, 11, 47, 3, 48, 3, 48, 5, 48, 868, 10, 48, 3, 48, 3, 48, 3, 49,
+	3, 49, 5, 49, 874, 10, 49, 3, 49, 3, 49, 5, 49, 878, 10, 49, 5, 49, 880,
+	10, 49, 3, 49, 3, 49, 5, 49, 884, 10, 49, 3, 49, 3, 49, 5, 49, 888, 10,
+	49, 5, 49, 890, 10, 49, 5, 49, 892, 10, 49, 3, 50, 3, 50, 3, 51, 3, 51,
+	3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 7, 53, 905, 10, 53, 12,
+	53, 14, 53, 908, 11, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 7, 54, 915,
+	10, 54, 12, 54, 14, 54, 918, 11, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55,
+	7, 55, 925, 10, 55, 12, 55, 14, 55, 928, 11, 55, 3, 56, 3, 56, 5, 56, 932,
+	10, 56, 7, 56, 934, 10, 56, 12, 56, 14, 56, 937, 11, 56, 3, 56, 3, 56,
+	3, 57, 3, 57, 5, 57, 943, 10, 57, 3, 57, 7, 57, 946, 10, 57, 12, 57, 14,
+	57, 949, 11, 57, 3, 58, 3, 58, 5, 58, 953, 10, 58, 3, 58, 3, 58, 5, 58,
+	957, 10, 58, 3, 58, 3, 58, 5, 58, 961, 10, 58, 3, 58, 3, 58, 5, 58, 965,
+	10, 58, 3, 58, 7, 58, 968, 10, 58, 12, 58, 14, 58, 971, 11, 58, 3, 59,
+	3, 59, 5, 59, 975, 10, 59, 3, 59, 3, 59, 5, 59, 979, 10, 59, 3, 59, 3,
+	59, 5, 59, 983, 10, 59, 3, 59, 3, 59, 5, 59, 987, 10, 59, 3, 59, 3, 59,
+	5, 59, 991, 10, 59, 3, 59, 3, 59, 5, 59, 995, 10, 59, 3, 59, 7, 59, 998,
+	10, 59, 12, 59, 14, 59, 1001, 11, 59, 3, 60, 3, 60, 5, 60, 1005, 10, 60,
+	3, 60, 3, 60, 5, 60, 1009, 10, 60, 3, 60, 7, 60, 1012, 10, 60, 12, 60,
+	14, 60, 1015, 11, 60, 3, 61, 3, 61, 5, 61, 1019, 10, 61, 7, 61, 1021, 10,
+	61, 12, 61, 14, 61, 1024, 11, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3,
+	62, 7, 62, 1032, 10, 62, 12, 62, 14, 62, 1035, 11, 62, 3, 63, 3, 63, 3,
+	63, 5, 63, 1040, 10, 63, 3, 63, 3, 63, 5, 63, 1044, 10, 63, 3, 63, 3, 63,
+	3, 63, 3, 63, 3, 63, 5, 63, 1051, 10, 63, 3, 63, 3, 63, 5, 63, 1055, 10,
+	63, 3, 63, 3, 63, 5, 63, 1059, 10, 63, 3, 63, 5, 63, 1062, 10, 63, 3, 64,
+	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 5,
+	64, 1075, 10, 64, 3, 64, 5, 64, 1078, 10, 64, 3, 64, 5, 64, 1081, 10, 64,
+	3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3,
+	65, 3, 65, 5, 65, 1095, 10, 65, 3, 66, 3, 66, 5, 66, 1099, 10, 66, 3, 66,
+	7, 66, 1102, 10, 66, 12, 66, 14, 66, 1105, 11, 66, 3, 66, 5, 66, 1108,
+	10, 66, 3, 66, 5, 66, 1111, 10, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67,
+	5, 67, 1118, 10, 67, 3, 67, 3, 67, 5, 67, 1122, 10, 67, 3, 67, 3, 67, 5,
+	67, 1126, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1133, 10, 67,
+	3, 67, 3, 67, 5, 67, 1137, 10, 67, 3, 67, 3, 67, 5, 67, 1141, 10, 67, 3,
+	67, 3, 67, 3, 67, 3, 67, 5, 67, 1147, 10, 67, 3, 67, 3, 67, 5, 67, 1151,
+	10, 67, 3, 67, 3, 67, 5, 67, 1155, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67,
+	5, 67, 1161, 10, 67, 3, 67, 3, 67, 5, 67, 1165, 10, 67, 3, 67, 3, 67, 5,
+	67, 1169, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1175, 10, 67, 3, 67,
+	3, 67, 5, 67, 1179, 10, 67, 3, 67, 3, 67, 5, 67, 1183, 10, 67, 3, 67, 3,
+	67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1193, 10, 67, 3, 68,
+	3, 68, 5, 68, 1197, 10, 68, 3, 68, 3, 68, 5, 68, 1201, 10, 68, 3, 68, 3,
+	68, 5, 68, 1205, 10, 68, 3, 68, 3, 68, 5, 68, 1209, 10, 68, 3, 68, 3, 68,
+	5, 68, 1213, 10, 68, 3, 68, 3, 68, 5, 68, 1217, 10, 68, 3, 68, 3, 68, 5,
+	68, 1221, 10, 68, 3, 68, 3, 68, 5, 68, 1225, 10, 68, 3, 68, 3, 68, 5, 68,
+	1229, 10, 68, 3, 68, 3, 68, 3, 69, 3, 69, 5, 69, 1235, 10, 69, 3, 69, 3,
+	69, 5, 69, 1239, 10, 69, 3, 69, 3, 69, 5, 69, 1243, 10, 69, 3, 69, 3, 69,
+	5, 69, 1247, 10, 69, 3, 69, 3, 69, 5, 69, 1251, 10, 69, 7, 69, 1253, 10,
+	69, 12, 69, 14, 69, 1256, 11, 69, 5, 69, 1258, 10, 69, 3, 69, 3, 69, 3,
+	70, 3, 70, 3, 70, 5, 70, 1265, 10, 70, 3, 70, 3, 70, 5, 70, 1269, 10, 70,
+	3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1276, 10, 70, 3, 70, 5, 70, 1279,
+	10, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1287, 10, 71,
+	3, 72, 3, 72, 3, 73, 3, 73, 5, 73, 1293, 10, 73, 3, 73, 3, 73, 5, 73, 1297,
+	10, 73, 3, 73, 3, 73, 5, 73, 1301, 10, 73, 3, 73, 3, 73, 5, 73, 1305, 10,
+	73, 7, 73, 1307, 10, 73, 12, 73, 14, 73, 1310, 11, 73, 5, 73, 1312, 10,
+	73, 3, 73, 3, 73, 3, 74, 3, 74, 5, 74, 1318, 10, 74, 3, 74, 3, 74, 3, 74,
+	5, 74, 1323, 10, 74, 3, 74, 3, 74, 3, 74, 5, 74, 1328, 10, 74, 3, 74, 3,
+	74, 3, 74, 5, 74, 1333, 10, 74, 3, 74, 3, 74, 3, 74, 5, 74, 1338, 10, 74,
+	3, 74, 3, 74, 3, 74, 5, 74, 1343, 10, 74, 3, 74, 5, 74, 1346, 10, 74, 3,
+	75, 3, 75, 5, 75, 1350, 10, 75, 3, 75, 3, 75, 5, 75, 1354, 10, 75, 3, 75,
+	3, 75, 3, 76, 3, 76, 5, 76, 1360, 10, 76, 3, 76, 6, 76, 1363, 10, 76, 13,
+	76, 14, 76, 1364, 3, 77, 3, 77, 5, 77, 1369, 10, 77, 3, 77, 5, 77, 1372,
+	10, 77, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 5, 79,
+	1382, 10, 79, 3, 79, 3, 79, 5, 79, 1386, 10, 79, 3, 79, 3, 79, 5, 79, 1390,
+	10, 79, 5, 79, 1392, 10, 79, 3, 79, 3, 79, 5, 79, 1396, 10, 79, 3, 79,
+	3, 79, 5, 79, 1400, 10, 79, 3, 79, 3, 79, 5, 79, 1404, 10, 79, 7, 79, 1406,
+	10, 79, 12, 79, 14, 79, 1409, 11, 79, 5, 79, 1411, 10, 79, 3, 79, 3, 79,
+	3, 80, 3, 80, 3, 80, 3, 80, 5, 80, 1419, 10, 80, 3, 81, 3, 81, 5, 81, 1423,
+	10, 81, 3, 81, 3, 81, 5, 81, 1427, 10, 81, 3, 81, 3, 81, 5, 81, 1431, 10,
+	81, 3, 81, 3, 81, 5, 81, 1435, 10, 81, 3, 81, 3, 81, 5, 81, 1439, 10, 81,
+	7, 81, 1441, 10, 81, 12, 81, 14, 81, 1444, 11, 81, 5, 81, 1446, 10, 81,
+	3, 81, 3, 81, 3, 82, 3, 82, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 85, 3,
+	85, 3, 85, 7, 85, 1460, 10, 85, 12, 85, 14, 85, 1463, 11, 85, 3, 86, 3,
+	86, 5, 86, 1467, 10, 86, 3, 86, 3, 86, 5, 86, 1471, 10, 86, 3, 86, 3, 86,
+	5, 86, 1475, 10, 86, 3, 86, 5, 86, 1478, 10, 86, 3, 86, 5, 86, 1481, 10,
+	86, 3, 86, 3, 86, 3, 87, 3, 87, 5, 87, 1487, 10, 87, 3, 87, 3, 87, 5, 87,
+	1491, 10, 87, 3, 87, 3, 87, 5, 87, 1495, 10, 87, 5, 87, 1497, 10, 87, 3,
+	87, 3, 87, 5, 87, 1501, 10, 87, 3, 87, 3, 87, 5, 87, 1505, 10, 87, 3, 87,
+	3, 87, 5, 87, 1509, 10, 87, 5, 87, 1511, 10, 87, 3, 87, 3, 87, 5, 87, 1515,
+	10, 87, 3, 87, 3, 87, 5, 87, 1519, 10, 87, 3, 87, 3, 87, 3, 88, 3, 88,
+	5, 88, 1525, 10, 88, 3, 88, 3, 88, 3, 89, 3, 89, 5, 89, 1531, 10, 89, 3,
+	89, 6, 89, 1534, 10, 89, 13, 89, 14, 89, 1535, 3, 89, 3, 89, 5, 89, 1540,
+	10, 89, 3, 89, 3, 89, 5, 89, 1544, 10, 89, 3, 89, 6, 89, 1547, 10, 89,
+	13, 89, 14, 89, 1548, 5, 89, 1551, 10, 89, 3, 89, 5, 89, 1554, 10, 89,
+	3, 89, 3, 89, 5, 89, 1558, 10, 89, 3, 89, 5, 89, 1561, 10, 89, 3, 89, 5,
+	89, 1564, 10, 89, 3, 89, 3, 89, 3, 90, 3, 90, 5, 90, 1570, 10, 90, 3, 90,
+	3, 90, 5, 90, 1574, 10, 90, 3, 90, 3, 90, 5, 90, 1578, 10, 90, 3, 90, 3,
+	90, 3, 91, 3, 91, 3, 92, 3, 92, 5, 92, 1586, 10, 92, 3, 93, 3, 93, 5, 93,
+	1590, 10, 93, 3, 93, 3, 93, 5, 93, 1594, 10, 93, 3, 93, 3, 93, 5, 93, 1598,
+	10, 93, 3, 93, 3, 93, 5, 93, 1602, 10, 93, 3, 93, 3, 93, 5, 93, 1606, 10,
+	93, 3, 93, 3, 93, 5, 93, 1610, 10, 93, 3, 93, 3, 93, 5, 93, 1614, 10, 93,
+	3, 93, 3, 93, 5, 93, 1618, 10, 93, 7, 93, 1620, 10, 93, 12, 93, 14, 93,
+	1623, 11, 93, 5, 93, 1625, 10, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 5,
+	94, 1632, 10, 94, 3, 95, 3, 95, 5, 95, 1636, 10, 95, 3, 95, 6, 95, 1639,
+	10, 95, 13, 95, 14, 95, 1640, 3, 96, 3, 96, 3, 97, 3, 97, 3, 98, 3, 98,
+	3, 99, 3, 99, 5, 99, 1651, 10, 99, 3, 100, 3, 100, 3, 101, 3, 101, 3, 102,
+	3, 102, 3, 103, 3, 103, 3, 104, 3, 104, 3, 104, 2, 2, 105, 2, 4, 6, 8,
+	10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
+	46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80,
+	82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
+	114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142,
+	144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172,
+	174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202,
+	204, 206, 2, 12, 3, 2, 71, 74, 3, 2, 15, 16, 3, 2, 93, 94, 3, 2, 103, 105,
+	3, 2, 113, 114, 7, 2, 49, 61, 64, 75, 78, 87, 93, 100, 115, 124, 7, 2,
+	76, 77, 88, 92, 106, 106, 125, 127, 130, 130, 4, 2, 25, 25, 30, 33, 4,
+	2, 26, 26, 34, 37, 4, 2, 16, 16, 38, 48, 2, 1904, 2, 209, 3, 2, 2, 2, 4,
+	223, 3, 2, 2, 2, 6, 227, 3, 2, 2, 2, 8, 229, 3, 2, 2, 2, 10, 251, 3, 2,
+	2, 2, 12, 255, 3, 2, 2, 2, 14, 292, 3, 2, 2, 2, 16, 316, 3, 2, 2, 2, 18,
+	327, 3, 2, 2, 2, 20, 332, 3, 2, 2, 2, 22, 336, 3, 2, 2, 2, 24, 349, 3,
+	2, 2, 2, 26, 359, 3, 2, 2, 2, 28, 381, 3, 2, 2, 2, 30, 383, 3, 2, 2, 2,
+	32, 389, 3, 2, 2, 2, 34, 443, 3, 2, 2, 2, 36, 447, 3, 2, 2, 2, 38, 467,
+	3, 2, 2, 2, 40, 487, 3, 2, 2, 2, 42, 489, 3, 2, 2, 2, 44, 500, 3, 2, 2,
+	2, 46, 527, 3, 2, 2, 2, 48, 540, 3, 2, 2, 2, 50, 544, 3, 2, 2, 2, 52, 552,
+	3, 2, 2, 2, 54, 559, 3, 2, 2, 2, 56, 603, 3, 2, 2, 2, 58, 612, 3, 2, 2,
+	2, 60, 614, 3, 2, 2, 2, 62, 629, 3, 2, 2, 2, 64, 633, 3, 2, 2, 2, 66, 637,
+	3, 2, 2, 2, 68, 644, 3, 2, 2, 2, 70, 648, 3, 2, 2, 2, 72, 673, 3, 2, 2,
+	2, 74, 677, 3, 2, 2, 2, 76, 689, 3, 2, 2, 2, 78, 705, 3, 2, 2, 2, 80, 707,
+	3, 2, 2, 2, 82, 731, 3, 2, 2, 2, 84, 801, 3, 2, 2, 2, 86, 803, 3, 2, 2,
+	2, 88, 832, 3, 2, 2, 2, 90, 834, 3, 2, 2, 2, 92, 855, 3, 2, 2, 2, 94, 865,
+	3, 2, 2, 2, 96, 871, 3, 2, 2, 2, 98, 893, 3, 2, 2, 2, 100, 895, 3, 2, 2,
+	2, 102, 897, 3, 2, 2, 2, 104, 899, 3, 2, 2, 2, 106, 909, 3, 2, 2, 2, 108,
+	919, 3, 2, 2, 2, 110, 935, 3, 2, 2, 2, 112, 940, 3, 2, 2, 2, 114, 950,
+	3, 2, 2, 2, 116, 972, 3, 2, 2, 2, 118, 1002, 3, 2, 2, 2, 120, 1022, 3,
+	2, 2, 2, 122, 1027, 3, 2, 2, 2, 124, 1061, 3, 2, 2, 2, 126, 1077, 3, 2,
+	2, 2, 128, 1094, 3, 2, 2, 2, 130, 1096, 3, 2, 2, 2, 132, 1192, 3, 2, 2,
+	2, 134, 1194, 3, 2, 2, 2, 136, 1232, 3, 2, 2, 2, 138, 1278, 3, 2, 2, 2,
+	140, 1286, 3, 2, 2, 2, 142, 1288, 3, 2, 2, 2, 144, 1290, 3, 2, 2, 2, 146,
+	1345, 3, 2, 2, 2, 148, 1347, 3, 2, 2, 2, 150, 1357, 3, 2, 2, 2, 152, 1366,
+	3, 2, 2, 2, 154, 1373, 3, 2, 2, 2, 156, 1379, 3, 2, 2, 2, 158, 1418, 3,
+	2, 2, 2, 160, 1420, 3, 2, 2, 2, 162, 1449, 3, 2, 2, 2, 164, 1451, 3, 2,
+	2, 2, 166, 1453, 3, 2, 2, 2, 168, 1461, 3, 2, 2, 2, 170, 1464, 3, 2, 2,
+	2, 172, 1484, 3, 2, 2, 2, 174, 1522, 3, 2, 2, 2, 176, 1550, 3, 2, 2, 2,
+	178, 1567, 3, 2, 2, 2, 180, 1581, 3, 2, 2, 2, 182, 1585, 3, 2, 2, 2, 184,
+	1587, 3, 2, 2, 2, 186, 1628, 3, 2, 2, 2, 188, 1633, 3, 2, 2, 2, 190, 1642,
+	3, 2, 2, 2, 192, 1644, 3, 2, 2, 2, 194, 1646, 3, 2, 2, 2, 196, 1650, 3,
+	2, 2, 2, 198, 1652, 3, 2, 2, 2, 200, 1654, 3, 2, 2, 2, 202, 1656, 3, 2,
+	2, 2, 204, 1658, 3, 2, 2, 2, 206, 1660, 3, 2, 2, 2, 208, 210, 7, 131, 2,
+	2, 209, 208, 3, 2, 2, 2, 209, 210, 3, 2, 2, 2, 210, 211, 3, 2, 2, 2, 211,
+	216, 5, 4, 3, 2, 212, 214, 7, 131, 2, 2, 213, 212, 3, 2, 2, 2, 213, 214,
+	3, 2, 2, 2, 214, 215, 3, 2, 2, 2, 215, 217, 7, 3, 2, 2, 216, 213, 3, 2,
+	2, 2, 216, 217, 3, 2, 2, 2, 217, 219, 3, 2, 2, 2, 218, 220, 7, 131, 2,
+	2, 219, 218, 3, 2, 2, 2, 219, 220, 3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221,
+	222, 7, 2, 2, 3, 222, 3, 3, 2, 2, 2, 223, 224, 5, 6, 4, 2, 224, 5, 3, 2,
+	2, 2, 225, 228, 5, 8, 5, 2, 226, 228, 5, 44, 23, 2, 227, 225, 3, 2, 2,
+	2, 227, 226, 3, 2, 2, 2, 228, 7, 3, 2, 2, 2, 229, 236, 5, 12, 7, 2, 230,
+	232, 7, 131, 2, 2, 231, 230, 3, 2, 2, 2, 231, 232, 3, 2, 2, 2, 232, 233,
+	3, 2, 2, 2, 233, 235, 5, 10, 6, 2, 234, 231, 3, 2, 2, 2, 235, 238, 3, 2,
+	2, 2, 236, 234, 3, 2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 9, 3, 2, 2, 2, 238,
+	236, 3, 2, 2, 2, 239, 240, 7, 49, 2, 2, 240, 241, 7, 131, 2, 2, 241, 243,
+	7, 50, 2, 2, 242, 244, 7, 131, 2, 2, 243, 242, 3, 2, 2, 2, 243, 244, 3,
+	2, 2, 2, 244, 245, 3, 2, 2, 2, 245, 252, 5, 12, 7, 2, 246, 248, 7, 49,
+	2, 2, 247, 249, 7, 131, 2, 2, 248, 247, 3, 2, 2, 2, 248, 249, 3, 2, 2,
+	2, 249, 250, 3, 2, 2, 2, 250, 252, 5, 12, 7, 2, 251, 239, 3, 2, 2, 2, 251,
+	246, 3, 2, 2, 2, 252, 11, 3, 2, 2, 2, 253, 256, 5, 14, 8, 2, 254, 256,
+	5, 16, 9, 2, 255, 253, 3, 2, 2, 2, 255, 254, 3, 2, 2, 2, 256, 13, 3, 2,
+	2, 2, 257, 259, 5, 20, 11, 2, 258, 260, 7, 131, 2, 2, 259, 258, 3, 2, 2,
+	2, 259, 260, 3, 2, 2, 2, 260, 262, 3, 2, 2, 2, 261, 257, 3, 2, 2, 2, 262,
+	265, 3, 2, 2, 2, 263, 261, 3, 2, 2, 2, 263, 264, 3, 2, 2, 2, 264, 266,
+	3, 2, 2, 2, 265, 263, 3, 2, 2, 2, 266, 293, 5, 52, 27, 2, 267, 269, 5,
+	20, 11, 2, 268, 270, 7, 131, 2, 2, 269, 268, 3, 2, 2, 2, 269, 270, 3, 2,
+	2, 2, 270, 272, 3, 2, 2, 2, 271, 267, 3, 2, 2, 2, 272, 275, 3, 2, 2, 2,
+	273, 271, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 276, 3, 2, 2, 2, 275,
+	273, 3, 2, 2, 2, 276, 283, 5, 18, 10, 2, 277, 279, 7, 131, 2, 2, 278, 277,
+	3, 2, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 282, 5, 18,
+	10, 2, 281, 278, 3, 2, 2, 2, 282, 285, 3, 2, 2, 2, 283, 281, 3, 2, 2, 2,
+	283, 284, 3, 2, 2, 2, 284, 290, 3, 2, 2, 2, 285, 283, 3, 2, 2, 2, 286,
+	288, 7, 131, 2, 2, 287, 286, 3, 2, 2, 2, 287, 288, 3, 2, 2, 2, 288, 289,
+	3, 2, 2, 2, 289, 291, 5, 52, 27, 2, 290, 287, 3, 2, 2, 2, 290, 291, 3,
+	2, 2, 2, 291, 293, 3, 2, 2, 2, 292, 263, 3, 2, 2, 2, 292, 273, 3, 2, 2,
+	2, 293, 15, 3, 2, 2, 2, 294, 296, 5, 20, 11, 2, 295, 297, 7, 131, 2, 2,
+	296, 295, 3, 2, 2, 2, 296, 297, 3, 2, 2, 2, 297, 299, 3, 2, 2, 2, 298,
+	294, 3, 2, 2, 2, 299, 302, 3, 2, 2, 2, 300, 298, 3, 2, 2, 2, 300, 301,
+	3, 2, 2, 2, 301, 309, 3, 2, 2, 2, 302, 300, 3, 2, 2, 2, 303, 305, 5, 18,
+	10, 2, 304, 306, 7, 131, 2, 2, 305, 304, 3, 2, 2, 2, 305, 306, 3, 2, 2,
+	2, 306, 308, 3, 2, 2, 2, 307, 303, 3, 2, 2, 2, 308, 311, 3, 2, 2, 2, 309,
+	307, 3, 2, 2, 2, 309, 310, 3, 2, 2, 2, 310, 312, 3, 2, 2, 2, 311, 309,
+	3, 2, 2, 2, 312, 314, 5, 50, 26, 2, 313, 315, 7, 131, 2, 2, 314, 313, 3,
+	2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 317, 3, 2, 2, 2, 316, 300, 3, 2, 2,
+	2, 317, 318, 3, 2, 2, 2, 318, 316, 3, 2, 2, 2, 318, 319, 3, 2, 2, 2, 319,
+	320, 3, 2, 2, 2, 320, 321, 5, 14, 8, 2, 321, 17, 3, 2, 2, 2, 322, 328,
+	5, 30, 16, 2, 323, 328, 5, 26, 14, 2, 324, 328, 5, 36, 19, 2, 325, 328,
+	5, 32, 17, 2, 326, 328, 5, 38, 20, 2, 327, 322, 3, 2, 2, 2, 327, 323, 3,
+	2, 2, 2, 327, 324, 3, 2, 2, 2, 327, 325, 3, 2, 2, 2, 327, 326, 3, 2, 2,
+	2, 328, 19, 3, 2, 2, 2, 329, 333, 5, 22, 12, 2, 330, 333, 5, 24, 13, 2,
+	331, 333, 5, 42, 22, 2, 332, 329, 3, 2, 2, 2, 332, 330, 3, 2, 2, 2, 332,
+	331, 3, 2, 2, 2, 333, 21, 3, 2, 2, 2, 334, 335, 7, 51, 2, 2, 335, 337,
+	7, 131, 2, 2, 336, 334, 3, 2, 2, 2, 336, 337, 3, 2, 2, 2, 337, 338, 3,
+	2, 2, 2, 338, 340, 7, 52, 2, 2, 339, 341, 7, 131, 2, 2, 340, 339, 3, 2,
+	2, 2, 340, 341, 3, 2, 2, 2, 341, 342, 3, 2, 2, 2, 342, 347, 5, 70, 36,
+	2, 343, 345, 7, 131, 2, 2, 344, 343, 3, 2, 2, 2, 344, 345, 3, 2, 2, 2,
+	345, 346, 3, 2, 2, 2, 346, 348, 5, 68, 35, 2, 347, 344, 3, 2, 2, 2, 347,
+	348, 3, 2, 2, 2, 348, 23, 3, 2, 2, 2, 349, 351, 7, 53, 2, 2, 350, 352,
+	7, 131, 2, 2, 351, 350, 3, 2, 2, 2, 351, 352, 3, 2, 2, 2, 352, 353, 3,
+	2, 2, 2, 353, 354, 5, 102, 52, 2, 354, 355, 7, 131, 2, 2, 355, 356, 7,
+	54, 2, 2, 356, 357, 7, 131, 2, 2, 357, 358, 5, 180, 91, 2, 358, 25, 3,
+	2, 2, 2, 359, 361, 7, 55, 2, 2, 360, 362, 7, 131, 2, 2, 361, 360, 3, 2,
+	2, 2, 361, 362, 3, 2, 2, 2, 362, 363, 3, 2, 2, 2, 363, 368, 5, 72, 37,
+	2, 364, 365, 7, 131, 2, 2, 365, 367, 5, 28, 15, 2, 366, 364, 3, 2, 2, 2,
+	367, 370, 3, 2, 2, 2, 368, 366, 3, 2, 2, 2, 368, 369, 3, 2, 2, 2, 369,
+	27, 3, 2, 2, 2, 370, 368, 3, 2, 2, 2, 371, 372, 7, 56, 2, 2, 372, 373,
+	7, 131, 2, 2, 373, 374, 7, 52, 2, 2, 374, 375, 7, 131, 2, 2, 375, 382,
+	5, 32, 17, 2, 376, 377, 7, 56, 2, 2, 377, 378, 7, 131, 2, 2, 378, 379,
+	7, 57, 2, 2, 379, 380, 7, 131, 2, 2, 380, 382, 5, 32, 17, 2, 381, 371,
+	3, 2, 2, 2, 381, 376, 3, 2, 2, 2, 382, 29, 3, 2, 2, 2, 383, 385, 7, 57,
+	2, 2, 384, 386, 7, 131, 2, 2, 385, 384, 3, 2, 2, 2, 385, 386, 3, 2, 2,
+	2, 386, 387, 3, 2, 2, 2, 387, 388, 5, 70, 36, 2, 388, 31, 3, 2, 2, 2, 389,
+	391, 7, 58, 2, 2, 390, 392, 7, 131, 2, 2, 391, 390, 3, 2, 2, 2, 391, 392,
+	3, 2, 2, 2, 392, 393, 3, 2, 2, 2, 393, 404, 5, 34, 18, 2, 394, 396, 7,
+	131, 2, 2, 395, 394, 3, 2, 2, 2, 395, 396, 3, 2, 2, 2, 396, 397, 3, 2,
+	2, 2, 397, 399, 7, 4, 2, 2, 398, 400, 7, 131, 2, 2, 399, 398, 3, 2, 2,
+	2, 399, 400, 3, 2, 2, 2, 400, 401, 3, 2, 2, 2, 401, 403, 5, 34, 18, 2,
+	402, 395, 3, 2, 2, 2, 403, 406, 3, 2, 2, 2, 404, 402, 3, 2, 2, 2, 404,
+	405, 3, 2, 2, 2, 405, 33, 3, 2, 2, 2, 406, 404, 3, 2, 2, 2, 407, 409, 5,
+	188, 95, 2, 408, 410, 7, 131, 2, 2, 409, 408, 3, 2, 2, 2, 409, 410, 3,
+	2, 2, 2, 410, 411, 3, 2, 2, 2, 411, 413, 7, 5, 2, 2, 412, 414, 7, 131,
+	2, 2, 413, 412, 3, 2, 2, 2, 413, 414, 3, 2, 2, 2, 414, 415, 3, 2, 2, 2,
+	415, 416, 5, 102, 52, 2, 416, 444, 3, 2, 2, 2, 417, 419, 5, 180, 91, 2,
+	418, 420, 7, 131, 2, 2, 419, 418, 3, 2, 2, 2, 419, 420, 3, 2, 2, 2, 420,
+	421, 3, 2, 2, 2, 421, 423, 7, 5, 2, 2, 422, 424, 7, 131, 2, 2, 423, 422,
+	3, 2, 2, 2, 423, 424, 3, 2, 2, 2, 424, 425, 3, 2, 2, 2, 425, 426, 5, 102,
+	52, 2, 426, 444, 3, 2, 2, 2, 427, 429, 5, 180, 91, 2, 428, 430, 7, 131,
+	2, 2, 429, 428, 3, 2, 2, 2, 429, 430, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2,
+	431, 433, 7, 6, 2, 2, 432, 434, 7, 131, 2, 2, 433, 432, 3, 2, 2, 2, 433,
+	434, 3, 2, 2, 2, 434, 435, 3, 2, 2, 2, 435, 436, 5, 102, 52, 2, 436, 444,
+	3, 2, 2, 2, 437, 439, 5, 180, 91, 2, 438, 440, 7, 131, 2, 2, 439, 438,
+	3, 2, 2, 2, 439, 440, 3, 2, 2, 2, 440, 441, 3, 2, 2, 2, 441, 442, 5, 92,
+	47, 2, 442, 444, 3, 2, 2, 2, 443, 407, 3, 2, 2, 2, 443, 417, 3, 2, 2, 2,
+	443, 427, 3, 2, 2, 2, 443, 437, 3, 2, 2, 2, 444, 35, 3, 2, 2, 2, 445, 446,
+	7, 59, 2, 2, 446, 448, 7, 131, 2, 2, 447, 445, 3, 2, 2, 2, 447, 448, 3,
+	2, 2, 2, 448, 449, 3, 2, 2, 2, 449, 451, 7, 60, 2, 2, 450, 452, 7, 131,
+	2, 2, 451, 450, 3, 2, 2, 2, 451, 452, 3, 2, 2, 2, 452, 453, 3, 2, 2, 2,
+	453, 464, 5, 102, 52, 2, 454, 456, 7, 131, 2, 2, 455, 454, 3, 2, 2, 2,
+	455, 456, 3, 2, 2, 2, 456, 457, 3, 2, 2, 2, 457, 459, 7, 4, 2, 2, 458,
+	460, 7, 131, 2, 2, 459, 458, 3, 2, 2, 2, 459, 460, 3, 2, 2, 2, 460, 461,
+	3, 2, 2, 2, 461, 463, 5, 102, 52, 2, 462, 455, 3, 2, 2, 2, 463, 466, 3,
+	2, 2, 2, 464, 462, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465, 37, 3, 2, 2,
+	2, 466, 464, 3, 2, 2, 2, 467, 468, 7, 61, 2, 2, 468, 469, 7, 131, 2, 2,
+	469, 480, 5, 40, 21, 2, 470, 472, 7, 131, 2, 2, 471, 470, 3, 2, 2, 2, 471,
+	472, 3, 2, 2, 2, 472, 473, 3, 2, 2, 2, 473, 475, 7, 4, 2, 2, 474, 476,
+	7, 131, 2, 2, 475, 474, 3, 2, 2, 2, 475, 476, 3, 2, 2, 2, 476, 477, 3,
+	2, 2, 2, 477, 479, 5, 40, 21, 2, 478, 471, 3, 2, 2, 2, 479, 482, 3, 2,
+	2, 2, 480, 478, 3, 2, 2, 2, 480, 481, 3, 2, 2, 2, 481, 39, 3, 2, 2, 2,
+	482, 480, 3, 2, 2, 2, 483, 484, 5, 180, 91, 2, 484, 485, 5, 92, 47, 2,
+	485, 488, 3, 2, 2, 2, 486, 488, 5, 188, 95, 2, 487, 483, 3, 2, 2, 2, 487,
+	486, 3, 2, 2, 2, 488, 41, 3, 2, 2, 2, 489, 490, 7, 62, 2, 2, 490, 491,
+	7, 131, 2, 2, 491, 498, 5, 160, 81, 2, 492, 494, 7, 131, 2, 2, 493, 492,
+	3, 2, 2, 2, 493, 494, 3, 2, 2, 2, 494, 495, 3, 2, 2, 2, 495, 496, 7, 63,
+	2, 2, 496, 497, 7, 131, 2, 2, 497, 499, 5, 46, 24, 2, 498, 493, 3, 2, 2,
+	2, 498, 499, 3, 2, 2, 2, 499, 43, 3, 2, 2, 2, 500, 501, 7, 62, 2, 2, 501,
+	504, 7, 131, 2, 2, 502, 505, 5, 160, 81, 2, 503, 505, 5, 162, 82, 2, 504,
+	502, 3, 2, 2, 2, 504, 503, 3, 2, 2, 2, 505, 510, 3, 2, 2, 2, 506, 507,
+	7, 131, 2, 2, 507, 508, 7, 63, 2, 2, 508, 509, 7, 131, 2, 2, 509, 511,
+	5, 46, 24, 2, 510, 506, 3, 2, 2, 2, 510, 511, 3, 2, 2, 2, 511, 45, 3, 2,
+	2, 2, 512, 528, 7, 7, 2, 2, 513, 524, 5, 48, 25, 2, 514, 516, 7, 131, 2,
+	2, 515, 514, 3, 2, 2, 2, 515, 516, 3, 2, 2, 2, 516, 517, 3, 2, 2, 2, 517,
+	519, 7, 4, 2, 2, 518, 520, 7, 131, 2, 2, 519, 518, 3, 2, 2, 2, 519, 520,
+	3, 2, 2, 2, 520, 521, 3, 2, 2, 2, 521, 523, 5, 48, 25, 2, 522, 515, 3,
+	2, 2, 2, 523, 526, 3, 2, 2, 2, 524, 522, 3, 2, 2, 2, 524, 525, 3, 2, 2,
+	2, 525, 528, 3, 2, 2, 2, 526, 524, 3, 2, 2, 2, 527, 512, 3, 2, 2, 2, 527,
+	513, 3, 2, 2, 2, 528, 533, 3, 2, 2, 2, 529, 531, 7, 131, 2, 2, 530, 529,
+	3, 2, 2, 2, 530, 531, 3, 2, 2, 2, 531, 532, 3, 2, 2, 2, 532, 534, 5, 68,
+	35, 2, 533, 530, 3, 2, 2, 2, 533, 534, 3, 2, 2, 2, 534, 47, 3, 2, 2, 2,
+	535, 536, 5, 164, 83, 2, 536, 537, 7, 131, 2, 2, 537, 538, 7, 54, 2, 2,
+	538, 539, 7, 131, 2, 2, 539, 541, 3, 2, 2, 2, 540, 535, 3, 2, 2, 2, 540,
+	541, 3, 2, 2, 2, 541, 542, 3, 2, 2, 2, 542, 543, 5, 180, 91, 2, 543, 49,
+	3, 2, 2, 2, 544, 545, 7, 64, 2, 2, 545, 550, 5, 54, 28, 2, 546, 548, 7,
+	131, 2, 2, 547, 546, 3, 2, 2, 2, 547, 548, 3, 2, 2, 2, 548, 549, 3, 2,
+	2, 2, 549, 551, 5, 68, 35, 2, 550, 547, 3, 2, 2, 2, 550, 551, 3, 2, 2,
+	2, 551, 51, 3, 2, 2, 2, 552, 553, 7, 65, 2, 2, 553, 554, 5, 54, 28, 2,
+	554, 53, 3, 2, 2, 2, 555, 557, 7, 131, 2, 2, 556, 555, 3, 2, 2, 2, 556,
+	557, 3, 2, 2, 2, 557, 558, 3, 2, 2, 2, 558, 560, 7, 66, 2, 2, 559, 556,
+	3, 2, 2, 2, 559, 560, 3, 2, 2, 2, 560, 561, 3, 2, 2, 2, 561, 562, 7, 131,
+	2, 2, 562, 565, 5, 56, 29, 2, 563, 564, 7, 131, 2, 2, 564, 566, 5, 60,
+	31, 2, 565, 563, 3, 2, 2, 2, 565, 566, 3, 2, 2, 2, 566, 569, 3, 2, 2, 2,
+	567, 568, 7, 131, 2, 2, 568, 570, 5, 62, 32, 2, 569, 567, 3, 2, 2, 2, 569,
+	570, 3, 2, 2, 2, 570, 573, 3, 2, 2, 2, 571, 572, 7, 131, 2, 2, 572, 574,
+	5, 64, 33, 2, 573, 571, 3, 2, 2, 2, 573, 574, 3, 2, 2, 2, 574, 55, 3, 2,
+	2, 2, 575, 586, 7, 7, 2, 2, 576, 578, 7, 131, 2, 2, 577, 576, 3, 2, 2,
+	2, 577, 578, 3, 2, 2, 2, 578, 579, 3, 2, 2, 2, 579, 581, 7, 4, 2, 2, 580,
+	582, 7, 131, 2, 2, 581, 580, 3, 2, 2, 2, 581, 582, 3, 2, 2, 2, 582, 583,
+	3, 2, 2, 2, 583, 585, 5, 58, 30, 2, 584, 577, 3, 2, 2, 2, 585, 588, 3,
+	2, 2, 2, 586, 584, 3, 2, 2, 2, 586, 587, 3, 2, 2, 2, 587, 604, 3, 2, 2,
+	2, 588, 586, 3, 2, 2, 2, 589, 600, 5, 58, 30, 2, 590, 592, 7, 131, 2, 2,
+	591, 590, 3, 2, 2, 2, 591, 592, 3, 2, 2, 2, 592, 593, 3, 2, 2, 2, 593,
+	595, 7, 4, 2, 2, 594, 596, 7, 131, 2, 2, 595, 594, 3, 2, 2, 2, 595, 596,
+	3, 2, 2, 2, 596, 597, 3, 2, 2, 2, 597, 599, 5, 58, 30, 2, 598, 591, 3,
+	2, 2, 2, 599, 602, 3, 2, 2, 2, 600, 598, 3, 2, 2, 2, 600, 601, 3, 2, 2,
+	2, 601, 604, 3, 2, 2, 2, 602, 600, 3, 2, 2, 2, 603, 575, 3, 2, 2, 2, 603,
+	589, 3, 2, 2, 2, 604, 57, 3, 2, 2, 2, 605, 606, 5, 102, 52, 2, 606, 607,
+	7, 131, 2, 2, 607, 608, 7, 54, 2, 2, 608, 609, 7, 131, 2, 2, 609, 610,
+	5, 180, 91, 2, 610, 613, 3, 2, 2, 2, 611, 613, 5, 102, 52, 2, 612, 605,
+	3, 2, 2, 2, 612, 611, 3, 2, 2, 2, 613, 59, 3, 2, 2, 2, 614, 615, 7, 67,
+	2, 2, 615, 616, 7, 131, 2, 2, 616, 617, 7, 68, 2, 2, 617, 618, 7, 131,
+	2, 2, 618, 626, 5, 66, 34, 2, 619, 621, 7, 4, 2, 2, 620, 622, 7, 131, 2,
+	2, 621, 620, 3, 2, 2, 2, 621, 622, 3, 2, 2, 2, 622, 623, 3, 2, 2, 2, 623,
+	625, 5, 66, 34, 2, 624, 619, 3, 2, 2, 2, 625, 628, 3, 2, 2, 2, 626, 624,
+	3, 2, 2, 2, 626, 627, 3, 2, 2, 2, 627, 61, 3, 2, 2, 2, 628, 626, 3, 2,
+	2, 2, 629, 630, 7, 69, 2, 2, 630, 631, 7, 131, 2, 2, 631, 632, 5, 102,
+	52, 2, 632, 63, 3, 2, 2, 2, 633, 634, 7, 70, 2, 2, 634, 635, 7, 131, 2,
+	2, 635, 636, 5, 102, 52, 2, 636, 65, 3, 2, 2, 2, 637, 642, 5, 102, 52,
+	2, 638, 640, 7, 131, 2, 2, 639, 638, 3, 2, 2, 2, 639, 640, 3, 2, 2, 2,
+	640, 641, 3, 2, 2, 2, 641, 643, 9, 2, 2, 2, 642, 639, 3, 2, 2, 2, 642,
+	643, 3, 2, 2, 2, 643, 67, 3, 2, 2, 2, 644, 645, 7, 75, 2, 2, 645, 646,
+	7, 131, 2, 2, 646, 647, 5, 102, 52, 2, 647, 69, 3, 2, 2, 2, 648, 659, 5,
+	72, 37, 2, 649, 651, 7, 131, 2, 2, 650, 649, 3, 2, 2, 2, 650, 651, 3, 2,
+	2, 2, 651, 652, 3, 2, 2, 2, 652, 654, 7, 4, 2, 2, 653, 655, 7, 131, 2,
+	2, 654, 653, 3, 2, 2, 2, 654, 655, 3, 2, 2, 2, 655, 656, 3, 2, 2, 2, 656,
+	658, 5, 72, 37, 2, 657, 650, 3, 2, 2, 2, 658, 661, 3, 2, 2, 2, 659, 657,
+	3, 2, 2, 2, 659, 660, 3, 2, 2, 2, 660, 71, 3, 2, 2, 2, 661, 659, 3, 2,
+	2, 2, 662, 664, 5, 180, 91, 2, 663, 665, 7, 131, 2, 2, 664, 663, 3, 2,
+	2, 2, 664, 665, 3, 2, 2, 2, 665, 666, 3, 2, 2, 2, 666, 668, 7, 5, 2, 2,
+	667, 669, 7, 131, 2, 2, 668, 667, 3, 2, 2, 2, 668, 669, 3, 2, 2, 2, 669,
+	670, 3, 2, 2, 2, 670, 671, 5, 74, 38, 2, 671, 674, 3, 2, 2, 2, 672, 674,
+	5, 74, 38, 2, 673, 662, 3, 2, 2, 2, 673, 672, 3, 2, 2, 2, 674, 73, 3, 2,
+	2, 2, 675, 678, 5, 76, 39, 2, 676, 678, 5, 78, 40, 2, 677, 675, 3, 2, 2,
+	2, 677, 676, 3, 2, 2, 2, 678, 75, 3, 2, 2, 2, 679, 680, 7, 76, 2, 2, 680,
+	681, 7, 8, 2, 2, 681, 682, 5, 78, 40, 2, 682, 683, 7, 9, 2, 2, 683, 690,
+	3, 2, 2, 2, 684, 685, 7, 77, 2, 2, 685, 686, 7, 8, 2, 2, 686, 687, 5, 78,
+	40, 2, 687, 688, 7, 9, 2, 2, 688, 690, 3, 2, 2, 2, 689, 679, 3, 2, 2, 2,
+	689, 684, 3, 2, 2, 2, 690, 77, 3, 2, 2, 2, 691, 698, 5, 80, 41, 2, 692,
+	694, 7, 131, 2, 2, 693, 692, 3, 2, 2, 2, 693, 694, 3, 2, 2, 2, 694, 695,
+	3, 2, 2, 2, 695, 697, 5, 82, 42, 2, 696, 693, 3, 2, 2, 2, 697, 700, 3,
+	2, 2, 2, 698, 696, 3, 2, 2, 2, 698, 699, 3, 2, 2, 2, 699, 706, 3, 2, 2,
+	2, 700, 698, 3, 2, 2, 2, 701, 702, 7, 8, 2, 2, 702, 703, 5, 78, 40, 2,
+	703, 704, 7, 9, 2, 2, 704, 706, 3, 2, 2, 2, 705, 691, 3, 2, 2, 2, 705,
+	701, 3, 2, 2, 2, 706, 79, 3, 2, 2, 2, 707, 709, 7, 8, 2, 2, 708, 710, 7,
+	131, 2, 2, 709, 708, 3, 2, 2, 2, 709, 710, 3, 2, 2, 2, 710, 715, 3, 2,
+	2, 2, 711, 713, 5, 180, 91, 2, 712, 714, 7, 131, 2, 2, 713, 712, 3, 2,
+	2, 2, 713, 714, 3, 2, 2, 2, 714, 716, 3, 2, 2, 2, 715, 711, 3, 2, 2, 2,
+	715, 716, 3, 2, 2, 2, 716, 721, 3, 2, 2, 2, 717, 719, 5, 92, 47, 2, 718,
+	720, 7, 131, 2, 2, 719, 718, 3, 2, 2, 2, 719, 720, 3, 2, 2, 2, 720, 722,
+	3, 2, 2, 2, 721, 717, 3, 2, 2, 2, 721, 722, 3, 2, 2, 2, 722, 727, 3, 2,
+	2, 2, 723, 725, 5, 88, 45, 2, 724, 726, 7, 131, 2, 2, 725, 724, 3, 2, 2,
+	2, 725, 726, 3, 2, 2, 2, 726, 728, 3, 2, 2, 2, 727, 723, 3, 2, 2, 2, 727,
+	728, 3, 2, 2, 2, 728, 729, 3, 2, 2, 2, 729, 730, 7, 9, 2, 2, 730, 81, 3,
+	2, 2, 2, 731, 733, 5, 84, 43, 2, 732, 734, 7, 131, 2, 2, 733, 732, 3, 2,
+	2, 2, 733, 734, 3, 2, 2, 2, 734, 735, 3, 2, 2, 2, 735, 736, 5, 80, 41,
+	2, 736, 83, 3, 2, 2, 2, 737, 739, 5, 202, 102, 2, 738, 740, 7, 131, 2,
+	2, 739, 738, 3, 2, 2, 2, 739, 740, 3, 2, 2, 2, 740, 741, 3, 2, 2, 2, 741,
+	743, 5, 206, 104, 2, 742, 744, 7, 131, 2, 2, 743, 742, 3, 2, 2, 2, 743,
+	744, 3, 2, 2, 2, 744, 746, 3, 2, 2, 2, 745, 747, 5, 86, 44, 2, 746, 745,
+	3, 2, 2, 2, 746, 747, 3, 2, 2, 2, 747, 749, 3, 2, 2, 2, 748, 750, 7, 131,
+	2, 2, 749, 748, 3, 2, 2, 2, 749, 750, 3, 2, 2, 2, 750, 751, 3, 2, 2, 2,
+	751, 753, 5, 206, 104, 2, 752, 754, 7, 131, 2, 2, 753, 752, 3, 2, 2, 2,
+	753, 754, 3, 2, 2, 2, 754, 755, 3, 2, 2, 2, 755, 756, 5, 204, 103, 2, 756,
+	802, 3, 2, 2, 2, 757, 759, 5, 202, 102, 2, 758, 760, 7, 131, 2, 2, 759,
 	758, 3, 2, 2, 2, 759, 760, 3, 2, 2, 2, 760, 761, 3, 2, 2, 2, 761, 763,
-	5, 198, 100, 2, 762, 764, 7, 127, 2, 2, 763, 762, 3, 2, 2, 2, 763, 764,
-	3, 2, 2, 2, 764, 765, 3, 2, 2, 2, 765, 766, 5, 196, 99, 2, 766, 780, 3,
-	2, 2, 2, 767, 769, 5, 198, 100, 2, 768, 770, 7, 127, 2, 2, 769, 768, 3,
-	2, 2, 2, 769, 770, 3, 2, 2, 2, 770, 772, 3, 2, 2, 2, 771, 773, 5, 84, 43,
-	2, 772, 771, 3, 2, 2, 2, 772, 773, 3, 2, 2, 2, 773, 775, 3, 2, 2, 2, 774,
-	776, 7, 127, 2, 2, 775, 774, 3, 2, 2, 2, 775, 776, 3, 2, 2, 2, 776, 777,
-	3, 2, 2, 2, 777, 778, 5, 198, 100, 2, 778, 780, 3, 2, 2, 2, 779, 715, 3,
-	2, 2, 2, 779, 735, 3, 2, 2, 2, 779, 751, 3, 2, 2, 2, 779, 767, 3, 2, 2,
-	2, 780, 83, 3, 2, 2, 2, 781, 783, 7, 10, 2, 2, 782, 784, 7, 127, 2, 2,
-	783, 782, 3, 2, 2, 2, 783, 784, 3, 2, 2, 2, 784, 789, 3, 2, 2, 2, 785,
-	787, 5, 172, 87, 2, 786, 788, 7, 127, 2, 2, 787, 786, 3, 2, 2, 2, 787,
-	788, 3, 2, 2, 2, 788, 790, 3, 2, 2, 2, 789, 785, 3, 2, 2, 2, 789, 790,
-	3, 2, 2, 2, 790, 795, 3, 2, 2, 2, 791, 793, 5, 88, 45, 2, 792, 794, 7,
-	127, 2, 2, 793, 792, 3, 2, 2, 2, 793, 794, 3, 2, 2, 2, 794, 796, 3, 2,
-	2, 2, 795, 791, 3, 2, 2, 2, 795, 796, 3, 2, 2, 2, 796, 798, 3, 2, 2, 2,
-	797, 799, 5, 94, 48, 2, 798, 797, 3, 2, 2, 2, 798, 799, 3, 2, 2, 2, 799,
-	804, 3, 2, 2, 2, 800, 802, 5, 86, 44, 2, 801, 803, 7, 127, 2, 2, 802, 801,
-	3, 2, 2, 2, 802, 803, 3, 2, 2, 2, 803, 805, 3, 2, 2, 2, 804, 800, 3, 2,
-	2, 2, 804, 805, 3, 2, 2, 2, 805, 806, 3, 2, 2, 2, 806, 807, 7, 11, 2, 2,
-	807, 85, 3, 2, 2, 2, 808, 811, 5, 176, 89, 2, 809, 811, 5, 178, 90, 2,
-	810, 808, 3, 2, 2, 2, 810, 809, 3, 2, 2, 2, 811, 87, 3, 2, 2, 2, 812, 814,
-	7, 12, 2, 2, 813, 815, 7, 127, 2, 2, 814, 813, 3, 2, 2, 2, 814, 815, 3,
-	2, 2, 2, 815, 816, 3, 2, 2, 2, 816, 830, 5, 98, 50, 2, 817, 819, 7, 127,
-	2, 2, 818, 817, 3, 2, 2, 2, 818, 819, 3, 2, 2, 2, 819, 820, 3, 2, 2, 2,
-	820, 822, 7, 13, 2, 2, 821, 823, 7, 12, 2, 2, 822, 821, 3, 2, 2, 2, 822,
-	823, 3, 2, 2, 2, 823, 825, 3, 2, 2, 2, 824, 826, 7, 127, 2, 2, 825, 824,
-	3, 2, 2, 2, 825, 826, 3, 2, 2, 2, 826, 827, 3, 2, 2, 2, 827, 829, 5, 98,
-	50, 2, 828, 818, 3, 2, 2, 2, 829, 832, 3, 2, 2, 2, 830, 828, 3, 2, 2, 2,
-	830, 831, 3, 2, 2, 2, 831, 89, 3, 2, 2, 2, 832, 830, 3, 2, 2, 2, 833, 840,
-	5, 92, 47, 2, 834, 836, 7, 127, 2, 2, 835, 834, 3, 2, 2, 2, 835, 836, 3,
-	2, 2, 2, 836, 837, 3, 2, 2, 2, 837, 839, 5, 92, 47, 2, 838, 835, 3, 2,
-	2, 2, 839, 842, 3, 2, 2, 2, 840, 838, 3, 2, 2, 2, 840, 841, 3, 2, 2, 2,
-	841, 91, 3, 2, 2, 2, 842, 840, 3, 2, 2, 2, 843, 845, 7, 12, 2, 2, 844,
-	846, 7, 127, 2, 2, 845, 844, 3, 2, 2, 2, 845, 846, 3, 2, 2, 2, 846, 847,
-	3, 2, 2, 2, 847, 848, 5, 96, 49, 2, 848, 93, 3, 2, 2, 2, 849, 851, 7, 7,
-	2, 2, 850, 852, 7, 127, 2, 2, 851, 850, 3, 2, 2, 2, 851, 852, 3, 2, 2,
-	2, 852, 857, 3, 2, 2, 2, 853, 855, 5, 184, 93, 2, 854, 856, 7, 127, 2,
-	2, 855, 854, 3, 2, 2, 2, 855, 856, 3, 2, 2, 2, 856, 858, 3, 2, 2, 2, 857,
-	853, 3, 2, 2, 2, 857, 858, 3, 2, 2, 2, 858, 869, 3, 2, 2, 2, 859, 861,
-	7, 14, 2, 2, 860, 862, 7, 127, 2, 2, 861, 860, 3, 2, 2, 2, 861, 862, 3,
-	2, 2, 2, 862, 867, 3, 2, 2, 2, 863, 865, 5, 184, 93, 2, 864, 866, 7, 127,
-	2, 2, 865, 864, 3, 2, 2, 2, 865, 866, 3, 2, 2, 2, 866, 868, 3, 2, 2, 2,
-	867, 863, 3, 2, 2, 2, 867, 868, 3, 2, 2, 2, 868, 870, 3, 2, 2, 2, 869,
-	859, 3, 2, 2, 2, 869, 870, 3, 2, 2, 2, 870, 95, 3, 2, 2, 2, 871, 872, 5,
-	188, 95, 2, 872, 97, 3, 2, 2, 2, 873, 874, 5, 188, 95, 2, 874, 99, 3, 2,
-	2, 2, 875, 876, 5, 102, 52, 2, 876, 101, 3, 2, 2, 2, 877, 884, 5, 104,
-	53, 2, 878, 879, 7, 127, 2, 2, 879, 880, 7, 75, 2, 2, 880, 881, 7, 127,
-	2, 2, 881, 883, 5, 104, 53, 2, 882, 878, 3, 2, 2, 2, 883, 886, 3, 2, 2,
-	2, 884, 882, 3, 2, 2, 2, 884, 885, 3, 2, 2, 2, 885, 103, 3, 2, 2, 2, 886,
-	884, 3, 2, 2, 2, 887, 894, 5, 106, 54, 2, 888, 889, 7, 127, 2, 2, 889,
-	890, 7, 76, 2, 2, 890, 891, 7, 127, 2, 2, 891, 893, 5, 106, 54, 2, 892,
-	888, 3, 2, 2, 2, 893, 896, 3, 2, 2, 2, 894, 892, 3, 2, 2, 2, 894, 895,
-	3, 2, 2, 2, 895, 105, 3, 2, 2, 2, 896, 894, 3, 2, 2, 2, 897, 904, 5, 108,
-	55, 2, 898, 899, 7, 127, 2, 2, 899, 900, 7, 77, 2, 2, 900, 901, 7, 127,
-	2, 2, 901, 903, 5, 108, 55, 2, 902, 898, 3, 2, 2, 2, 903, 906, 3, 2, 2,
-	2, 904, 902, 3, 2, 2, 2, 904, 905, 3, 2, 2, 2, 905, 107, 3, 2, 2, 2, 906,
-	904, 3, 2, 2, 2, 907, 909, 7, 78, 2, 2, 908, 910, 7, 127, 2, 2, 909, 908,
-	3, 2, 2, 2, 909, 910, 3, 2, 2, 2, 910, 912, 3, 2, 2, 2, 911, 907, 3, 2,
-	2, 2, 912, 915, 3, 2, 2, 2, 913, 911, 3, 2, 2, 2, 913, 914, 3, 2, 2, 2,
-	914, 916, 3, 2, 2, 2, 915, 913, 3, 2, 2, 2, 916, 917, 5, 110, 56, 2, 917,
-	109, 3, 2, 2, 2, 918, 925, 5, 112, 57, 2, 919, 921, 7, 127, 2, 2, 920,
-	919, 3, 2, 2, 2, 920, 921, 3, 2, 2, 2, 921, 922, 3, 2, 2, 2, 922, 924,
-	5, 138, 70, 2, 923, 920, 3, 2, 2, 2, 924, 927, 3, 2, 2, 2, 925, 923, 3,
-	2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 111, 3, 2, 2, 2, 927, 925, 3, 2, 2,
-	2, 928, 947, 5, 114, 58, 2, 929, 931, 7, 127, 2, 2, 930, 929, 3, 2, 2,
-	2, 930, 931, 3, 2, 2, 2, 931, 932, 3, 2, 2, 2, 932, 934, 7, 15, 2, 2, 933,
-	935, 7, 127, 2, 2, 934, 933, 3, 2, 2, 2, 934, 935, 3, 2, 2, 2, 935, 936,
-	3, 2, 2, 2, 936, 946, 5, 114, 58, 2, 937, 939, 7, 127, 2, 2, 938, 937,
-	3, 2, 2, 2, 938, 939, 3, 2, 2, 2, 939, 940, 3, 2, 2, 2, 940, 942, 7, 16,
-	2, 2, 941, 943, 7, 127, 2, 2, 942, 941, 3, 2, 2, 2, 942, 943, 3, 2, 2,
-	2, 943, 944, 3, 2, 2, 2, 944, 946, 5, 114, 58, 2, 945, 930, 3, 2, 2, 2,
-	945, 938, 3, 2, 2, 2, 946, 949, 3, 2, 2, 2, 947, 945, 3, 2, 2, 2, 947,
-	948, 3, 2, 2, 2, 948, 113, 3, 2, 2, 2, 949, 947, 3, 2, 2, 2, 950, 977,
-	5, 116, 59, 2, 951, 953, 7, 127, 2, 2, 952, 951, 3, 2, 2, 2, 952, 953,
-	3, 2, 2, 2, 953, 954, 3, 2, 2, 2, 954, 956, 7, 7, 2, 2, 955, 957, 7, 127,
-	2, 2, 956, 955, 3, 2, 2, 2, 956, 957, 3, 2, 2, 2, 957, 958, 3, 2, 2, 2,
-	958, 976, 5, 116, 59, 2, 959, 961, 7, 127, 2, 2, 960, 959, 3, 2, 2, 2,
-	960, 961, 3, 2, 2, 2, 961, 962, 3, 2, 2, 2, 962, 964, 7, 17, 2, 2, 963,
-	965, 7, 127, 2, 2, 964, 963, 3, 2, 2, 2, 964, 965, 3, 2, 2, 2, 965, 966,
-	3, 2, 2, 2, 966, 976, 5, 116, 59, 2, 967, 969, 7, 127, 2, 2, 968, 967,
-	3, 2, 2, 2, 968, 969, 3, 2, 2, 2, 969, 970, 3, 2, 2, 2, 970, 972, 7, 18,
-	2, 2, 971, 973, 7, 127, 2, 2, 972, 971, 3, 2, 2, 2, 972, 973, 3, 2, 2,
-	2, 973, 974, 3, 2, 2, 2, 974, 976, 5, 116, 59, 2, 975, 952, 3, 2, 2, 2,
-	975, 960, 3, 2, 2, 2, 975, 968, 3, 2, 2, 2, 976, 979, 3, 2, 2, 2, 977,
-	975, 3, 2, 2, 2, 977, 978, 3, 2, 2, 2, 978, 115, 3, 2, 2, 2, 979, 977,
-	3, 2, 2, 2, 980, 991, 5, 118, 60, 2, 981, 983, 7, 127, 2, 2, 982, 981,
-	3, 2, 2, 2, 982, 983, 3, 2, 2, 2, 983, 984, 3, 2, 2, 2, 984, 986, 7, 19,
-	2, 2, 985, 987, 7, 127, 2, 2, 986, 985, 3, 2, 2, 2, 986, 987, 3, 2, 2,
-	2, 987, 988, 3, 2, 2, 2, 988, 990, 5, 118, 60, 2, 989, 982, 3, 2, 2, 2,
-	990, 993, 3, 2, 2, 2, 991, 989, 3, 2, 2, 2, 991, 992, 3, 2, 2, 2, 992,
-	117, 3, 2, 2, 2, 993, 991, 3, 2, 2, 2, 994, 996, 9, 3, 2, 2, 995, 997,
-	7, 127, 2, 2, 996, 995, 3, 2, 2, 2, 996, 997, 3, 2, 2, 2, 997, 999, 3,
-	2, 2, 2, 998, 994, 3, 2, 2, 2, 999, 1002, 3, 2, 2, 2, 1000, 998, 3, 2,
-	2, 2, 1000, 1001, 3, 2, 2, 2, 1001, 1003, 3, 2, 2, 2, 1002, 1000, 3, 2,
-	2, 2, 1003, 1004, 5, 120, 61, 2, 1004, 119, 3, 2, 2, 2, 1005, 1011, 5,
-	128, 65, 2, 1006, 1010, 5, 124, 63, 2, 1007, 1010, 5, 122, 62, 2, 1008,
-	1010, 5, 126, 64, 2, 1009, 1006, 3, 2, 2, 2, 1009, 1007, 3, 2, 2, 2, 1009,
-	1008, 3, 2, 2, 2, 1010, 1013, 3, 2, 2, 2, 1011, 1009, 3, 2, 2, 2, 1011,
-	1012, 3, 2, 2, 2, 1012, 121, 3, 2, 2, 2, 1013, 1011, 3, 2, 2, 2, 1014,
-	1015, 7, 127, 2, 2, 1015, 1017, 7, 79, 2, 2, 1016, 1018, 7, 127, 2, 2,
-	1017, 1016, 3, 2, 2, 2, 1017, 1018, 3, 2, 2, 2, 1018, 1019, 3, 2, 2, 2,
-	1019, 1040, 5, 128, 65, 2, 1020, 1022, 7, 127, 2, 2, 1021, 1020, 3, 2,
-	2, 2, 1021, 1022, 3, 2, 2, 2, 1022, 1023, 3, 2, 2, 2, 1023, 1024, 7, 10,
-	2, 2, 1024, 1025, 5, 100, 51, 2, 1025, 1026, 7, 11, 2, 2, 1026, 1040, 3,
-	2, 2, 2, 1027, 1029, 7, 127, 2, 2, 1028, 1027, 3, 2, 2, 2, 1028, 1029,
-	3, 2, 2, 2, 1029, 1030, 3, 2, 2, 2, 1030, 1032, 7, 10, 2, 2, 1031, 1033,
-	5, 100, 51, 2, 1032, 1031, 3, 2, 2, 2, 1032, 1033, 3, 2, 2, 2, 1033, 1034,
-	3, 2, 2, 2, 1034, 1036, 7, 14, 2, 2, 1035, 1037, 5, 100, 51, 2, 1036, 1035,
-	3, 2, 2, 2, 1036, 1037, 3, 2, 2, 2, 1037, 1038, 3, 2, 2, 2, 1038, 1040,
-	7, 11, 2, 2, 1039, 1014, 3, 2, 2, 2, 1039, 1021, 3, 2, 2, 2, 1039, 1028,
-	3, 2, 2, 2, 1040, 123, 3, 2, 2, 2, 1041, 1042, 7, 127, 2, 2, 1042, 1043,
-	7, 80, 2, 2, 1043, 1044, 7, 127, 2, 2, 1044, 1052, 7, 63, 2, 2, 1045, 1046,
-	7, 127, 2, 2, 1046, 1047, 7, 81, 2, 2, 1047, 1048, 7, 127, 2, 2, 1048,
-	1052, 7, 63, 2, 2, 1049, 1050, 7, 127, 2, 2, 1050, 1052, 7, 82, 2, 2, 1051,
-	1041, 3, 2, 2, 2, 1051, 1045, 3, 2, 2, 2, 1051, 1049, 3, 2, 2, 2, 1052,
-	1054, 3, 2, 2, 2, 1053, 1055, 7, 127, 2, 2, 1054, 1053, 3, 2, 2, 2, 1054,
-	1055, 3, 2, 2, 2, 1055, 1056, 3, 2, 2, 2, 1056, 1057, 5, 128, 65, 2, 1057,
-	125, 3, 2, 2, 2, 1058, 1059, 7, 127, 2, 2, 1059, 1060, 7, 83, 2, 2, 1060,
-	1061, 7, 127, 2, 2, 1061, 1069, 7, 84, 2, 2, 1062, 1063, 7, 127, 2, 2,
-	1063, 1064, 7, 83, 2, 2, 1064, 1065, 7, 127, 2, 2, 1065, 1066, 7, 78, 2,
-	2, 1066, 1067, 7, 127, 2, 2, 1067, 1069, 7, 84, 2, 2, 1068, 1058, 3, 2,
-	2, 2, 1068, 1062, 3, 2, 2, 2, 1069, 127, 3, 2, 2, 2, 1070, 1077, 5, 130,
-	66, 2, 1071, 1073, 7, 127, 2, 2, 1072, 1071, 3, 2, 2, 2, 1072, 1073, 3,
-	2, 2, 2, 1073, 1074, 3, 2, 2, 2, 1074, 1076, 5, 166, 84, 2, 1075, 1072,
-	3, 2, 2, 2, 1076, 1079, 3, 2, 2, 2, 1077, 1075, 3, 2, 2, 2, 1077, 1078,
-	3, 2, 2, 2, 1078, 1084, 3, 2, 2, 2, 1079, 1077, 3, 2, 2, 2, 1080, 1082,
-	7, 127, 2, 2, 1081, 1080, 3, 2, 2, 2, 1081, 1082, 3, 2, 2, 2, 1082, 1083,
-	3, 2, 2, 2, 1083, 1085, 5, 90, 46, 2, 1084, 1081, 3, 2, 2, 2, 1084, 1085,
-	3, 2, 2, 2, 1085, 129, 3, 2, 2, 2, 1086, 1165, 5, 132, 67, 2, 1087, 1165,
-	5, 178, 90, 2, 1088, 1165, 5, 168, 85, 2, 1089, 1091, 7, 85, 2, 2, 1090,
-	1092, 7, 127, 2, 2, 1091, 1090, 3, 2, 2, 2, 1091, 1092, 3, 2, 2, 2, 1092,
-	1093, 3, 2, 2, 2, 1093, 1095, 7, 8, 2, 2, 1094, 1096, 7, 127, 2, 2, 1095,
-	1094, 3, 2, 2, 2, 1095, 1096, 3, 2, 2, 2, 1096, 1097, 3, 2, 2, 2, 1097,
-	1099, 7, 7, 2, 2, 1098, 1100, 7, 127, 2, 2, 1099, 1098, 3, 2, 2, 2, 1099,
-	1100, 3, 2, 2, 2, 1100, 1101, 3, 2, 2, 2, 1101, 1165, 7, 9, 2, 2, 1102,
-	1165, 5, 162, 82, 2, 1103, 1165, 5, 164, 83, 2, 1104, 1106, 7, 49, 2, 2,
-	1105, 1107, 7, 127, 2, 2, 1106, 1105, 3, 2, 2, 2, 1106, 1107, 3, 2, 2,
-	2, 1107, 1108, 3, 2, 2, 2, 1108, 1110, 7, 8, 2, 2, 1109, 1111, 7, 127,
-	2, 2, 1110, 1109, 3, 2, 2, 2, 1110, 1111, 3, 2, 2, 2, 1111, 1112, 3, 2,
-	2, 2, 1112, 1114, 5, 144, 73, 2, 1113, 1115, 7, 127, 2, 2, 1114, 1113,
-	3, 2, 2, 2, 1114, 1115, 3, 2, 2, 2, 1115, 1116, 3, 2, 2, 2, 1116, 1117,
-	7, 9, 2, 2, 1117, 1165, 3, 2, 2, 2, 1118, 1120, 7, 86, 2, 2, 1119, 1121,
-	7, 127, 2, 2, 1120, 1119, 3, 2, 2, 2, 1120, 1121, 3, 2, 2, 2, 1121, 1122,
-	3, 2, 2, 2, 1122, 1124, 7, 8, 2, 2, 1123, 1125, 7, 127, 2, 2, 1124, 1123,
-	3, 2, 2, 2, 1124, 1125, 3, 2, 2, 2, 1125, 1126, 3, 2, 2, 2, 1126, 1128,
-	5, 144, 73, 2, 1127, 1129, 7, 127, 2, 2, 1128, 1127, 3, 2, 2, 2, 1128,
-	1129, 3, 2, 2, 2, 1129, 1130, 3, 2, 2, 2, 1130, 1131, 7, 9, 2, 2, 1131,
-	1165, 3, 2, 2, 2, 1132, 1134, 7, 87, 2, 2, 1133, 1135, 7, 127, 2, 2, 1134,
-	1133, 3, 2, 2, 2, 1134, 1135, 3, 2, 2, 2, 1135, 1136, 3, 2, 2, 2, 1136,
-	1138, 7, 8, 2, 2, 1137, 1139, 7, 127, 2, 2, 1138, 1137, 3, 2, 2, 2, 1138,
-	1139, 3, 2, 2, 2, 1139, 1140, 3, 2, 2, 2, 1140, 1142, 5, 144, 73, 2, 1141,
-	1143, 7, 127, 2, 2, 1142, 1141, 3, 2, 2, 2, 1142, 1143, 3, 2, 2, 2, 1143,
-	1144, 3, 2, 2, 2, 1144, 1145, 7, 9, 2, 2, 1145, 1165, 3, 2, 2, 2, 1146,
-	1148, 7, 88, 2, 2, 1147, 1149, 7, 127, 2, 2, 1148, 1147, 3, 2, 2, 2, 1148,
-	1149, 3, 2, 2, 2, 1149, 1150, 3, 2, 2, 2, 1150, 1152, 7, 8, 2, 2, 1151,
-	1153, 7, 127, 2, 2, 1152, 1151, 3, 2, 2, 2, 1152, 1153, 3, 2, 2, 2, 1153,
-	1154, 3, 2, 2, 2, 1154, 1156, 5, 144, 73, 2, 1155, 1157, 7, 127, 2, 2,
-	1156, 1155, 3, 2, 2, 2, 1156, 1157, 3, 2, 2, 2, 1157, 1158, 3, 2, 2, 2,
-	1158, 1159, 7, 9, 2, 2, 1159, 1165, 3, 2, 2, 2, 1160, 1165, 5, 142, 72,
-	2, 1161, 1165, 5, 140, 71, 2, 1162, 1165, 5, 148, 75, 2, 1163, 1165, 5,
-	172, 87, 2, 1164, 1086, 3, 2, 2, 2, 1164, 1087, 3, 2, 2, 2, 1164, 1088,
-	3, 2, 2, 2, 1164, 1089, 3, 2, 2, 2, 1164, 1102, 3, 2, 2, 2, 1164, 1103,
-	3, 2, 2, 2, 1164, 1104, 3, 2, 2, 2, 1164, 1118, 3, 2, 2, 2, 1164, 1132,
-	3, 2, 2, 2, 1164, 1146, 3, 2, 2, 2, 1164, 1160, 3, 2, 2, 2, 1164, 1161,
-	3, 2, 2, 2, 1164, 1162, 3, 2, 2, 2, 1164, 1163, 3, 2, 2, 2, 1165, 131,
-	3, 2, 2, 2, 1166, 1173, 5, 174, 88, 2, 1167, 1173, 7, 97, 2, 2, 1168, 1173,
-	5, 134, 68, 2, 1169, 1173, 7, 84, 2, 2, 1170, 1173, 5, 176, 89, 2, 1171,
-	1173, 5, 136, 69, 2, 1172, 1166, 3, 2, 2, 2, 1172, 1167, 3, 2, 2, 2, 1172,
-	1168, 3, 2, 2, 2, 1172, 1169, 3, 2, 2, 2, 1172, 1170, 3, 2, 2, 2, 1172,
-	1171, 3, 2, 2, 2, 1173, 133, 3, 2, 2, 2, 1174, 1175, 9, 4, 2, 2, 1175,
-	135, 3, 2, 2, 2, 1176, 1178, 7, 10, 2, 2, 1177, 1179, 7, 127, 2, 2, 1178,
-	1177, 3, 2, 2, 2, 1178, 1179, 3, 2, 2, 2, 1179, 1197, 3, 2, 2, 2, 1180,
-	1182, 5, 100, 51, 2, 1181, 1183, 7, 127, 2, 2, 1182, 1181, 3, 2, 2, 2,
-	1182, 1183, 3, 2, 2, 2, 1183, 1194, 3, 2, 2, 2, 1184, 1186, 7, 4, 2, 2,
-	1185, 1187, 7, 127, 2, 2, 1186, 1185, 3, 2, 2, 2, 1186, 1187, 3, 2, 2,
-	2, 1187, 1188, 3, 2, 2, 2, 1188, 1190, 5, 100, 51, 2, 1189, 1191, 7, 127,
-	2, 2, 1190, 1189, 3, 2, 2, 2, 1190, 1191, 3, 2, 2, 2, 1191, 1193, 3, 2,
-	2, 2, 1192, 1184, 3, 2, 2, 2, 1193, 1196, 3, 2, 2, 2, 1194, 1192, 3, 2,
-	2, 2, 1194, 1195, 3, 2, 2, 2, 1195, 1198, 3, 2, 2, 2, 1196, 1194, 3, 2,
-	2, 2, 1197, 1180, 3, 2, 2, 2, 1197, 1198, 3, 2, 2, 2, 1198, 1199, 3, 2,
-	2, 2, 1199, 1200, 7, 11, 2, 2, 1200, 137, 3, 2, 2, 2, 1201, 1203, 7, 5,
-	2, 2, 1202, 1204, 7, 127, 2, 2, 1203, 1202, 3, 2, 2, 2, 1203, 1204, 3,
-	2, 2, 2, 1204, 1205, 3, 2, 2, 2, 1205, 1232, 5, 112, 57, 2, 1206, 1208,
-	7, 20, 2, 2, 1207, 1209, 7, 127, 2, 2, 1208, 1207, 3, 2, 2, 2, 1208, 1209,
-	3, 2, 2, 2, 1209, 1210, 3, 2, 2, 2, 1210, 1232, 5, 112, 57, 2, 1211, 1213,
-	7, 21, 2, 2, 1212, 1214, 7, 127, 2, 2, 1213, 1212, 3, 2, 2, 2, 1213, 1214,
-	3, 2, 2, 2, 1214, 1215, 3, 2, 2, 2, 1215, 1232, 5, 112, 57, 2, 1216, 1218,
-	7, 22, 2, 2, 1217, 1219, 7, 127, 2, 2, 1218, 1217, 3, 2, 2, 2, 1218, 1219,
-	3, 2, 2, 2, 1219, 1220, 3, 2, 2, 2, 1220, 1232, 5, 112, 57, 2, 1221, 1223,
-	7, 23, 2, 2, 1222, 1224, 7, 127, 2, 2, 1223, 1222, 3, 2, 2, 2, 1223, 1224,
-	3, 2, 2, 2, 1224, 1225, 3, 2, 2, 2, 1225, 1232, 5, 112, 57, 2, 1226, 1228,
-	7, 24, 2, 2, 1227, 1229, 7, 127, 2, 2, 1228, 1227, 3, 2, 2, 2, 1228, 1229,
-	3, 2, 2, 2, 1229, 1230, 3, 2, 2, 2, 1230, 1232, 5, 112, 57, 2, 1231, 1201,
-	3, 2, 2, 2, 1231, 1206, 3, 2, 2, 2, 1231, 1211, 3, 2, 2, 2, 1231, 1216,
-	3, 2, 2, 2, 1231, 1221, 3, 2, 2, 2, 1231, 1226, 3, 2, 2, 2, 1232, 139,
-	3, 2, 2, 2, 1233, 1235, 7, 8, 2, 2, 1234, 1236, 7, 127, 2, 2, 1235, 1234,
-	3, 2, 2, 2, 1235, 1236, 3, 2, 2, 2, 1236, 1237, 3, 2, 2, 2, 1237, 1239,
-	5, 100, 51, 2, 1238, 1240, 7, 127, 2, 2, 1239, 1238, 3, 2, 2, 2, 1239,
-	1240, 3, 2, 2, 2, 1240, 1241, 3, 2, 2, 2, 1241, 1242, 7, 9, 2, 2, 1242,
-	141, 3, 2, 2, 2, 1243, 1248, 5, 78, 40, 2, 1244, 1246, 7, 127, 2, 2, 1245,
-	1244, 3, 2, 2, 2, 1245, 1246, 3, 2, 2, 2, 1246, 1247, 3, 2, 2, 2, 1247,
-	1249, 5, 80, 41, 2, 1248, 1245, 3, 2, 2, 2, 1249, 1250, 3, 2, 2, 2, 1250,
-	1248, 3, 2, 2, 2, 1250, 1251, 3, 2, 2, 2, 1251, 143, 3, 2, 2, 2, 1252,
-	1257, 5, 146, 74, 2, 1253, 1255, 7, 127, 2, 2, 1254, 1253, 3, 2, 2, 2,
-	1254, 1255, 3, 2, 2, 2, 1255, 1256, 3, 2, 2, 2, 1256, 1258, 5, 68, 35,
-	2, 1257, 1254, 3, 2, 2, 2, 1257, 1258, 3, 2, 2, 2, 1258, 145, 3, 2, 2,
-	2, 1259, 1260, 5, 172, 87, 2, 1260, 1261, 7, 127, 2, 2, 1261, 1262, 7,
-	79, 2, 2, 1262, 1263, 7, 127, 2, 2, 1263, 1264, 5, 100, 51, 2, 1264, 147,
-	3, 2, 2, 2, 1265, 1267, 5, 150, 76, 2, 1266, 1268, 7, 127, 2, 2, 1267,
-	1266, 3, 2, 2, 2, 1267, 1268, 3, 2, 2, 2, 1268, 1269, 3, 2, 2, 2, 1269,
-	1271, 7, 8, 2, 2, 1270, 1272, 7, 127, 2, 2, 1271, 1270, 3, 2, 2, 2, 1271,
-	1272, 3, 2, 2, 2, 1272, 1277, 3, 2, 2, 2, 1273, 1275, 7, 65, 2, 2, 1274,
-	1276, 7, 127, 2, 2, 1275, 1274, 3, 2, 2, 2, 1275, 1276, 3, 2, 2, 2, 1276,
-	1278, 3, 2, 2, 2, 1277, 1273, 3, 2, 2, 2, 1277, 1278, 3, 2, 2, 2, 1278,
-	1296, 3, 2, 2, 2, 1279, 1281, 5, 100, 51, 2, 1280, 1282, 7, 127, 2, 2,
-	1281, 1280, 3, 2, 2, 2, 1281, 1282, 3, 2, 2, 2, 1282, 1293, 3, 2, 2, 2,
-	1283, 1285, 7, 4, 2, 2, 1284, 1286, 7, 127, 2, 2, 1285, 1284, 3, 2, 2,
-	2, 1285, 1286, 3, 2, 2, 2, 1286, 1287, 3, 2, 2, 2, 1287, 1289, 5, 100,
-	51, 2, 1288, 1290, 7, 127, 2, 2, 1289, 1288, 3, 2, 2, 2, 1289, 1290, 3,
-	2, 2, 2, 1290, 1292, 3, 2, 2, 2, 1291, 1283, 3, 2, 2, 2, 1292, 1295, 3,
-	2, 2, 2, 1293, 1291, 3, 2, 2, 2, 1293, 1294, 3, 2, 2, 2, 1294, 1297, 3,
-	2, 2, 2, 1295, 1293, 3, 2, 2, 2, 1296, 1279, 3, 2, 2, 2, 1296, 1297, 3,
-	2, 2, 2, 1297, 1298, 3, 2, 2, 2, 1298, 1299, 7, 9, 2, 2, 1299, 149, 3,
-	2, 2, 2, 1300, 1301, 5, 160, 81, 2, 1301, 1302, 5, 192, 97, 2, 1302, 1305,
-	3, 2, 2, 2, 1303, 1305, 7, 91, 2, 2, 1304, 1300, 3, 2, 2, 2, 1304, 1303,
-	3, 2, 2, 2, 1305, 151, 3, 2, 2, 2, 1306, 1308, 5, 158, 80, 2, 1307, 1309,
-	7, 127, 2, 2, 1308, 1307, 3, 2, 2, 2, 1308, 1309, 3, 2, 2, 2, 1309, 1310,
-	3, 2, 2, 2, 1310, 1312, 7, 8, 2, 2, 1311, 1313, 7, 127, 2, 2, 1312, 1311,
-	3, 2, 2, 2, 1312, 1313, 3, 2, 2, 2, 1313, 1331, 3, 2, 2, 2, 1314, 1316,
-	5, 100, 51, 2, 1315, 1317, 7, 127, 2, 2, 1316, 1315, 3, 2, 2, 2, 1316,
-	1317, 3, 2, 2, 2, 1317, 1328, 3, 2, 2, 2, 1318, 1320, 7, 4, 2, 2, 1319,
-	1321, 7, 127, 2, 2, 1320, 1319, 3, 2, 2, 2, 1320, 1321, 3, 2, 2, 2, 1321,
-	1322, 3, 2, 2, 2, 1322, 1324, 5, 100, 51, 2, 1323, 1325, 7, 127, 2, 2,
-	1324, 1323, 3, 2, 2, 2, 1324, 1325, 3, 2, 2, 2, 1325, 1327, 3, 2, 2, 2,
-	1326, 1318, 3, 2, 2, 2, 1327, 1330, 3, 2, 2, 2, 1328, 1326, 3, 2, 2, 2,
-	1328, 1329, 3, 2, 2, 2, 1329, 1332, 3, 2, 2, 2, 1330, 1328, 3, 2, 2, 2,
-	1331, 1314, 3, 2, 2, 2, 1331, 1332, 3, 2, 2, 2, 1332, 1333, 3, 2, 2, 2,
-	1333, 1334, 7, 9, 2, 2, 1334, 153, 3, 2, 2, 2, 1335, 1336, 5, 158, 80,
-	2, 1336, 155, 3, 2, 2, 2, 1337, 1338, 5, 192, 97, 2, 1338, 157, 3, 2, 2,
-	2, 1339, 1340, 5, 160, 81, 2, 1340, 1341, 5, 192, 97, 2, 1341, 159, 3,
-	2, 2, 2, 1342, 1343, 5, 192, 97, 2, 1343, 1344, 7, 25, 2, 2, 1344, 1346,
-	3, 2, 2, 2, 1345, 1342, 3, 2, 2, 2, 1346, 1349, 3, 2, 2, 2, 1347, 1345,
-	3, 2, 2, 2, 1347, 1348, 3, 2, 2, 2, 1348, 161, 3, 2, 2, 2, 1349, 1347,
-	3, 2, 2, 2, 1350, 1352, 7, 10, 2, 2, 1351, 1353, 7, 127, 2, 2, 1352, 1351,
-	3, 2, 2, 2, 1352, 1353, 3, 2, 2, 2, 1353, 1354, 3, 2, 2, 2, 1354, 1363,
-	5, 144, 73, 2, 1355, 1357, 7, 127, 2, 2, 1356, 1355, 3, 2, 2, 2, 1356,
-	1357, 3, 2, 2, 2, 1357, 1358, 3, 2, 2, 2, 1358, 1360, 7, 13, 2, 2, 1359,
-	1361, 7, 127, 2, 2, 1360, 1359, 3, 2, 2, 2, 1360, 1361, 3, 2, 2, 2, 1361,
-	1362, 3, 2, 2, 2, 1362, 1364, 5, 100, 51, 2, 1363, 1356, 3, 2, 2, 2, 1363,
-	1364, 3, 2, 2, 2, 1364, 1366, 3, 2, 2, 2, 1365, 1367, 7, 127, 2, 2, 1366,
-	1365, 3, 2, 2, 2, 1366, 1367, 3, 2, 2, 2, 1367, 1368, 3, 2, 2, 2, 1368,
-	1369, 7, 11, 2, 2, 1369, 163, 3, 2, 2, 2, 1370, 1372, 7, 10, 2, 2, 1371,
-	1373, 7, 127, 2, 2, 1372, 1371, 3, 2, 2, 2, 1372, 1373, 3, 2, 2, 2, 1373,
-	1382, 3, 2, 2, 2, 1374, 1376, 5, 172, 87, 2, 1375, 1377, 7, 127, 2, 2,
-	1376, 1375, 3, 2, 2, 2, 1376, 1377, 3, 2, 2, 2, 1377, 1378, 3, 2, 2, 2,
-	1378, 1380, 7, 5, 2, 2, 1379, 1381, 7, 127, 2, 2, 1380, 1379, 3, 2, 2,
-	2, 1380, 1381, 3, 2, 2, 2, 1381, 1383, 3, 2, 2, 2, 1382, 1374, 3, 2, 2,
-	2, 1382, 1383, 3, 2, 2, 2, 1383, 1384, 3, 2, 2, 2, 1384, 1386, 5, 142,
-	72, 2, 1385, 1387, 7, 127, 2, 2, 1386, 1385, 3, 2, 2, 2, 1386, 1387, 3,
-	2, 2, 2, 1387, 1396, 3, 2, 2, 2, 1388, 1390, 7, 74, 2, 2, 1389, 1391, 7,
-	127, 2, 2, 1390, 1389, 3, 2, 2, 2, 1390, 1391, 3, 2, 2, 2, 1391, 1392,
-	3, 2, 2, 2, 1392, 1394, 5, 100, 51, 2, 1393, 1395, 7, 127, 2, 2, 1394,
-	1393, 3, 2, 2, 2, 1394, 1395, 3, 2, 2, 2, 1395, 1397, 3, 2, 2, 2, 1396,
-	1388, 3, 2, 2, 2, 1396, 1397, 3, 2, 2, 2, 1397, 1398, 3, 2, 2, 2, 1398,
-	1400, 7, 13, 2, 2, 1399, 1401, 7, 127, 2, 2, 1400, 1399, 3, 2, 2, 2, 1400,
-	1401, 3, 2, 2, 2, 1401, 1402, 3, 2, 2, 2, 1402, 1404, 5, 100, 51, 2, 1403,
-	1405, 7, 127, 2, 2, 1404, 1403, 3, 2, 2, 2, 1404, 1405, 3, 2, 2, 2, 1405,
-	1406, 3, 2, 2, 2, 1406, 1407, 7, 11, 2, 2, 1407, 165, 3, 2, 2, 2, 1408,
-	1410, 7, 25, 2, 2, 1409, 1411, 7, 127, 2, 2, 1410, 1409, 3, 2, 2, 2, 1410,
-	1411, 3, 2, 2, 2, 1411, 1412, 3, 2, 2, 2, 1412, 1413, 5, 182, 92, 2, 1413,
-	167, 3, 2, 2, 2, 1414, 1419, 7, 92, 2, 2, 1415, 1417, 7, 127, 2, 2, 1416,
-	1415, 3, 2, 2, 2, 1416, 1417, 3, 2, 2, 2, 1417, 1418, 3, 2, 2, 2, 1418,
-	1420, 5, 170, 86, 2, 1419, 1416, 3, 2, 2, 2, 1420, 1421, 3, 2, 2, 2, 1421,
-	1419, 3, 2, 2, 2, 1421, 1422, 3, 2, 2, 2, 1422, 1437, 3, 2, 2, 2, 1423,
-	1425, 7, 92, 2, 2, 1424, 1426, 7, 127, 2, 2, 1425, 1424, 3, 2, 2, 2, 1425,
-	1426, 3, 2, 2, 2, 1426, 1427, 3, 2, 2, 2, 1427, 1432, 5, 100, 51, 2, 1428,
-	1430, 7, 127, 2, 2, 1429, 1428, 3, 2, 2, 2, 1429, 1430, 3, 2, 2, 2, 1430,
-	1431, 3, 2, 2, 2, 1431, 1433, 5, 170, 86, 2, 1432, 1429, 3, 2, 2, 2, 1433,
-	1434, 3, 2, 2, 2, 1434, 1432, 3, 2, 2, 2, 1434, 1435, 3, 2, 2, 2, 1435,
-	1437, 3, 2, 2, 2, 1436, 1414, 3, 2, 2, 2, 1436, 1423, 3, 2, 2, 2, 1437,
-	1446, 3, 2, 2, 2, 1438, 1440, 7, 127, 2, 2, 1439, 1438, 3, 2, 2, 2, 1439,
-	1440, 3, 2, 2, 2, 1440, 1441, 3, 2, 2, 2, 1441, 1443, 7, 93, 2, 2, 1442,
-	1444, 7, 127, 2, 2, 1443, 1442, 3, 2, 2, 2, 1443, 1444, 3, 2, 2, 2, 1444,
-	1445, 3, 2, 2, 2, 1445, 1447, 5, 100, 51, 2, 1446, 1439, 3, 2, 2, 2, 1446,
-	1447, 3, 2, 2, 2, 1447, 1449, 3, 2, 2, 2, 1448, 1450, 7, 127, 2, 2, 1449,
-	1448, 3, 2, 2, 2, 1449, 1450, 3, 2, 2, 2, 1450, 1451, 3, 2, 2, 2, 1451,
-	1452, 7, 94, 2, 2, 1452, 169, 3, 2, 2, 2, 1453, 1455, 7, 95, 2, 2, 1454,
-	1456, 7, 127, 2, 2, 1455, 1454, 3, 2, 2, 2, 1455, 1456, 3, 2, 2, 2, 1456,
-	1457, 3, 2, 2, 2, 1457, 1459, 5, 100, 51, 2, 1458, 1460, 7, 127, 2, 2,
-	1459, 1458, 3, 2, 2, 2, 1459, 1460, 3, 2, 2, 2, 1460, 1461, 3, 2, 2, 2,
-	1461, 1463, 7, 96, 2, 2, 1462, 1464, 7, 127, 2, 2, 1463, 1462, 3, 2, 2,
-	2, 1463, 1464, 3, 2, 2, 2, 1464, 1465, 3, 2, 2, 2, 1465, 1466, 5, 100,
-	51, 2, 1466, 171, 3, 2, 2, 2, 1467, 1468, 5, 192, 97, 2, 1468, 173, 3,
-	2, 2, 2, 1469, 1472, 5, 186, 94, 2, 1470, 1472, 5, 184, 93, 2, 1471, 1469,
-	3, 2, 2, 2, 1471, 1470, 3, 2, 2, 2, 1472, 175, 3, 2, 2, 2, 1473, 1475,
-	7, 26, 2, 2, 1474, 1476, 7, 127, 2, 2, 1475, 1474, 3, 2, 2, 2, 1475, 1476,
-	3, 2, 2, 2, 1476, 1510, 3, 2, 2, 2, 1477, 1479, 5, 182, 92, 2, 1478, 1480,
-	7, 127, 2, 2, 1479, 1478, 3, 2, 2, 2, 1479, 1480, 3, 2, 2, 2, 1480, 1481,
-	3, 2, 2, 2, 1481, 1483, 7, 12, 2, 2, 1482, 1484, 7, 127, 2, 2, 1483, 1482,
-	3, 2, 2, 2, 1483, 1484, 3, 2, 2, 2, 1484, 1485, 3, 2, 2, 2, 1485, 1487,
-	5, 100, 51, 2, 1486, 1488, 7, 127, 2, 2, 1487, 1486, 3, 2, 2, 2, 1487,
-	1488, 3, 2, 2, 2, 1488, 1507, 3, 2, 2, 2, 1489, 1491, 7, 4, 2, 2, 1490,
-	1492, 7, 127, 2, 2, 1491, 1490, 3, 2, 2, 2, 1491, 1492, 3, 2, 2, 2, 1492,
-	1493, 3, 2, 2, 2, 1493, 1495, 5, 182, 92, 2, 1494, 1496, 7, 127, 2, 2,
-	1495, 1494, 3, 2, 2, 2, 1495, 1496, 3, 2, 2, 2, 1496, 1497, 3, 2, 2, 2,
-	1497, 1499, 7, 12, 2, 2, 1498, 1500, 7, 127, 2, 2, 1499, 1498, 3, 2, 2,
-	2, 1499, 1500, 3, 2, 2, 2, 1500, 1501, 3, 2, 2, 2, 1501, 1503, 5, 100,
-	51, 2, 1502, 1504, 7, 127, 2, 2, 1503, 1502, 3, 2, 2, 2, 1503, 1504, 3,
-	2, 2, 2, 1504, 1506, 3, 2, 2, 2, 1505, 1489, 3, 2, 2, 2, 1506, 1509, 3,
-	2, 2, 2, 1507, 1505, 3, 2, 2, 2, 1507, 1508, 3, 2, 2, 2, 1508, 1511, 3,
-	2, 2, 2, 1509, 1507, 3, 2, 2, 2, 1510, 1477, 3, 2, 2, 2, 1510, 1511, 3,
-	2, 2, 2, 1511, 1512, 3, 2, 2, 2, 1512, 1513, 7, 27, 2, 2, 1513, 177, 3,
-	2, 2, 2, 1514, 1517, 7, 28, 2, 2, 1515, 1518, 5, 192, 97, 2, 1516, 1518,
-	7, 100, 2, 2, 1517, 1515, 3, 2, 2, 2, 1517, 1516, 3, 2, 2, 2, 1518, 179,
-	3, 2, 2, 2, 1519, 1524, 5, 130, 66, 2, 1520, 1522, 7, 127, 2, 2, 1521,
-	1520, 3, 2, 2, 2, 1521, 1522, 3, 2, 2, 2, 1522, 1523, 3, 2, 2, 2, 1523,
-	1525, 5, 166, 84, 2, 1524, 1521, 3, 2, 2, 2, 1525, 1526, 3, 2, 2, 2, 1526,
-	1524, 3, 2, 2, 2, 1526, 1527, 3, 2, 2, 2, 1527, 181, 3, 2, 2, 2, 1528,
-	1529, 5, 188, 95, 2, 1529, 183, 3, 2, 2, 2, 1530, 1531, 9, 5, 2, 2, 1531,
-	185, 3, 2, 2, 2, 1532, 1533, 9, 6, 2, 2, 1533, 187, 3, 2, 2, 2, 1534, 1537,
-	5, 192, 97, 2, 1535, 1537, 5, 190, 96, 2, 1536, 1534, 3, 2, 2, 2, 1536,
-	1535, 3, 2, 2, 2, 1537, 189, 3, 2, 2, 2, 1538, 1539, 9, 7, 2, 2, 1539,
-	191, 3, 2, 2, 2, 1540, 1541, 9, 8, 2, 2, 1541, 193, 3, 2, 2, 2, 1542, 1543,
-	9, 9, 2, 2, 1543, 195, 3, 2, 2, 2, 1544, 1545, 9, 10, 2, 2, 1545, 197,
-	3, 2, 2, 2, 1546, 1547, 9, 11, 2, 2, 1547, 199, 3, 2, 2, 2, 287, 201, 205,
-	208, 211, 219, 223, 228, 235, 240, 243, 247, 251, 255, 261, 265, 270, 275,
-	279, 282, 284, 288, 292, 297, 301, 306, 310, 319, 324, 328, 332, 336, 339,
-	343, 353, 360, 373, 377, 383, 387, 391, 396, 401, 405, 411, 415, 421, 425,
-	431, 435, 439, 443, 447, 451, 456, 463, 467, 472, 479, 485, 490, 496, 502,
-	507, 511, 516, 519, 522, 525, 532, 539, 542, 548, 551, 557, 561, 565, 569,
-	573, 578, 583, 587, 592, 595, 604, 613, 618, 631, 634, 642, 646, 651, 656,
-	660, 665, 671, 676, 683, 687, 691, 693, 697, 699, 703, 705, 711, 717, 721,
-	724, 727, 731, 737, 741, 744, 747, 753, 756, 759, 763, 769, 772, 775, 779,
-	783, 787, 789, 793, 795, 798, 802, 804, 810, 814, 818, 822, 825, 830, 835,
-	840, 845, 851, 855, 857, 861, 865, 867, 869, 884, 894, 904, 909, 913, 920,
-	925, 930, 934, 938, 942, 945, 947, 952, 956, 960, 964, 968, 972, 975, 977,
-	982, 986, 991, 996, 1000, 1009, 1011, 1017, 1021, 1028, 1032, 1036, 1039,
-	1051, 1054, 1068, 1072, 1077, 1081, 1084, 1091, 1095, 1099, 1106, 1110,
-	1114, 1120, 1124, 1128, 1134, 1138, 1142, 1148, 1152, 1156, 1164, 1172,
-	1178, 1182, 1186, 1190, 1194, 1197, 1203, 1208, 1213, 1218, 1223, 1228,
-	1231, 1235, 1239, 1245, 1250, 1254, 1257, 1267, 1271, 1275, 1277, 1281,
-	1285, 1289, 1293, 1296, 1304, 1308, 1312, 1316, 1320, 1324, 1328, 1331,
-	1347, 1352, 1356, 1360, 1363, 1366, 1372, 1376, 1380, 1382, 1386, 1390,
-	1394, 1396, 1400, 1404, 1410, 1416, 1421, 1425, 1429, 1434, 1436, 1439,
-	1443, 1446, 1449, 1455, 1459, 1463, 1471, 1475, 1479, 1483, 1487, 1491,
-	1495, 1499, 1503, 1507, 1510, 1517, 1521, 1526, 1536,
+	5, 206, 104, 2, 762, 764, 7, 131, 2, 2, 763, 762, 3, 2, 2, 2, 763, 764,
+	3, 2, 2, 2, 764, 766, 3, 2, 2, 2, 765, 767, 5, 86, 44, 2, 766, 765, 3,
+	2, 2, 2, 766, 767, 3, 2, 2, 2, 767, 769, 3, 2, 2, 2, 768, 770, 7, 131,
+	2, 2, 769, 768, 3, 2, 2, 2, 769, 770, 3, 2, 2, 2, 770, 771, 3, 2, 2, 2,
+	771, 772, 5, 206, 104, 2, 772, 802, 3, 2, 2, 2, 773, 775, 5, 206, 104,
+	2, 774, 776, 7, 131, 2, 2, 775, 774, 3, 2, 2, 2, 775, 776, 3, 2, 2, 2,
+	776, 778, 3, 2, 2, 2, 777, 779, 5, 86, 44, 2, 778, 777, 3, 2, 2, 2, 778,
+	779, 3, 2, 2, 2, 779, 781, 3, 2, 2, 2, 780, 782, 7, 131, 2, 2, 781, 780,
+	3, 2, 2, 2, 781, 782, 3, 2, 2, 2, 782, 783, 3, 2, 2, 2, 783, 785, 5, 206,
+	104, 2, 784, 786, 7, 131, 2, 2, 785, 784, 3, 2, 2, 2, 785, 786, 3, 2, 2,
+	2, 786, 787, 3, 2, 2, 2, 787, 788, 5, 204, 103, 2, 788, 802, 3, 2, 2, 2,
+	789, 791, 5, 206, 104, 2, 790, 792, 7, 131, 2, 2, 791, 790, 3, 2, 2, 2,
+	791, 792, 3, 2, 2, 2, 792, 794, 3, 2, 2, 2, 793, 795, 5, 86, 44, 2, 794,
+	793, 3, 2, 2, 2, 794, 795, 3, 2, 2, 2, 795, 797, 3, 2, 2, 2, 796, 798,
+	7, 131, 2, 2, 797, 796, 3, 2, 2, 2, 797, 798, 3, 2, 2, 2, 798, 799, 3,
+	2, 2, 2, 799, 800, 5, 206, 104, 2, 800, 802, 3, 2, 2, 2, 801, 737, 3, 2,
+	2, 2, 801, 757, 3, 2, 2, 2, 801, 773, 3, 2, 2, 2, 801, 789, 3, 2, 2, 2,
+	802, 85, 3, 2, 2, 2, 803, 805, 7, 10, 2, 2, 804, 806, 7, 131, 2, 2, 805,
+	804, 3, 2, 2, 2, 805, 806, 3, 2, 2, 2, 806, 811, 3, 2, 2, 2, 807, 809,
+	5, 180, 91, 2, 808, 810, 7, 131, 2, 2, 809, 808, 3, 2, 2, 2, 809, 810,
+	3, 2, 2, 2, 810, 812, 3, 2, 2, 2, 811, 807, 3, 2, 2, 2, 811, 812, 3, 2,
+	2, 2, 812, 817, 3, 2, 2, 2, 813, 815, 5, 90, 46, 2, 814, 816, 7, 131, 2,
+	2, 815, 814, 3, 2, 2, 2, 815, 816, 3, 2, 2, 2, 816, 818, 3, 2, 2, 2, 817,
+	813, 3, 2, 2, 2, 817, 818, 3, 2, 2, 2, 818, 820, 3, 2, 2, 2, 819, 821,
+	5, 96, 49, 2, 820, 819, 3, 2, 2, 2, 820, 821, 3, 2, 2, 2, 821, 826, 3,
+	2, 2, 2, 822, 824, 5, 88, 45, 2, 823, 825, 7, 131, 2, 2, 824, 823, 3, 2,
+	2, 2, 824, 825, 3, 2, 2, 2, 825, 827, 3, 2, 2, 2, 826, 822, 3, 2, 2, 2,
+	826, 827, 3, 2, 2, 2, 827, 828, 3, 2, 2, 2, 828, 829, 7, 11, 2, 2, 829,
+	87, 3, 2, 2, 2, 830, 833, 5, 184, 93, 2, 831, 833, 5, 186, 94, 2, 832,
+	830, 3, 2, 2, 2, 832, 831, 3, 2, 2, 2, 833, 89, 3, 2, 2, 2, 834, 836, 7,
+	12, 2, 2, 835, 837, 7, 131, 2, 2, 836, 835, 3, 2, 2, 2, 836, 837, 3, 2,
+	2, 2, 837, 838, 3, 2, 2, 2, 838, 852, 5, 100, 51, 2, 839, 841, 7, 131,
+	2, 2, 840, 839, 3, 2, 2, 2, 840, 841, 3, 2, 2, 2, 841, 842, 3, 2, 2, 2,
+	842, 844, 7, 13, 2, 2, 843, 845, 7, 12, 2, 2, 844, 843, 3, 2, 2, 2, 844,
+	845, 3, 2, 2, 2, 845, 847, 3, 2, 2, 2, 846, 848, 7, 131, 2, 2, 847, 846,
+	3, 2, 2, 2, 847, 848, 3, 2, 2, 2, 848, 849, 3, 2, 2, 2, 849, 851, 5, 100,
+	51, 2, 850, 840, 3, 2, 2, 2, 851, 854, 3, 2, 2, 2, 852, 850, 3, 2, 2, 2,
+	852, 853, 3, 2, 2, 2, 853, 91, 3, 2, 2, 2, 854, 852, 3, 2, 2, 2, 855, 862,
+	5, 94, 48, 2, 856, 858, 7, 131, 2, 2, 857, 856, 3, 2, 2, 2, 857, 858, 3,
+	2, 2, 2, 858, 859, 3, 2, 2, 2, 859, 861, 5, 94, 48, 2, 860, 857, 3, 2,
+	2, 2, 861, 864, 3, 2, 2, 2, 862, 860, 3, 2, 2, 2, 862, 863, 3, 2, 2, 2,
+	863, 93, 3, 2, 2, 2, 864, 862, 3, 2, 2, 2, 865, 867, 7, 12, 2, 2, 866,
+	868, 7, 131, 2, 2, 867, 866, 3, 2, 2, 2, 867, 868, 3, 2, 2, 2, 868, 869,
+	3, 2, 2, 2, 869, 870, 5, 98, 50, 2, 870, 95, 3, 2, 2, 2, 871, 873, 7, 7,
+	2, 2, 872, 874, 7, 131, 2, 2, 873, 872, 3, 2, 2, 2, 873, 874, 3, 2, 2,
+	2, 874, 879, 3, 2, 2, 2, 875, 877, 5, 192, 97, 2, 876, 878, 7, 131, 2,
+	2, 877, 876, 3, 2, 2, 2, 877, 878, 3, 2, 2, 2, 878, 880, 3, 2, 2, 2, 879,
+	875, 3, 2, 2, 2, 879, 880, 3, 2, 2, 2, 880, 891, 3, 2, 2, 2, 881, 883,
+	7, 14, 2, 2, 882, 884, 7, 131, 2, 2, 883, 882, 3, 2, 2, 2, 883, 884, 3,
+	2, 2, 2, 884, 889, 3, 2, 2, 2, 885, 887, 5, 192, 97, 2, 886, 888, 7, 131,
+	2, 2, 887, 886, 3, 2, 2, 2, 887, 888, 3, 2, 2, 2, 888, 890, 3, 2, 2, 2,
+	889, 885, 3, 2, 2, 2, 889, 890, 3, 2, 2, 2, 890, 892, 3, 2, 2, 2, 891,
+	881, 3, 2, 2, 2, 891, 892, 3, 2, 2, 2, 892, 97, 3, 2, 2, 2, 893, 894, 5,
+	196, 99, 2, 894, 99, 3, 2, 2, 2, 895, 896, 5, 196, 99, 2, 896, 101, 3,
+	2, 2, 2, 897, 898, 5, 104, 53, 2, 898, 103, 3, 2, 2, 2, 899, 906, 5, 106,
+	54, 2, 900, 901, 7, 131, 2, 2, 901, 902, 7, 78, 2, 2, 902, 903, 7, 131,
+	2, 2, 903, 905, 5, 106, 54, 2, 904, 900, 3, 2, 2, 2, 905, 908, 3, 2, 2,
+	2, 906, 904, 3, 2, 2, 2, 906, 907, 3, 2, 2, 2, 907, 105, 3, 2, 2, 2, 908,
+	906, 3, 2, 2, 2, 909, 916, 5, 108, 55, 2, 910, 911, 7, 131, 2, 2, 911,
+	912, 7, 79, 2, 2, 912, 913, 7, 131, 2, 2, 913, 915, 5, 108, 55, 2, 914,
+	910, 3, 2, 2, 2, 915, 918, 3, 2, 2, 2, 916, 914, 3, 2, 2, 2, 916, 917,
+	3, 2, 2, 2, 917, 107, 3, 2, 2, 2, 918, 916, 3, 2, 2, 2, 919, 926, 5, 110,
+	56, 2, 920, 921, 7, 131, 2, 2, 921, 922, 7, 80, 2, 2, 922, 923, 7, 131,
+	2, 2, 923, 925, 5, 110, 56, 2, 924, 920, 3, 2, 2, 2, 925, 928, 3, 2, 2,
+	2, 926, 924, 3, 2, 2, 2, 926, 927, 3, 2, 2, 2, 927, 109, 3, 2, 2, 2, 928,
+	926, 3, 2, 2, 2, 929, 931, 7, 81, 2, 2, 930, 932, 7, 131, 2, 2, 931, 930,
+	3, 2, 2, 2, 931, 932, 3, 2, 2, 2, 932, 934, 3, 2, 2, 2, 933, 929, 3, 2,
+	2, 2, 934, 937, 3, 2, 2, 2, 935, 933, 3, 2, 2, 2, 935, 936, 3, 2, 2, 2,
+	936, 938, 3, 2, 2, 2, 937, 935, 3, 2, 2, 2, 938, 939, 5, 112, 57, 2, 939,
+	111, 3, 2, 2, 2, 940, 947, 5, 114, 58, 2, 941, 943, 7, 131, 2, 2, 942,
+	941, 3, 2, 2, 2, 942, 943, 3, 2, 2, 2, 943, 944, 3, 2, 2, 2, 944, 946,
+	5, 146, 74, 2, 945, 942, 3, 2, 2, 2, 946, 949, 3, 2, 2, 2, 947, 945, 3,
+	2, 2, 2, 947, 948, 3, 2, 2, 2, 948, 113, 3, 2, 2, 2, 949, 947, 3, 2, 2,
+	2, 950, 969, 5, 116, 59, 2, 951, 953, 7, 131, 2, 2, 952, 951, 3, 2, 2,
+	2, 952, 953, 3, 2, 2, 2, 953, 954, 3, 2, 2, 2, 954, 956, 7, 15, 2, 2, 955,
+	957, 7, 131, 2, 2, 956, 955, 3, 2, 2, 2, 956, 957, 3, 2, 2, 2, 957, 958,
+	3, 2, 2, 2, 958, 968, 5, 116, 59, 2, 959, 961, 7, 131, 2, 2, 960, 959,
+	3, 2, 2, 2, 960, 961, 3, 2, 2, 2, 961, 962, 3, 2, 2, 2, 962, 964, 7, 16,
+	2, 2, 963, 965, 7, 131, 2, 2, 964, 963, 3, 2, 2, 2, 964, 965, 3, 2, 2,
+	2, 965, 966, 3, 2, 2, 2, 966, 968, 5, 116, 59, 2, 967, 952, 3, 2, 2, 2,
+	967, 960, 3, 2, 2, 2, 968, 971, 3, 2, 2, 2, 969, 967, 3, 2, 2, 2, 969,
+	970, 3, 2, 2, 2, 970, 115, 3, 2, 2, 2, 971, 969, 3, 2, 2, 2, 972, 999,
+	5, 118, 60, 2, 973, 975, 7, 131, 2, 2, 974, 973, 3, 2, 2, 2, 974, 975,
+	3, 2, 2, 2, 975, 976, 3, 2, 2, 2, 976, 978, 7, 7, 2, 2, 977, 979, 7, 131,
+	2, 2, 978, 977, 3, 2, 2, 2, 978, 979, 3, 2, 2, 2, 979, 980, 3, 2, 2, 2,
+	980, 998, 5, 118, 60, 2, 981, 983, 7, 131, 2, 2, 982, 981, 3, 2, 2, 2,
+	982, 983, 3, 2, 2, 2, 983, 984, 3, 2, 2, 2, 984, 986, 7, 17, 2, 2, 985,
+	987, 7, 131, 2, 2, 986, 985, 3, 2, 2, 2, 986, 987, 3, 2, 2, 2, 987, 988,
+	3, 2, 2, 2, 988, 998, 5, 118, 60, 2, 989, 991, 7, 131, 2, 2, 990, 989,
+	3, 2, 2, 2, 990, 991, 3, 2, 2, 2, 991, 992, 3, 2, 2, 2, 992, 994, 7, 18,
+	2, 2, 993, 995, 7, 131, 2, 2, 994, 993, 3, 2, 2, 2, 994, 995, 3, 2, 2,
+	2, 995, 996, 3, 2, 2, 2, 996, 998, 5, 118, 60, 2, 997, 974, 3, 2, 2, 2,
+	997, 982, 3, 2, 2, 2, 997, 990, 3, 2, 2, 2, 998, 1001, 3, 2, 2, 2, 999,
+	997, 3, 2, 2, 2, 999, 1000, 3, 2, 2, 2, 1000, 117, 3, 2, 2, 2, 1001, 999,
+	3, 2, 2, 2, 1002, 1013, 5, 120, 61, 2, 1003, 1005, 7, 131, 2, 2, 1004,
+	1003, 3, 2, 2, 2, 1004, 1005, 3, 2, 2, 2, 1005, 1006, 3, 2, 2, 2, 1006,
+	1008, 7, 19, 2, 2, 1007, 1009, 7, 131, 2, 2, 1008, 1007, 3, 2, 2, 2, 1008,
+	1009, 3, 2, 2, 2, 1009, 1010, 3, 2, 2, 2, 1010, 1012, 5, 120, 61, 2, 1011,
+	1004, 3, 2, 2, 2, 1012, 1015, 3, 2, 2, 2, 1013, 1011, 3, 2, 2, 2, 1013,
+	1014, 3, 2, 2, 2, 1014, 119, 3, 2, 2, 2, 1015, 1013, 3, 2, 2, 2, 1016,
+	1018, 9, 3, 2, 2, 1017, 1019, 7, 131, 2, 2, 1018, 1017, 3, 2, 2, 2, 1018,
+	1019, 3, 2, 2, 2, 1019, 1021, 3, 2, 2, 2, 1020, 1016, 3, 2, 2, 2, 1021,
+	1024, 3, 2, 2, 2, 1022, 1020, 3, 2, 2, 2, 1022, 1023, 3, 2, 2, 2, 1023,
+	1025, 3, 2, 2, 2, 1024, 1022, 3, 2, 2, 2, 1025, 1026, 5, 122, 62, 2, 1026,
+	121, 3, 2, 2, 2, 1027, 1033, 5, 130, 66, 2, 1028, 1032, 5, 126, 64, 2,
+	1029, 1032, 5, 124, 63, 2, 1030, 1032, 5, 128, 65, 2, 1031, 1028, 3, 2,
+	2, 2, 1031, 1029, 3, 2, 2, 2, 1031, 1030, 3, 2, 2, 2, 1032, 1035, 3, 2,
+	2, 2, 1033, 1031, 3, 2, 2, 2, 1033, 1034, 3, 2, 2, 2, 1034, 123, 3, 2,
+	2, 2, 1035, 1033, 3, 2, 2, 2, 1036, 1037, 7, 131, 2, 2, 1037, 1039, 7,
+	82, 2, 2, 1038, 1040, 7, 131, 2, 2, 1039, 1038, 3, 2, 2, 2, 1039, 1040,
+	3, 2, 2, 2, 1040, 1041, 3, 2, 2, 2, 1041, 1062, 5, 130, 66, 2, 1042, 1044,
+	7, 131, 2, 2, 1043, 1042, 3, 2, 2, 2, 1043, 1044, 3, 2, 2, 2, 1044, 1045,
+	3, 2, 2, 2, 1045, 1046, 7, 10, 2, 2, 1046, 1047, 5, 102, 52, 2, 1047, 1048,
+	7, 11, 2, 2, 1048, 1062, 3, 2, 2, 2, 1049, 1051, 7, 131, 2, 2, 1050, 1049,
+	3, 2, 2, 2, 1050, 1051, 3, 2, 2, 2, 1051, 1052, 3, 2, 2, 2, 1052, 1054,
+	7, 10, 2, 2, 1053, 1055, 5, 102, 52, 2, 1054, 1053, 3, 2, 2, 2, 1054, 1055,
+	3, 2, 2, 2, 1055, 1056, 3, 2, 2, 2, 1056, 1058, 7, 14, 2, 2, 1057, 1059,
+	5, 102, 52, 2, 1058, 1057, 3, 2, 2, 2, 1058, 1059, 3, 2, 2, 2, 1059, 1060,
+	3, 2, 2, 2, 1060, 1062, 7, 11, 2, 2, 1061, 1036, 3, 2, 2, 2, 1061, 1043,
+	3, 2, 2, 2, 1061, 1050, 3, 2, 2, 2, 1062, 125, 3, 2, 2, 2, 1063, 1064,
+	7, 131, 2, 2, 1064, 1065, 7, 83, 2, 2, 1065, 1066, 7, 131, 2, 2, 1066,
+	1078, 7, 64, 2, 2, 1067, 1068, 7, 131, 2, 2, 1068, 1069, 7, 84, 2, 2, 1069,
+	1070, 7, 131, 2, 2, 1070, 1078, 7, 64, 2, 2, 1071, 1072, 7, 131, 2, 2,
+	1072, 1078, 7, 85, 2, 2, 1073, 1075, 7, 131, 2, 2, 1074, 1073, 3, 2, 2,
+	2, 1074, 1075, 3, 2, 2, 2, 1075, 1076, 3, 2, 2, 2, 1076, 1078, 7, 20, 2,
+	2, 1077, 1063, 3, 2, 2, 2, 1077, 1067, 3, 2, 2, 2, 1077, 1071, 3, 2, 2,
+	2, 1077, 1074, 3, 2, 2, 2, 1078, 1080, 3, 2, 2, 2, 1079, 1081, 7, 131,
+	2, 2, 1080, 1079, 3, 2, 2, 2, 1080, 1081, 3, 2, 2, 2, 1081, 1082, 3, 2,
+	2, 2, 1082, 1083, 5, 130, 66, 2, 1083, 127, 3, 2, 2, 2, 1084, 1085, 7,
+	131, 2, 2, 1085, 1086, 7, 86, 2, 2, 1086, 1087, 7, 131, 2, 2, 1087, 1095,
+	7, 87, 2, 2, 1088, 1089, 7, 131, 2, 2, 1089, 1090, 7, 86, 2, 2, 1090, 1091,
+	7, 131, 2, 2, 1091, 1092, 7, 81, 2, 2, 1092, 1093, 7, 131, 2, 2, 1093,
+	1095, 7, 87, 2, 2, 1094, 1084, 3, 2, 2, 2, 1094, 1088, 3, 2, 2, 2, 1095,
+	129, 3, 2, 2, 2, 1096, 1103, 5, 132, 67, 2, 1097, 1099, 7, 131, 2, 2, 1098,
+	1097, 3, 2, 2, 2, 1098, 1099, 3, 2, 2, 2, 1099, 1100, 3, 2, 2, 2, 1100,
+	1102, 5, 174, 88, 2, 1101, 1098, 3, 2, 2, 2, 1102, 1105, 3, 2, 2, 2, 1103,
+	1101, 3, 2, 2, 2, 1103, 1104, 3, 2, 2, 2, 1104, 1110, 3, 2, 2, 2, 1105,
+	1103, 3, 2, 2, 2, 1106, 1108, 7, 131, 2, 2, 1107, 1106, 3, 2, 2, 2, 1107,
+	1108, 3, 2, 2, 2, 1108, 1109, 3, 2, 2, 2, 1109, 1111, 5, 92, 47, 2, 1110,
+	1107, 3, 2, 2, 2, 1110, 1111, 3, 2, 2, 2, 1111, 131, 3, 2, 2, 2, 1112,
+	1193, 5, 140, 71, 2, 1113, 1193, 5, 186, 94, 2, 1114, 1193, 5, 176, 89,
+	2, 1115, 1117, 7, 89, 2, 2, 1116, 1118, 7, 131, 2, 2, 1117, 1116, 3, 2,
+	2, 2, 1117, 1118, 3, 2, 2, 2, 1118, 1119, 3, 2, 2, 2, 1119, 1121, 7, 8,
+	2, 2, 1120, 1122, 7, 131, 2, 2, 1121, 1120, 3, 2, 2, 2, 1121, 1122, 3,
+	2, 2, 2, 1122, 1123, 3, 2, 2, 2, 1123, 1125, 7, 7, 2, 2, 1124, 1126, 7,
+	131, 2, 2, 1125, 1124, 3, 2, 2, 2, 1125, 1126, 3, 2, 2, 2, 1126, 1127,
+	3, 2, 2, 2, 1127, 1193, 7, 9, 2, 2, 1128, 1193, 5, 170, 86, 2, 1129, 1193,
+	5, 172, 87, 2, 1130, 1132, 7, 50, 2, 2, 1131, 1133, 7, 131, 2, 2, 1132,
+	1131, 3, 2, 2, 2, 1132, 1133, 3, 2, 2, 2, 1133, 1134, 3, 2, 2, 2, 1134,
+	1136, 7, 8, 2, 2, 1135, 1137, 7, 131, 2, 2, 1136, 1135, 3, 2, 2, 2, 1136,
+	1137, 3, 2, 2, 2, 1137, 1138, 3, 2, 2, 2, 1138, 1140, 5, 152, 77, 2, 1139,
+	1141, 7, 131, 2, 2, 1140, 1139, 3, 2, 2, 2, 1140, 1141, 3, 2, 2, 2, 1141,
+	1142, 3, 2, 2, 2, 1142, 1143, 7, 9, 2, 2, 1143, 1193, 3, 2, 2, 2, 1144,
+	1146, 7, 90, 2, 2, 1145, 1147, 7, 131, 2, 2, 1146, 1145, 3, 2, 2, 2, 1146,
+	1147, 3, 2, 2, 2, 1147, 1148, 3, 2, 2, 2, 1148, 1150, 7, 8, 2, 2, 1149,
+	1151, 7, 131, 2, 2, 1150, 1149, 3, 2, 2, 2, 1150, 1151, 3, 2, 2, 2, 1151,
+	1152, 3, 2, 2, 2, 1152, 1154, 5, 152, 77, 2, 1153, 1155, 7, 131, 2, 2,
+	1154, 1153, 3, 2, 2, 2, 1154, 1155, 3, 2, 2, 2, 1155, 1156, 3, 2, 2, 2,
+	1156, 1157, 7, 9, 2, 2, 1157, 1193, 3, 2, 2, 2, 1158, 1160, 7, 91, 2, 2,
+	1159, 1161, 7, 131, 2, 2, 1160, 1159, 3, 2, 2, 2, 1160, 1161, 3, 2, 2,
+	2, 1161, 1162, 3, 2, 2, 2, 1162, 1164, 7, 8, 2, 2, 1163, 1165, 7, 131,
+	2, 2, 1164, 1163, 3, 2, 2, 2, 1164, 1165, 3, 2, 2, 2, 1165, 1166, 3, 2,
+	2, 2, 1166, 1168, 5, 152, 77, 2, 1167, 1169, 7, 131, 2, 2, 1168, 1167,
+	3, 2, 2, 2, 1168, 1169, 3, 2, 2, 2, 1169, 1170, 3, 2, 2, 2, 1170, 1171,
+	7, 9, 2, 2, 1171, 1193, 3, 2, 2, 2, 1172, 1174, 7, 92, 2, 2, 1173, 1175,
+	7, 131, 2, 2, 1174, 1173, 3, 2, 2, 2, 1174, 1175, 3, 2, 2, 2, 1175, 1176,
+	3, 2, 2, 2, 1176, 1178, 7, 8, 2, 2, 1177, 1179, 7, 131, 2, 2, 1178, 1177,
+	3, 2, 2, 2, 1178, 1179, 3, 2, 2, 2, 1179, 1180, 3, 2, 2, 2, 1180, 1182,
+	5, 152, 77, 2, 1181, 1183, 7, 131, 2, 2, 1182, 1181, 3, 2, 2, 2, 1182,
+	1183, 3, 2, 2, 2, 1183, 1184, 3, 2, 2, 2, 1184, 1185, 7, 9, 2, 2, 1185,
+	1193, 3, 2, 2, 2, 1186, 1193, 5, 150, 76, 2, 1187, 1193, 5, 148, 75, 2,
+	1188, 1193, 5, 156, 79, 2, 1189, 1193, 5, 136, 69, 2, 1190, 1193, 5, 134,
+	68, 2, 1191, 1193, 5, 180, 91, 2, 1192, 1112, 3, 2, 2, 2, 1192, 1113, 3,
+	2, 2, 2, 1192, 1114, 3, 2, 2, 2, 1192, 1115, 3, 2, 2, 2, 1192, 1128, 3,
+	2, 2, 2, 1192, 1129, 3, 2, 2, 2, 1192, 1130, 3, 2, 2, 2, 1192, 1144, 3,
+	2, 2, 2, 1192, 1158, 3, 2, 2, 2, 1192, 1172, 3, 2, 2, 2, 1192, 1186, 3,
+	2, 2, 2, 1192, 1187, 3, 2, 2, 2, 1192, 1188, 3, 2, 2, 2, 1192, 1189, 3,
+	2, 2, 2, 1192, 1190, 3, 2, 2, 2, 1192, 1191, 3, 2, 2, 2, 1193, 133, 3,
+	2, 2, 2, 1194, 1196, 7, 88, 2, 2, 1195, 1197, 7, 131, 2, 2, 1196, 1195,
+	3, 2, 2, 2, 1196, 1197, 3, 2, 2, 2, 1197, 1198, 3, 2, 2, 2, 1198, 1200,
+	7, 8, 2, 2, 1199, 1201, 7, 131, 2, 2, 1200, 1199, 3, 2, 2, 2, 1200, 1201,
+	3, 2, 2, 2, 1201, 1202, 3, 2, 2, 2, 1202, 1204, 5, 180, 91, 2, 1203, 1205,
+	7, 131, 2, 2, 1204, 1203, 3, 2, 2, 2, 1204, 1205, 3, 2, 2, 2, 1205, 1206,
+	3, 2, 2, 2, 1206, 1208, 7, 5, 2, 2, 1207, 1209, 7, 131, 2, 2, 1208, 1207,
+	3, 2, 2, 2, 1208, 1209, 3, 2, 2, 2, 1209, 1210, 3, 2, 2, 2, 1210, 1212,
+	5, 102, 52, 2, 1211, 1213, 7, 131, 2, 2, 1212, 1211, 3, 2, 2, 2, 1212,
+	1213, 3, 2, 2, 2, 1213, 1214, 3, 2, 2, 2, 1214, 1216, 7, 4, 2, 2, 1215,
+	1217, 7, 131, 2, 2, 1216, 1215, 3, 2, 2, 2, 1216, 1217, 3, 2, 2, 2, 1217,
+	1218, 3, 2, 2, 2, 1218, 1220, 5, 154, 78, 2, 1219, 1221, 7, 131, 2, 2,
+	1220, 1219, 3, 2, 2, 2, 1220, 1221, 3, 2, 2, 2, 1221, 1222, 3, 2, 2, 2,
+	1222, 1224, 7, 13, 2, 2, 1223, 1225, 7, 131, 2, 2, 1224, 1223, 3, 2, 2,
+	2, 1224, 1225, 3, 2, 2, 2, 1225, 1226, 3, 2, 2, 2, 1226, 1228, 5, 102,
+	52, 2, 1227, 1229, 7, 131, 2, 2, 1228, 1227, 3, 2, 2, 2, 1228, 1229, 3,
+	2, 2, 2, 1229, 1230, 3, 2, 2, 2, 1230, 1231, 7, 9, 2, 2, 1231, 135, 3,
+	2, 2, 2, 1232, 1234, 5, 180, 91, 2, 1233, 1235, 7, 131, 2, 2, 1234, 1233,
+	3, 2, 2, 2, 1234, 1235, 3, 2, 2, 2, 1235, 1236, 3, 2, 2, 2, 1236, 1238,
+	7, 21, 2, 2, 1237, 1239, 7, 131, 2, 2, 1238, 1237, 3, 2, 2, 2, 1238, 1239,
+	3, 2, 2, 2, 1239, 1257, 3, 2, 2, 2, 1240, 1242, 5, 138, 70, 2, 1241, 1243,
+	7, 131, 2, 2, 1242, 1241, 3, 2, 2, 2, 1242, 1243, 3, 2, 2, 2, 1243, 1254,
+	3, 2, 2, 2, 1244, 1246, 7, 4, 2, 2, 1245, 1247, 7, 131, 2, 2, 1246, 1245,
+	3, 2, 2, 2, 1246, 1247, 3, 2, 2, 2, 1247, 1248, 3, 2, 2, 2, 1248, 1250,
+	5, 138, 70, 2, 1249, 1251, 7, 131, 2, 2, 1250, 1249, 3, 2, 2, 2, 1250,
+	1251, 3, 2, 2, 2, 1251, 1253, 3, 2, 2, 2, 1252, 1244, 3, 2, 2, 2, 1253,
+	1256, 3, 2, 2, 2, 1254, 1252, 3, 2, 2, 2, 1254, 1255, 3, 2, 2, 2, 1255,
+	1258, 3, 2, 2, 2, 1256, 1254, 3, 2, 2, 2, 1257, 1240, 3, 2, 2, 2, 1257,
+	1258, 3, 2, 2, 2, 1258, 1259, 3, 2, 2, 2, 1259, 1260, 7, 22, 2, 2, 1260,
+	137, 3, 2, 2, 2, 1261, 1279, 5, 174, 88, 2, 1262, 1264, 5, 190, 96, 2,
+	1263, 1265, 7, 131, 2, 2, 1264, 1263, 3, 2, 2, 2, 1264, 1265, 3, 2, 2,
+	2, 1265, 1266, 3, 2, 2, 2, 1266, 1268, 7, 12, 2, 2, 1267, 1269, 7, 131,
+	2, 2, 1268, 1267, 3, 2, 2, 2, 1268, 1269, 3, 2, 2, 2, 1269, 1270, 3, 2,
+	2, 2, 1270, 1271, 5, 102, 52, 2, 1271, 1279, 3, 2, 2, 2, 1272, 1279, 5,
+	180, 91, 2, 1273, 1275, 7, 23, 2, 2, 1274, 1276, 7, 131, 2, 2, 1275, 1274,
+	3, 2, 2, 2, 1275, 1276, 3, 2, 2, 2, 1276, 1277, 3, 2, 2, 2, 1277, 1279,
+	7, 7, 2, 2, 1278, 1261, 3, 2, 2, 2, 1278, 1262, 3, 2, 2, 2, 1278, 1272,
+	3, 2, 2, 2, 1278, 1273, 3, 2, 2, 2, 1279, 139, 3, 2, 2, 2, 1280, 1287,
+	5, 182, 92, 2, 1281, 1287, 7, 101, 2, 2, 1282, 1287, 5, 142, 72, 2, 1283,
+	1287, 7, 87, 2, 2, 1284, 1287, 5, 184, 93, 2, 1285, 1287, 5, 144, 73, 2,
+	1286, 1280, 3, 2, 2, 2, 1286, 1281, 3, 2, 2, 2, 1286, 1282, 3, 2, 2, 2,
+	1286, 1283, 3, 2, 2, 2, 1286, 1284, 3, 2, 2, 2, 1286, 1285, 3, 2, 2, 2,
+	1287, 141, 3, 2, 2, 2, 1288, 1289, 9, 4, 2, 2, 1289, 143, 3, 2, 2, 2, 1290,
+	1292, 7, 10, 2, 2, 1291, 1293, 7, 131, 2, 2, 1292, 1291, 3, 2, 2, 2, 1292,
+	1293, 3, 2, 2, 2, 1293, 1311, 3, 2, 2, 2, 1294, 1296, 5, 102, 52, 2, 1295,
+	1297, 7, 131, 2, 2, 1296, 1295, 3, 2, 2, 2, 1296, 1297, 3, 2, 2, 2, 1297,
+	1308, 3, 2, 2, 2, 1298, 1300, 7, 4, 2, 2, 1299, 1301, 7, 131, 2, 2, 1300,
+	1299, 3, 2, 2, 2, 1300, 1301, 3, 2, 2, 2, 1301, 1302, 3, 2, 2, 2, 1302,
+	1304, 5, 102, 52, 2, 1303, 1305, 7, 131, 2, 2, 1304, 1303, 3, 2, 2, 2,
+	1304, 1305, 3, 2, 2, 2, 1305, 1307, 3, 2, 2, 2, 1306, 1298, 3, 2, 2, 2,
+	1307, 1310, 3, 2, 2, 2, 1308, 1306, 3, 2, 2, 2, 1308, 1309, 3, 2, 2, 2,
+	1309, 1312, 3, 2, 2, 2, 1310, 1308, 3, 2, 2, 2, 1311, 1294, 3, 2, 2, 2,
+	1311, 1312, 3, 2, 2, 2, 1312, 1313, 3, 2, 2, 2, 1313, 1314, 7, 11, 2, 2,
+	1314, 145, 3, 2, 2, 2, 1315, 1317, 7, 5, 2, 2, 1316, 1318, 7, 131, 2, 2,
+	1317, 1316, 3, 2, 2, 2, 1317, 1318, 3, 2, 2, 2, 1318, 1319, 3, 2, 2, 2,
+	1319, 1346, 5, 114, 58, 2, 1320, 1322, 7, 24, 2, 2, 1321, 1323, 7, 131,
+	2, 2, 1322, 1321, 3, 2, 2, 2, 1322, 1323, 3, 2, 2, 2, 1323, 1324, 3, 2,
+	2, 2, 1324, 1346, 5, 114, 58, 2, 1325, 1327, 7, 25, 2, 2, 1326, 1328, 7,
+	131, 2, 2, 1327, 1326, 3, 2, 2, 2, 1327, 1328, 3, 2, 2, 2, 1328, 1329,
+	3, 2, 2, 2, 1329, 1346, 5, 114, 58, 2, 1330, 1332, 7, 26, 2, 2, 1331, 1333,
+	7, 131, 2, 2, 1332, 1331, 3, 2, 2, 2, 1332, 1333, 3, 2, 2, 2, 1333, 1334,
+	3, 2, 2, 2, 1334, 1346, 5, 114, 58, 2, 1335, 1337, 7, 27, 2, 2, 1336, 1338,
+	7, 131, 2, 2, 1337, 1336, 3, 2, 2, 2, 1337, 1338, 3, 2, 2, 2, 1338, 1339,
+	3, 2, 2, 2, 1339, 1346, 5, 114, 58, 2, 1340, 1342, 7, 28, 2, 2, 1341, 1343,
+	7, 131, 2, 2, 1342, 1341, 3, 2, 2, 2, 1342, 1343, 3, 2, 2, 2, 1343, 1344,
+	3, 2, 2, 2, 1344, 1346, 5, 114, 58, 2, 1345, 1315, 3, 2, 2, 2, 1345, 1320,
+	3, 2, 2, 2, 1345, 1325, 3, 2, 2, 2, 1345, 1330, 3, 2, 2, 2, 1345, 1335,
+	3, 2, 2, 2, 1345, 1340, 3, 2, 2, 2, 1346, 147, 3, 2, 2, 2, 1347, 1349,
+	7, 8, 2, 2, 1348, 1350, 7, 131, 2, 2, 1349, 1348, 3, 2, 2, 2, 1349, 1350,
+	3, 2, 2, 2, 1350, 1351, 3, 2, 2, 2, 1351, 1353, 5, 102, 52, 2, 1352, 1354,
+	7, 131, 2, 2, 1353, 1352, 3, 2, 2, 2, 1353, 1354, 3, 2, 2, 2, 1354, 1355,
+	3, 2, 2, 2, 1355, 1356, 7, 9, 2, 2, 1356, 149, 3, 2, 2, 2, 1357, 1362,
+	5, 80, 41, 2, 1358, 1360, 7, 131, 2, 2, 1359, 1358, 3, 2, 2, 2, 1359, 1360,
+	3, 2, 2, 2, 1360, 1361, 3, 2, 2, 2, 1361, 1363, 5, 82, 42, 2, 1362, 1359,
+	3, 2, 2, 2, 1363, 1364, 3, 2, 2, 2, 1364, 1362, 3, 2, 2, 2, 1364, 1365,
+	3, 2, 2, 2, 1365, 151, 3, 2, 2, 2, 1366, 1371, 5, 154, 78, 2, 1367, 1369,
+	7, 131, 2, 2, 1368, 1367, 3, 2, 2, 2, 1368, 1369, 3, 2, 2, 2, 1369, 1370,
+	3, 2, 2, 2, 1370, 1372, 5, 68, 35, 2, 1371, 1368, 3, 2, 2, 2, 1371, 1372,
+	3, 2, 2, 2, 1372, 153, 3, 2, 2, 2, 1373, 1374, 5, 180, 91, 2, 1374, 1375,
+	7, 131, 2, 2, 1375, 1376, 7, 82, 2, 2, 1376, 1377, 7, 131, 2, 2, 1377,
+	1378, 5, 102, 52, 2, 1378, 155, 3, 2, 2, 2, 1379, 1381, 5, 158, 80, 2,
+	1380, 1382, 7, 131, 2, 2, 1381, 1380, 3, 2, 2, 2, 1381, 1382, 3, 2, 2,
+	2, 1382, 1383, 3, 2, 2, 2, 1383, 1385, 7, 8, 2, 2, 1384, 1386, 7, 131,
+	2, 2, 1385, 1384, 3, 2, 2, 2, 1385, 1386, 3, 2, 2, 2, 1386, 1391, 3, 2,
+	2, 2, 1387, 1389, 7, 66, 2, 2, 1388, 1390, 7, 131, 2, 2, 1389, 1388, 3,
+	2, 2, 2, 1389, 1390, 3, 2, 2, 2, 1390, 1392, 3, 2, 2, 2, 1391, 1387, 3,
+	2, 2, 2, 1391, 1392, 3, 2, 2, 2, 1392, 1410, 3, 2, 2, 2, 1393, 1395, 5,
+	102, 52, 2, 1394, 1396, 7, 131, 2, 2, 1395, 1394, 3, 2, 2, 2, 1395, 1396,
+	3, 2, 2, 2, 1396, 1407, 3, 2, 2, 2, 1397, 1399, 7, 4, 2, 2, 1398, 1400,
+	7, 131, 2, 2, 1399, 1398, 3, 2, 2, 2, 1399, 1400, 3, 2, 2, 2, 1400, 1401,
+	3, 2, 2, 2, 1401, 1403, 5, 102, 52, 2, 1402, 1404, 7, 131, 2, 2, 1403,
+	1402, 3, 2, 2, 2, 1403, 1404, 3, 2, 2, 2, 1404, 1406, 3, 2, 2, 2, 1405,
+	1397, 3, 2, 2, 2, 1406, 1409, 3, 2, 2, 2, 1407, 1405, 3, 2, 2, 2, 1407,
+	1408, 3, 2, 2, 2, 1408, 1411, 3, 2, 2, 2, 1409, 1407, 3, 2, 2, 2, 1410,
+	1393, 3, 2, 2, 2, 1410, 1411, 3, 2, 2, 2, 1411, 1412, 3, 2, 2, 2, 1412,
+	1413, 7, 9, 2, 2, 1413, 157, 3, 2, 2, 2, 1414, 1415, 5, 168, 85, 2, 1415,
+	1416, 5, 200, 101, 2, 1416, 1419, 3, 2, 2, 2, 1417, 1419, 7, 95, 2, 2,
+	1418, 1414, 3, 2, 2, 2, 1418, 1417, 3, 2, 2, 2, 1419, 159, 3, 2, 2, 2,
+	1420, 1422, 5, 166, 84, 2, 1421, 1423, 7, 131, 2, 2, 1422, 1421, 3, 2,
+	2, 2, 1422, 1423, 3, 2, 2, 2, 1423, 1424, 3, 2, 2, 2, 1424, 1426, 7, 8,
+	2, 2, 1425, 1427, 7, 131, 2, 2, 1426, 1425, 3, 2, 2, 2, 1426, 1427, 3,
+	2, 2, 2, 1427, 1445, 3, 2, 2, 2, 1428, 1430, 5, 102, 52, 2, 1429, 1431,
+	7, 131, 2, 2, 1430, 1429, 3, 2, 2, 2, 1430, 1431, 3, 2, 2, 2, 1431, 1442,
+	3, 2, 2, 2, 1432, 1434, 7, 4, 2, 2, 1433, 1435, 7, 131, 2, 2, 1434, 1433,
+	3, 2, 2, 2, 1434, 1435, 3, 2, 2, 2, 1435, 1436, 3, 2, 2, 2, 1436, 1438,
+	5, 102, 52, 2, 1437, 1439, 7, 131, 2, 2, 1438, 1437, 3, 2, 2, 2, 1438,
+	1439, 3, 2, 2, 2, 1439, 1441, 3, 2, 2, 2, 1440, 1432, 3, 2, 2, 2, 1441,
+	1444, 3, 2, 2, 2, 1442, 1440, 3, 2, 2, 2, 1442, 1443, 3, 2, 2, 2, 1443,
+	1446, 3, 2, 2, 2, 1444, 1442, 3, 2, 2, 2, 1445, 1428, 3, 2, 2, 2, 1445,
+	1446, 3, 2, 2, 2, 1446, 1447, 3, 2, 2, 2, 1447, 1448, 7, 9, 2, 2, 1448,
+	161, 3, 2, 2, 2, 1449, 1450, 5, 166, 84, 2, 1450, 163, 3, 2, 2, 2, 1451,
+	1452, 5, 200, 101, 2, 1452, 165, 3, 2, 2, 2, 1453, 1454, 5, 168, 85, 2,
+	1454, 1455, 5, 200, 101, 2, 1455, 167, 3, 2, 2, 2, 1456, 1457, 5, 200,
+	101, 2, 1457, 1458, 7, 23, 2, 2, 1458, 1460, 3, 2, 2, 2, 1459, 1456, 3,
+	2, 2, 2, 1460, 1463, 3, 2, 2, 2, 1461, 1459, 3, 2, 2, 2, 1461, 1462, 3,
+	2, 2, 2, 1462, 169, 3, 2, 2, 2, 1463, 1461, 3, 2, 2, 2, 1464, 1466, 7,
+	10, 2, 2, 1465, 1467, 7, 131, 2, 2, 1466, 1465, 3, 2, 2, 2, 1466, 1467,
+	3, 2, 2, 2, 1467, 1468, 3, 2, 2, 2, 1468, 1477, 5, 152, 77, 2, 1469, 1471,
+	7, 131, 2, 2, 1470, 1469, 3, 2, 2, 2, 1470, 1471, 3, 2, 2, 2, 1471, 1472,
+	3, 2, 2, 2, 1472, 1474, 7, 13, 2, 2, 1473, 1475, 7, 131, 2, 2, 1474, 1473,
+	3, 2, 2, 2, 1474, 1475, 3, 2, 2, 2, 1475, 1476, 3, 2, 2, 2, 1476, 1478,
+	5, 102, 52, 2, 1477, 1470, 3, 2, 2, 2, 1477, 1478, 3, 2, 2, 2, 1478, 1480,
+	3, 2, 2, 2, 1479, 1481, 7, 131, 2, 2, 1480, 1479, 3, 2, 2, 2, 1480, 1481,
+	3, 2, 2, 2, 1481, 1482, 3, 2, 2, 2, 1482, 1483, 7, 11, 2, 2, 1483, 171,
+	3, 2, 2, 2, 1484, 1486, 7, 10, 2, 2, 1485, 1487, 7, 131, 2, 2, 1486, 1485,
+	3, 2, 2, 2, 1486, 1487, 3, 2, 2, 2, 1487, 1496, 3, 2, 2, 2, 1488, 1490,
+	5, 180, 91, 2, 1489, 1491, 7, 131, 2, 2, 1490, 1489, 3, 2, 2, 2, 1490,
+	1491, 3, 2, 2, 2, 1491, 1492, 3, 2, 2, 2, 1492, 1494, 7, 5, 2, 2, 1493,
+	1495, 7, 131, 2, 2, 1494, 1493, 3, 2, 2, 2, 1494, 1495, 3, 2, 2, 2, 1495,
+	1497, 3, 2, 2, 2, 1496, 1488, 3, 2, 2, 2, 1496, 1497, 3, 2, 2, 2, 1497,
+	1498, 3, 2, 2, 2, 1498, 1500, 5, 150, 76, 2, 1499, 1501, 7, 131, 2, 2,
+	1500, 1499, 3, 2, 2, 2, 1500, 1501, 3, 2, 2, 2, 1501, 1510, 3, 2, 2, 2,
+	1502, 1504, 7, 75, 2, 2, 1503, 1505, 7, 131, 2, 2, 1504, 1503, 3, 2, 2,
+	2, 1504, 1505, 3, 2, 2, 2, 1505, 1506, 3, 2, 2, 2, 1506, 1508, 5, 102,
+	52, 2, 1507, 1509, 7, 131, 2, 2, 1508, 1507, 3, 2, 2, 2, 1508, 1509, 3,
+	2, 2, 2, 1509, 1511, 3, 2, 2, 2, 1510, 1502, 3, 2, 2, 2, 1510, 1511, 3,
+	2, 2, 2, 1511, 1512, 3, 2, 2, 2, 1512, 1514, 7, 13, 2, 2, 1513, 1515, 7,
+	131, 2, 2, 1514, 1513, 3, 2, 2, 2, 1514, 1515, 3, 2, 2, 2, 1515, 1516,
+	3, 2, 2, 2, 1516, 1518, 5, 102, 52, 2, 1517, 1519, 7, 131, 2, 2, 1518,
+	1517, 3, 2, 2, 2, 1518, 1519, 3, 2, 2, 2, 1519, 1520, 3, 2, 2, 2, 1520,
+	1521, 7, 11, 2, 2, 1521, 173, 3, 2, 2, 2, 1522, 1524, 7, 23, 2, 2, 1523,
+	1525, 7, 131, 2, 2, 1524, 1523, 3, 2, 2, 2, 1524, 1525, 3, 2, 2, 2, 1525,
+	1526, 3, 2, 2, 2, 1526, 1527, 5, 190, 96, 2, 1527, 175, 3, 2, 2, 2, 1528,
+	1533, 7, 96, 2, 2, 1529, 1531, 7, 131, 2, 2, 1530, 1529, 3, 2, 2, 2, 1530,
+	1531, 3, 2, 2, 2, 1531, 1532, 3, 2, 2, 2, 1532, 1534, 5, 178, 90, 2, 1533,
+	1530, 3, 2, 2, 2, 1534, 1535, 3, 2, 2, 2, 1535, 1533, 3, 2, 2, 2, 1535,
+	1536, 3, 2, 2, 2, 1536, 1551, 3, 2, 2, 2, 1537, 1539, 7, 96, 2, 2, 1538,
+	1540, 7, 131, 2, 2, 1539, 1538, 3, 2, 2, 2, 1539, 1540, 3, 2, 2, 2, 1540,
+	1541, 3, 2, 2, 2, 1541, 1546, 5, 102, 52, 2, 1542, 1544, 7, 131, 2, 2,
+	1543, 1542, 3, 2, 2, 2, 1543, 1544, 3, 2, 2, 2, 1544, 1545, 3, 2, 2, 2,
+	1545, 1547, 5, 178, 90, 2, 1546, 1543, 3, 2, 2, 2, 1547, 1548, 3, 2, 2,
+	2, 1548, 1546, 3, 2, 2, 2, 1548, 1549, 3, 2, 2, 2, 1549, 1551, 3, 2, 2,
+	2, 1550, 1528, 3, 2, 2, 2, 1550, 1537, 3, 2, 2, 2, 1551, 1560, 3, 2, 2,
+	2, 1552, 1554, 7, 131, 2, 2, 1553, 1552, 3, 2, 2, 2, 1553, 1554, 3, 2,
+	2, 2, 1554, 1555, 3, 2, 2, 2, 1555, 1557, 7, 97, 2, 2, 1556, 1558, 7, 131,
+	2, 2, 1557, 1556, 3, 2, 2, 2, 1557, 1558, 3, 2, 2, 2, 1558, 1559, 3, 2,
+	2, 2, 1559, 1561, 5, 102, 52, 2, 1560, 1553, 3, 2, 2, 2, 1560, 1561, 3,
+	2, 2, 2, 1561, 1563, 3, 2, 2, 2, 1562, 1564, 7, 131, 2, 2, 1563, 1562,
+	3, 2, 2, 2, 1563, 1564, 3, 2, 2, 2, 1564, 1565, 3, 2, 2, 2, 1565, 1566,
+	7, 98, 2, 2, 1566, 177, 3, 2, 2, 2, 1567, 1569, 7, 99, 2, 2, 1568, 1570,
+	7, 131, 2, 2, 1569, 1568, 3, 2, 2, 2, 1569, 1570, 3, 2, 2, 2, 1570, 1571,
+	3, 2, 2, 2, 1571, 1573, 5, 102, 52, 2, 1572, 1574, 7, 131, 2, 2, 1573,
+	1572, 3, 2, 2, 2, 1573, 1574, 3, 2, 2, 2, 1574, 1575, 3, 2, 2, 2, 1575,
+	1577, 7, 100, 2, 2, 1576, 1578, 7, 131, 2, 2, 1577, 1576, 3, 2, 2, 2, 1577,
+	1578, 3, 2, 2, 2, 1578, 1579, 3, 2, 2, 2, 1579, 1580, 5, 102, 52, 2, 1580,
+	179, 3, 2, 2, 2, 1581, 1582, 5, 200, 101, 2, 1582, 181, 3, 2, 2, 2, 1583,
+	1586, 5, 194, 98, 2, 1584, 1586, 5, 192, 97, 2, 1585, 1583, 3, 2, 2, 2,
+	1585, 1584, 3, 2, 2, 2, 1586, 183, 3, 2, 2, 2, 1587, 1589, 7, 21, 2, 2,
+	1588, 1590, 7, 131, 2, 2, 1589, 1588, 3, 2, 2, 2, 1589, 1590, 3, 2, 2,
+	2, 1590, 1624, 3, 2, 2, 2, 1591, 1593, 5, 190, 96, 2, 1592, 1594, 7, 131,
+	2, 2, 1593, 1592, 3, 2, 2, 2, 1593, 1594, 3, 2, 2, 2, 1594, 1595, 3, 2,
+	2, 2, 1595, 1597, 7, 12, 2, 2, 1596, 1598, 7, 131, 2, 2, 1597, 1596, 3,
+	2, 2, 2, 1597, 1598, 3, 2, 2, 2, 1598, 1599, 3, 2, 2, 2, 1599, 1601, 5,
+	102, 52, 2, 1600, 1602, 7, 131, 2, 2, 1601, 1600, 3, 2, 2, 2, 1601, 1602,
+	3, 2, 2, 2, 1602, 1621, 3, 2, 2, 2, 1603, 1605, 7, 4, 2, 2, 1604, 1606,
+	7, 131, 2, 2, 1605, 1604, 3, 2, 2, 2, 1605, 1606, 3, 2, 2, 2, 1606, 1607,
+	3, 2, 2, 2, 1607, 1609, 5, 190, 96, 2, 1608, 1610, 7, 131, 2, 2, 1609,
+	1608, 3, 2, 2, 2, 1609, 1610, 3, 2, 2, 2, 1610, 1611, 3, 2, 2, 2, 1611,
+	1613, 7, 12, 2, 2, 1612, 1614, 7, 131, 2, 2, 1613, 1612, 3, 2, 2, 2, 1613,
+	1614, 3, 2, 2, 2, 1614, 1615, 3, 2, 2, 2, 1615, 1617, 5, 102, 52, 2, 1616,
+	1618, 7, 131, 2, 2, 1617, 1616, 3, 2, 2, 2, 1617, 1618, 3, 2, 2, 2, 1618,
+	1620, 3, 2, 2, 2, 1619, 1603, 3, 2, 2, 2, 1620, 1623, 3, 2, 2, 2, 1621,
+	1619, 3, 2, 2, 2, 1621, 1622, 3, 2, 2, 2, 1622, 1625, 3, 2, 2, 2, 1623,
+	1621, 3, 2, 2, 2, 1624, 1591, 3, 2, 2, 2, 1624, 1625, 3, 2, 2, 2, 1625,
+	1626, 3, 2, 2, 2, 1626, 1627, 7, 22, 2, 2, 1627, 185, 3, 2, 2, 2, 1628,
+	1631, 7, 29, 2, 2, 1629, 1632, 5, 200, 101, 2, 1630, 1632, 7, 104, 2, 2,
+	1631, 1629, 3, 2, 2, 2, 1631, 1630, 3, 2, 2, 2, 1632, 187, 3, 2, 2, 2,
+	1633, 1638, 5, 132, 67, 2, 1634, 1636, 7, 131, 2, 2, 1635, 1634, 3, 2,
+	2, 2, 1635, 1636, 3, 2, 2, 2, 1636, 1637, 3, 2, 2, 2, 1637, 1639, 5, 174,
+	88, 2, 1638, 1635, 3, 2, 2, 2, 1639, 1640, 3, 2, 2, 2, 1640, 1638, 3, 2,
+	2, 2, 1640, 1641, 3, 2, 2, 2, 1641, 189, 3, 2, 2, 2, 1642, 1643, 5, 196,
+	99, 2, 1643, 191, 3, 2, 2, 2, 1644, 1645, 9, 5, 2, 2, 1645, 193, 3, 2,
+	2, 2, 1646, 1647, 9, 6, 2, 2, 1647, 195, 3, 2, 2, 2, 1648, 1651, 5, 200,
+	101, 2, 1649, 1651, 5, 198, 100, 2, 1650, 1648, 3, 2, 2, 2, 1650, 1649,
+	3, 2, 2, 2, 1651, 197, 3, 2, 2, 2, 1652, 1653, 9, 7, 2, 2, 1653, 199, 3,
+	2, 2, 2, 1654, 1655, 9, 8, 2, 2, 1655, 201, 3, 2, 2, 2, 1656, 1657, 9,
+	9, 2, 2, 1657, 203, 3, 2, 2, 2, 1658, 1659, 9, 10, 2, 2, 1659, 205, 3,
+	2, 2, 2, 1660, 1661, 9, 11, 2, 2, 1661, 207, 3, 2, 2, 2, 310, 209, 213,
+	216, 219, 227, 231, 236, 243, 248, 251, 255, 259, 263, 269, 273, 278, 283,
+	287, 290, 292, 296, 300, 305, 309, 314, 318, 327, 332, 336, 340, 344, 347,
+	351, 361, 368, 381, 385, 391, 395, 399, 404, 409, 413, 419, 423, 429, 433,
+	439, 443, 447, 451, 455, 459, 464, 471, 475, 480, 487, 493, 498, 504, 510,
+	515, 519, 524, 527, 530, 533, 540, 547, 550, 556, 559, 565, 569, 573, 577,
+	581, 586, 591, 595, 600, 603, 612, 621, 626, 639, 642, 650, 654, 659, 664,
+	668, 673, 677, 689, 693, 698, 705, 709, 713, 715, 719, 721, 725, 727, 733,
+	739, 743, 746, 749, 753, 759, 763, 766, 769, 775, 778, 781, 785, 791, 794,
+	797, 801, 805, 809, 811, 815, 817, 820, 824, 826, 832, 836, 840, 844, 847,
+	852, 857, 862, 867, 873, 877, 879, 883, 887, 889, 891, 906, 916, 926, 931,
+	935, 942, 947, 952, 956, 960, 964, 967, 969, 974, 978, 982, 986, 990, 994,
+	997, 999, 1004, 1008, 1013, 1018, 1022, 1031, 1033, 1039, 1043, 1050, 1054,
+	1058, 1061, 1074, 1077, 1080, 1094, 1098, 1103, 1107, 1110, 1117, 1121,
+	1125, 1132, 1136, 1140, 1146, 1150, 1154, 1160, 1164, 1168, 1174, 1178,
+	1182, 1192, 1196, 1200, 1204, 1208, 1212, 1216, 1220, 1224, 1228, 1234,
+	1238, 1242, 1246, 1250, 1254, 1257, 1264, 1268, 1275, 1278, 1286, 1292,
+	1296, 1300, 1304, 1308, 1311, 1317, 1322, 1327, 1332, 1337, 1342, 1345,
+	1349, 1353, 1359, 1364, 1368, 1371, 1381, 1385, 1389, 1391, 1395, 1399,
+	1403, 1407, 1410, 1418, 1422, 1426, 1430, 1434, 1438, 1442, 1445, 1461,
+	1466, 1470, 1474, 1477, 1480, 1486, 1490, 1494, 1496, 1500, 1504, 1508,
+	1510, 1514, 1518, 1524, 1530, 1535, 1539, 1543, 1548, 1550, 1553, 1557,
+	1560, 1563, 1569, 1573, 1577, 1585, 1589, 1593, 1597, 1601, 1605, 1609,
+	1613, 1617, 1621, 1624, 1631, 1635, 1640, 1650,
 }
 var literalNames = []string{
 	"", "';'", "','", "'='", "'+='", "'*'", "'('", "')'", "'['", "']'", "':'",
-	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'", "'<'", "'>'",
-	"'<='", "'>='", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
-	"'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'", "'\u00AD'",
-	"'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'", "'\u2015'",
-	"'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "", "", "", "",
+	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'=~'", "'{'", "'}'",
+	"'.'", "'<>'", "'<'", "'>'", "'<='", "'>='", "'$'", "'\u27E8'", "'\u3008'",
+	"'\uFE64'", "'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'",
+	"'\u00AD'", "'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'",
+	"'\u2015'", "'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"'0'",
+	"", "", "", "", "", "", "'0'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL", "MATCH",
-	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
-	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
-	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
-	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
-	"FILTER", "EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
+	"", "", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL",
+	"MATCH", "UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE",
+	"REMOVE", "CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "SHORTESTPATH",
+	"ALLSHORTESTPATHS", "OR", "XOR", "AND", "NOT", "IN", "STARTS", "ENDS",
+	"CONTAINS", "IS", "NULL", "REDUCE", "COUNT", "ANY", "NONE", "SINGLE", "TRUE",
+	"FALSE", "EXISTS", "CASE", "ELSE", "END", "WHEN", "THEN", "StringLiteral",
+	"EscapedChar", "HexInteger", "DecimalInteger", "OctalInteger", "HexLetter",
+	"HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit", "OctDigit", "ZeroDigit",
+	"ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT", "DO", "FOR",
+	"REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP", "FILTER",
+	"EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
 	"EscapedSymbolicName", "SP", "WHITESPACE", "Comment",
 }
 
@@ -831,21 +895,22 @@ var ruleNames = []string{
 	"oC_InQueryCall", "oC_StandaloneCall", "oC_YieldItems", "oC_YieldItem",
 	"oC_With", "oC_Return", "oC_ProjectionBody", "oC_ProjectionItems", "oC_ProjectionItem",
 	"oC_Order", "oC_Skip", "oC_Limit", "oC_SortItem", "oC_Where", "oC_Pattern",
-	"oC_PatternPart", "oC_AnonymousPatternPart", "oC_PatternElement", "oC_NodePattern",
-	"oC_PatternElementChain", "oC_RelationshipPattern", "oC_RelationshipDetail",
-	"oC_Properties", "oC_RelationshipTypes", "oC_NodeLabels", "oC_NodeLabel",
-	"oC_RangeLiteral", "oC_LabelName", "oC_RelTypeName", "oC_Expression", "oC_OrExpression",
-	"oC_XorExpression", "oC_AndExpression", "oC_NotExpression", "oC_ComparisonExpression",
-	"oC_AddOrSubtractExpression", "oC_MultiplyDivideModuloExpression", "oC_PowerOfExpression",
-	"oC_UnaryAddOrSubtractExpression", "oC_StringListNullOperatorExpression",
+	"oC_PatternPart", "oC_AnonymousPatternPart", "oC_ShortestPathPattern",
+	"oC_PatternElement", "oC_NodePattern", "oC_PatternElementChain", "oC_RelationshipPattern",
+	"oC_RelationshipDetail", "oC_Properties", "oC_RelationshipTypes", "oC_NodeLabels",
+	"oC_NodeLabel", "oC_RangeLiteral", "oC_LabelName", "oC_RelTypeName", "oC_Expression",
+	"oC_OrExpression", "oC_XorExpression", "oC_AndExpression", "oC_NotExpression",
+	"oC_ComparisonExpression", "oC_AddOrSubtractExpression", "oC_MultiplyDivideModuloExpression",
+	"oC_PowerOfExpression", "oC_UnaryAddOrSubtractExpression", "oC_StringListNullOperatorExpression",
 	"oC_ListOperatorExpression", "oC_StringOperatorExpression", "oC_NullOperatorExpression",
-	"oC_PropertyOrLabelsExpression", "oC_Atom", "oC_Literal", "oC_BooleanLiteral",
-	"oC_ListLiteral", "oC_PartialComparisonExpression", "oC_ParenthesizedExpression",
-	"oC_RelationshipsPattern", "oC_FilterExpression", "oC_IdInColl", "oC_FunctionInvocation",
-	"oC_FunctionName", "oC_ExplicitProcedureInvocation", "oC_ImplicitProcedureInvocation",
-	"oC_ProcedureResultField", "oC_ProcedureName", "oC_Namespace", "oC_ListComprehension",
-	"oC_PatternComprehension", "oC_PropertyLookup", "oC_CaseExpression", "oC_CaseAlternatives",
-	"oC_Variable", "oC_NumberLiteral", "oC_MapLiteral", "oC_Parameter", "oC_PropertyExpression",
+	"oC_PropertyOrLabelsExpression", "oC_Atom", "oC_Reduce", "oC_MapProjection",
+	"oC_MapProjectionItem", "oC_Literal", "oC_BooleanLiteral", "oC_ListLiteral",
+	"oC_PartialComparisonExpression", "oC_ParenthesizedExpression", "oC_RelationshipsPattern",
+	"oC_FilterExpression", "oC_IdInColl", "oC_FunctionInvocation", "oC_FunctionName",
+	"oC_ExplicitProcedureInvocation", "oC_ImplicitProcedureInvocation", "oC_ProcedureResultField",
+	"oC_ProcedureName", "oC_Namespace", "oC_ListComprehension", "oC_PatternComprehension",
+	"oC_PropertyLookup", "oC_CaseExpression", "oC_CaseAlternatives", "oC_Variable",
+	"oC_NumberLiteral", "oC_MapLiteral", "oC_Parameter", "oC_PropertyExpression",
 	"oC_PropertyKeyName", "oC_IntegerLiteral", "oC_DoubleLiteral", "oC_SchemaName",
 	"oC_ReservedWord", "oC_SymbolicName", "oC_LeftArrowHead", "oC_RightArrowHead",
 	"oC_Dash",
@@ -928,88 +993,92 @@ const (
 	CypherParserT__42                 = 43
 	CypherParserT__43                 = 44
 	CypherParserT__44                 = 45
-	CypherParserUNION                 = 46
-	CypherParserALL                   = 47
-	CypherParserOPTIONAL              = 48
-	CypherParserMATCH                 = 49
-	CypherParserUNWIND                = 50
-	CypherParserAS                    = 51
-	CypherParserMERGE                 = 52
-	CypherParserON                    = 53
-	CypherParserCREATE                = 54
-	CypherParserSET                   = 55
-	CypherParserDETACH                = 56
-	CypherParserDELETE                = 57
-	CypherParserREMOVE                = 58
-	CypherParserCALL                  = 59
-	CypherParserYIELD                 = 60
-	CypherParserWITH                  = 61
-	CypherParserRETURN                = 62
-	CypherParserDISTINCT              = 63
-	CypherParserORDER                 = 64
-	CypherParserBY                    = 65
-	CypherParserL_SKIP                = 66
-	CypherParserLIMIT                 = 67
-	CypherParserASCENDING             = 68
-	CypherParserASC                   = 69
-	CypherParserDESCENDING            = 70
-	CypherParserDESC                  = 71
-	CypherParserWHERE                 = 72
-	CypherParserOR                    = 73
-	CypherParserXOR                   = 74
-	CypherParserAND                   = 75
-	CypherParserNOT                   = 76
-	CypherParserIN                    = 77
-	CypherParserSTARTS                = 78
-	CypherParserENDS                  = 79
-	CypherParserCONTAINS              = 80
-	CypherParserIS                    = 81
-	CypherParserNULL                  = 82
-	CypherParserCOUNT                 = 83
-	CypherParserANY                   = 84
-	CypherParserNONE                  = 85
-	CypherParserSINGLE                = 86
-	CypherParserTRUE                  = 87
-	CypherParserFALSE                 = 88
-	CypherParserEXISTS                = 89
-	CypherParserCASE                  = 90
-	CypherParserELSE                  = 91
-	CypherParserEND                   = 92
-	CypherParserWHEN                  = 93
-	CypherParserTHEN                  = 94
-	CypherParserStringLiteral         = 95
-	CypherParserEscapedChar           = 96
-	CypherParserHexInteger            = 97
-	CypherParserDecimalInteger        = 98
-	CypherParserOctalInteger          = 99
-	CypherParserHexLetter             = 100
-	CypherParserHexDigit              = 101
-	CypherParserDigit                 = 102
-	CypherParserNonZeroDigit          = 103
-	CypherParserNonZeroOctDigit       = 104
-	CypherParserOctDigit              = 105
-	CypherParserZeroDigit             = 106
-	CypherParserExponentDecimalReal   = 107
-	CypherParserRegularDecimalReal    = 108
-	CypherParserCONSTRAINT            = 109
-	CypherParserDO                    = 110
-	CypherParserFOR                   = 111
-	CypherParserREQUIRE               = 112
-	CypherParserUNIQUE                = 113
-	CypherParserMANDATORY             = 114
-	CypherParserSCALAR                = 115
-	CypherParserOF                    = 116
-	CypherParserADD                   = 117
-	CypherParserDROP                  = 118
-	CypherParserFILTER                = 119
-	CypherParserEXTRACT               = 120
-	CypherParserUnescapedSymbolicName = 121
-	CypherParserIdentifierStart       = 122
-	CypherParserIdentifierPart        = 123
-	CypherParserEscapedSymbolicName   = 124
-	CypherParserSP                    = 125
-	CypherParserWHITESPACE            = 126
-	CypherParserComment               = 127
+	CypherParserT__45                 = 46
+	CypherParserUNION                 = 47
+	CypherParserALL                   = 48
+	CypherParserOPTIONAL              = 49
+	CypherParserMATCH                 = 50
+	CypherParserUNWIND                = 51
+	CypherParserAS                    = 52
+	CypherParserMERGE                 = 53
+	CypherParserON                    = 54
+	CypherParserCREATE                = 55
+	CypherParserSET                   = 56
+	CypherParserDETACH                = 57
+	CypherParserDELETE                = 58
+	CypherParserREMOVE                = 59
+	CypherParserCALL                  = 60
+	CypherParserYIELD                 = 61
+	CypherParserWITH                  = 62
+	CypherParserRETURN                = 63
+	CypherParserDISTINCT              = 64
+	CypherParserORDER                 = 65
+	CypherParserBY                    = 66
+	CypherParserL_SKIP                = 67
+	CypherParserLIMIT                 = 68
+	CypherParserASCENDING             = 69
+	CypherParserASC                   = 70
+	CypherParserDESCENDING            = 71
+	CypherParserDESC                  = 72
+	CypherParserWHERE                 = 73
+	CypherParserSHORTESTPATH          = 74
+	CypherParserALLSHORTESTPATHS      = 75
+	CypherParserOR                    = 76
+	CypherParserXOR                   = 77
+	CypherParserAND                   = 78
+	CypherParserNOT                   = 79
+	CypherParserIN                    = 80
+	CypherParserSTARTS                = 81
+	CypherParserENDS                  = 82
+	CypherParserCONTAINS              = 83
+	CypherParserIS                    = 84
+	CypherParserNULL                  = 85
+	CypherParserREDUCE                = 86
+	CypherParserCOUNT                 = 87
+	CypherParserANY                   = 88
+	CypherParserNONE                  = 89
+	CypherParserSINGLE                = 90
+	CypherParserTRUE                  = 91
+	CypherParserFALSE                 = 92
+	CypherParserEXISTS                = 93
+	CypherParserCASE                  = 94
+	CypherParserELSE                  = 95
+	CypherParserEND                   = 96
+	CypherParserWHEN                  = 97
+	CypherParserTHEN                  = 98
+	CypherParserStringLiteral         = 99
+	CypherParserEscapedChar           = 100
+	CypherParserHexInteger            = 101
+	CypherParserDecimalInteger        = 102
+	CypherParserOctalInteger          = 103
+	CypherParserHexLetter             = 104
+	CypherParserHexDigit              = 105
+	CypherParserDigit                 = 106
+	CypherParserNonZeroDigit          = 107
+	CypherParserNonZeroOctDigit       = 108
+	CypherParserOctDigit              = 109
+	CypherParserZeroDigit             = 110
+	CypherParserExponentDecimalReal   = 111
+	CypherParserRegularDecimalReal    = 112
+	CypherParserCONSTRAINT            = 113
+	CypherParserDO                    = 114
+	CypherParserFOR                   = 115
+	CypherParserREQUIRE               = 116
+	CypherParserUNIQUE                = 117
+	CypherParserMANDATORY             = 118
+	CypherParserSCALAR                = 119
+	CypherParserOF                    = 120
+	CypherParserADD                   = 121
+	CypherParserDROP                  = 122
+	CypherParserFILTER                = 123
+	CypherParserEXTRACT               = 124
+	CypherParserUnescapedSymbolicName = 125
+	CypherParserIdentifierStart       = 126
+	CypherParserIdentifierPart        = 127
+	CypherParserEscapedSymbolicName   = 128
+	CypherParserSP                    = 129
+	CypherParserWHITESPACE            = 130
+	CypherParserComment               = 131
 )
 
 // CypherParser rules.
@@ -1051,68 +1120,72 @@ const (
 	CypherParserRULE_oC_Pattern                          = 34
 	CypherParserRULE_oC_PatternPart                      = 35
 	CypherParserRULE_oC_AnonymousPatternPart             = 36
-	CypherParserRULE_oC_PatternElement                   = 37
-	CypherParserRULE_oC_NodePattern                      = 38
-	CypherParserRULE_oC_PatternElementChain              = 39
-	CypherParserRULE_oC_RelationshipPattern              = 40
-	CypherParserRULE_oC_RelationshipDetail               = 41
-	CypherParserRULE_oC_Properties                       = 42
-	CypherParserRULE_oC_RelationshipTypes                = 43
-	CypherParserRULE_oC_NodeLabels                       = 44
-	CypherParserRULE_oC_NodeLabel                        = 45
-	CypherParserRULE_oC_RangeLiteral                     = 46
-	CypherParserRULE_oC_LabelName                        = 47
-	CypherParserRULE_oC_RelTypeName                      = 48
-	CypherParserRULE_oC_Expression                       = 49
-	CypherParserRULE_oC_OrExpression                     = 50
-	CypherParserRULE_oC_XorExpression                    = 51
-	CypherParserRULE_oC_AndExpression                    = 52
-	CypherParserRULE_oC_NotExpression                    = 53
-	CypherParserRULE_oC_ComparisonExpression             = 54
-	CypherParserRULE_oC_AddOrSubtractExpression          = 55
-	CypherParserRULE_oC_MultiplyDivideModuloExpression   = 56
-	CypherParserRULE_oC_PowerOfExpression                = 57
-	CypherParserRULE_oC_UnaryAddOrSubtractExpression     = 58
-	CypherParserRULE_oC_StringListNullOperatorExpression = 59
-	CypherParserRULE_oC_ListOperatorExpression           = 60
-	CypherParserRULE_oC_StringOperatorExpression         = 61
-	CypherParserRULE_oC_NullOperatorExpression           = 62
-	CypherParserRULE_oC_PropertyOrLabelsExpression       = 63
-	CypherParserRULE_oC_Atom                             = 64
-	CypherParserRULE_oC_Literal                          = 65
-	CypherParserRULE_oC_BooleanLiteral                   = 66
-	CypherParserRULE_oC_ListLiteral                      = 67
-	CypherParserRULE_oC_PartialComparisonExpression      = 68
-	CypherParserRULE_oC_ParenthesizedExpression          = 69
-	CypherParserRULE_oC_RelationshipsPattern             = 70
-	CypherParserRULE_oC_FilterExpression                 = 71
-	CypherParserRULE_oC_IdInColl                         = 72
-	CypherParserRULE_oC_FunctionInvocation               = 73
-	CypherParserRULE_oC_FunctionName                     = 74
-	CypherParserRULE_oC_ExplicitProcedureInvocation      = 75
-	CypherParserRULE_oC_ImplicitProcedureInvocation      = 76
-	CypherParserRULE_oC_ProcedureResultField             = 77
-	CypherParserRULE_oC_ProcedureName                    = 78
-	CypherParserRULE_oC_Namespace                        = 79
-	CypherParserRULE_oC_ListComprehension                = 80
-	CypherParserRULE_oC_PatternComprehension             = 81
-	CypherParserRULE_oC_PropertyLookup                   = 82
-	CypherParserRULE_oC_CaseExpression                   = 83
-	CypherParserRULE_oC_CaseAlternatives                 = 84
-	CypherParserRULE_oC_Variable                         = 85
-	CypherParserRULE_oC_NumberLiteral                    = 86
-	CypherParserRULE_oC_MapLiteral                       = 87
-	CypherParserRULE_oC_Parameter                        = 88
-	CypherParserRULE_oC_PropertyExpression               = 89
-	CypherParserRULE_oC_PropertyKeyName                  = 90
-	CypherParserRULE_oC_IntegerLiteral                   = 91
-	CypherParserRULE_oC_DoubleLiteral                    = 92
-	CypherParserRULE_oC_SchemaName                       = 93
-	CypherParserRULE_oC_ReservedWord                     = 94
-	CypherParserRULE_oC_SymbolicName                     = 95
-	CypherParserRULE_oC_LeftArrowHead                    = 96
-	CypherParserRULE_oC_RightArrowHead                   = 97
-	CypherParserRULE_oC_Dash                             = 98
+	CypherParserRULE_oC_ShortestPathPattern              = 37
+	CypherParserRULE_oC_PatternElement                   = 38
+	CypherParserRULE_oC_NodePattern                      = 39
+	CypherParserRULE_oC_PatternElementChain              = 40
+	CypherParserRULE_oC_RelationshipPattern              = 41
+	CypherParserRULE_oC_RelationshipDetail               = 42
+	CypherParserRULE_oC_Properties                       = 43
+	CypherParserRULE_oC_RelationshipTypes                = 44
+	CypherParserRULE_oC_NodeLabels                       = 45
+	CypherParserRULE_oC_NodeLabel                        = 46
+	CypherParserRULE_oC_RangeLiteral                     = 47
+	CypherParserRULE_oC_LabelName                        = 48
+	CypherParserRULE_oC_RelTypeName                      = 49
+	CypherParserRULE_oC_Expression                       = 50
+	CypherParserRULE_oC_OrExpression                     = 51
+	CypherParserRULE_oC_XorExpression                    = 52
+	CypherParserRULE_oC_AndExpression                    = 53
+	CypherParserRULE_oC_NotExpression                    = 54
+	CypherParserRULE_oC_ComparisonExpression             = 55
+	CypherParserRULE_oC_AddOrSubtractExpression          = 56
+	CypherParserRULE_oC_MultiplyDivideModuloExpression   = 57
+	CypherParserRULE_oC_PowerOfExpression                = 58
+	CypherParserRULE_oC_UnaryAddOrSubtractExpression     = 59
+	CypherParserRULE_oC_StringListNullOperatorExpression = 60
+	CypherParserRULE_oC_ListOperatorExpression           = 61
+	CypherParserRULE_oC_StringOperatorExpression         = 62
+	CypherParserRULE_oC_NullOperatorExpression           = 63
+	CypherParserRULE_oC_PropertyOrLabelsExpression       = 64
+	CypherParserRULE_oC_Atom                             = 65
+	CypherParserRULE_oC_Reduce                           = 66
+	CypherParserRULE_oC_MapProjection                    = 67
+	CypherParserRULE_oC_MapProjectionItem                = 68
+	CypherParserRULE_oC_Literal                          = 69
+	CypherParserRULE_oC_BooleanLiteral                   = 70
+	CypherParserRULE_oC_ListLiteral                      = 71
+	CypherParserRULE_oC_PartialComparisonExpression      = 72
+	CypherParserRULE_oC_ParenthesizedExpression          = 73
+	CypherParserRULE_oC_RelationshipsPattern             = 74
+	CypherParserRULE_oC_FilterExpression                 = 75
+	CypherParserRULE_oC_IdInColl                         = 76
+	CypherParserRULE_oC_FunctionInvocation               = 77
+	CypherParserRULE_oC_FunctionName                     = 78
+	CypherParserRULE_oC_ExplicitProcedureInvocation      = 79
+	CypherParserRULE_oC_ImplicitProcedureInvocation      = 80
+	CypherParserRULE_oC_ProcedureResultField             = 81
+	CypherParserRULE_oC_ProcedureName                    = 82
+	CypherParserRULE_oC_Namespace                        = 83
+	CypherParserRULE_oC_ListComprehension                = 84
+	CypherParserRULE_oC_PatternComprehension             = 85
+	CypherParserRULE_oC_PropertyLookup                   = 86
+	CypherParserRULE_oC_CaseExpression                   = 87
+	CypherParserRULE_oC_CaseAlternatives                 = 88
+	CypherParserRULE_oC_Variable                         = 89
+	CypherParserRULE_oC_NumberLiteral                    = 90
+	CypherParserRULE_oC_MapLiteral                       = 91
+	CypherParserRULE_oC_Parameter                        = 92
+	CypherParserRULE_oC_PropertyExpression               = 93
+	CypherParserRULE_oC_PropertyKeyName                  = 94
+	CypherParserRULE_oC_IntegerLiteral                   = 95
+	CypherParserRULE_oC_DoubleLiteral                    = 96
+	CypherParserRULE_oC_SchemaName                       = 97
+	CypherParserRULE_oC_ReservedWord                     = 98
+	CypherParserRULE_oC_SymbolicName                     = 99
+	CypherParserRULE_oC_LeftArrowHead                    = 100
+	CypherParserRULE_oC_RightArrowHead                   = 101
+	CypherParserRULE_oC_Dash                             = 102
 )
 
 // IOC_CypherContext is an interface to support dynamic dispatch.
@@ -1217,55 +1290,55 @@ func (p *CypherParser) OC_Cypher() (localctx IOC_CypherContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(199)
+	p.SetState(207)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(198)
+			p.SetState(206)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(201)
+		p.SetState(209)
 		p.OC_Statement()
 	}
-	p.SetState(206)
+	p.SetState(214)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) == 1 {
-		p.SetState(203)
+		p.SetState(211)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(202)
+				p.SetState(210)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(205)
+			p.SetState(213)
 			p.Match(CypherParserT__0)
 		}
 
 	}
-	p.SetState(209)
+	p.SetState(217)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(208)
+			p.SetState(216)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(211)
+		p.SetState(219)
 		p.Match(CypherParserEOF)
 	}
 
@@ -1362,7 +1435,7 @@ func (p *CypherParser) OC_Statement() (localctx IOC_StatementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
+		p.SetState(221)
 		p.OC_Query()
 	}
 
@@ -1467,20 +1540,20 @@ func (p *CypherParser) OC_Query() (localctx IOC_QueryContext) {
 		}
 	}()
 
-	p.SetState(217)
+	p.SetState(225)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(215)
+			p.SetState(223)
 			p.OC_RegularQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(216)
+			p.SetState(224)
 			p.OC_StandaloneCall()
 		}
 
@@ -1613,33 +1686,33 @@ func (p *CypherParser) OC_RegularQuery() (localctx IOC_RegularQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(219)
+		p.SetState(227)
 		p.OC_SingleQuery()
 	}
-	p.SetState(226)
+	p.SetState(234)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(221)
+			p.SetState(229)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(220)
+					p.SetState(228)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(223)
+				p.SetState(231)
 				p.OC_Union()
 			}
 
 		}
-		p.SetState(228)
+		p.SetState(236)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 	}
@@ -1752,58 +1825,58 @@ func (p *CypherParser) OC_Union() (localctx IOC_UnionContext) {
 		}
 	}()
 
-	p.SetState(241)
+	p.SetState(249)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(229)
+			p.SetState(237)
 			p.Match(CypherParserUNION)
 		}
 		{
-			p.SetState(230)
+			p.SetState(238)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(231)
+			p.SetState(239)
 			p.Match(CypherParserALL)
 		}
-		p.SetState(233)
+		p.SetState(241)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(232)
+				p.SetState(240)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(235)
+			p.SetState(243)
 			p.OC_SingleQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(236)
+			p.SetState(244)
 			p.Match(CypherParserUNION)
 		}
-		p.SetState(238)
+		p.SetState(246)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(237)
+				p.SetState(245)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(240)
+			p.SetState(248)
 			p.OC_SingleQuery()
 		}
 
@@ -1910,20 +1983,20 @@ func (p *CypherParser) OC_SingleQuery() (localctx IOC_SingleQueryContext) {
 		}
 	}()
 
-	p.SetState(245)
+	p.SetState(253)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(243)
+			p.SetState(251)
 			p.OC_SinglePartQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(244)
+			p.SetState(252)
 			p.OC_MultiPartQuery()
 		}
 
@@ -2077,116 +2150,116 @@ func (p *CypherParser) OC_SinglePartQuery() (localctx IOC_SinglePartQueryContext
 
 	var _alt int
 
-	p.SetState(282)
+	p.SetState(290)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(253)
+		p.SetState(261)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
+		for ((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(CypherParserOPTIONAL-49))|(1<<(CypherParserMATCH-49))|(1<<(CypherParserUNWIND-49))|(1<<(CypherParserCALL-49)))) != 0 {
 			{
-				p.SetState(247)
+				p.SetState(255)
 				p.OC_ReadingClause()
 			}
-			p.SetState(249)
+			p.SetState(257)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(248)
+					p.SetState(256)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(255)
+			p.SetState(263)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(256)
+			p.SetState(264)
 			p.OC_Return()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(263)
+		p.SetState(271)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
+		for ((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(CypherParserOPTIONAL-49))|(1<<(CypherParserMATCH-49))|(1<<(CypherParserUNWIND-49))|(1<<(CypherParserCALL-49)))) != 0 {
 			{
-				p.SetState(257)
+				p.SetState(265)
 				p.OC_ReadingClause()
 			}
-			p.SetState(259)
+			p.SetState(267)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(258)
+					p.SetState(266)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(265)
+			p.SetState(273)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(266)
+			p.SetState(274)
 			p.OC_UpdatingClause()
 		}
-		p.SetState(273)
+		p.SetState(281)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(268)
+				p.SetState(276)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(267)
+						p.SetState(275)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(270)
+					p.SetState(278)
 					p.OC_UpdatingClause()
 				}
 
 			}
-			p.SetState(275)
+			p.SetState(283)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
 		}
-		p.SetState(280)
+		p.SetState(288)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) == 1 {
-			p.SetState(277)
+			p.SetState(285)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(276)
+					p.SetState(284)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(279)
+				p.SetState(287)
 				p.OC_Return()
 			}
 
@@ -2366,73 +2439,73 @@ func (p *CypherParser) OC_MultiPartQuery() (localctx IOC_MultiPartQueryContext)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(306)
+	p.SetState(314)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(290)
+			p.SetState(298)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
+			for ((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(CypherParserOPTIONAL-49))|(1<<(CypherParserMATCH-49))|(1<<(CypherParserUNWIND-49))|(1<<(CypherParserCALL-49)))) != 0 {
 				{
-					p.SetState(284)
+					p.SetState(292)
 					p.OC_ReadingClause()
 				}
-				p.SetState(286)
+				p.SetState(294)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(285)
+						p.SetState(293)
 						p.Match(CypherParserSP)
 					}
 
 				}
 
-				p.SetState(292)
+				p.SetState(300)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
-			p.SetState(299)
+			p.SetState(307)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			for ((_la-52)&-(0x1f+1)) == 0 && ((1<<uint((_la-52)))&((1<<(CypherParserMERGE-52))|(1<<(CypherParserCREATE-52))|(1<<(CypherParserSET-52))|(1<<(CypherParserDETACH-52))|(1<<(CypherParserDELETE-52))|(1<<(CypherParserREMOVE-52)))) != 0 {
+			for ((_la-53)&-(0x1f+1)) == 0 && ((1<<uint((_la-53)))&((1<<(CypherParserMERGE-53))|(1<<(CypherParserCREATE-53))|(1<<(CypherParserSET-53))|(1<<(CypherParserDETACH-53))|(1<<(CypherParserDELETE-53))|(1<<(CypherParserREMOVE-53)))) != 0 {
 				{
-					p.SetState(293)
+					p.SetState(301)
 					p.OC_UpdatingClause()
 				}
-				p.SetState(295)
+				p.SetState(303)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(294)
+						p.SetState(302)
 						p.Match(CypherParserSP)
 					}
 
 				}
 
-				p.SetState(301)
+				p.SetState(309)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(302)
+				p.SetState(310)
 				p.OC_With()
 			}
-			p.SetState(304)
+			p.SetState(312)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(303)
+					p.SetState(311)
 					p.Match(CypherParserSP)
 				}
 
@@ -2442,12 +2515,12 @@ func (p *CypherParser) OC_MultiPartQuery() (localctx IOC_MultiPartQueryContext)
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(308)
+		p.SetState(316)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(310)
+		p.SetState(318)
 		p.OC_SinglePartQuery()
 	}
 
@@ -2582,42 +2655,42 @@ func (p *CypherParser) OC_UpdatingClause() (localctx IOC_UpdatingClauseContext)
 		}
 	}()
 
-	p.SetState(317)
+	p.SetState(325)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserCREATE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(312)
+			p.SetState(320)
 			p.OC_Create()
 		}
 
 	case CypherParserMERGE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(313)
+			p.SetState(321)
 			p.OC_Merge()
 		}
 
 	case CypherParserDETACH, CypherParserDELETE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(314)
+			p.SetState(322)
 			p.OC_Delete()
 		}
 
 	case CypherParserSET:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(315)
+			p.SetState(323)
 			p.OC_Set()
 		}
 
 	case CypherParserREMOVE:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(316)
+			p.SetState(324)
 			p.OC_Remove()
 		}
 
@@ -2736,28 +2809,28 @@ func (p *CypherParser) OC_ReadingClause() (localctx IOC_ReadingClauseContext) {
 		}
 	}()
 
-	p.SetState(322)
+	p.SetState(330)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserOPTIONAL, CypherParserMATCH:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(319)
+			p.SetState(327)
 			p.OC_Match()
 		}
 
 	case CypherParserUNWIND:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(320)
+			p.SetState(328)
 			p.OC_Unwind()
 		}
 
 	case CypherParserCALL:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(321)
+			p.SetState(329)
 			p.OC_InQueryCall()
 		}
 
@@ -2884,57 +2957,57 @@ func (p *CypherParser) OC_Match() (localctx IOC_MatchContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(326)
+	p.SetState(334)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserOPTIONAL {
 		{
-			p.SetState(324)
+			p.SetState(332)
 			p.Match(CypherParserOPTIONAL)
 		}
 		{
-			p.SetState(325)
+			p.SetState(333)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(328)
+		p.SetState(336)
 		p.Match(CypherParserMATCH)
 	}
-	p.SetState(330)
+	p.SetState(338)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(329)
+			p.SetState(337)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(332)
+		p.SetState(340)
 		p.OC_Pattern()
 	}
-	p.SetState(337)
+	p.SetState(345)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
-		p.SetState(334)
+		p.SetState(342)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(333)
+				p.SetState(341)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(336)
+			p.SetState(344)
 			p.OC_Where()
 		}
 
@@ -3060,38 +3133,38 @@ func (p *CypherParser) OC_Unwind() (localctx IOC_UnwindContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(339)
+		p.SetState(347)
 		p.Match(CypherParserUNWIND)
 	}
-	p.SetState(341)
+	p.SetState(349)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(340)
+			p.SetState(348)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(343)
+		p.SetState(351)
 		p.OC_Expression()
 	}
 	{
-		p.SetState(344)
+		p.SetState(352)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(345)
+		p.SetState(353)
 		p.Match(CypherParserAS)
 	}
 	{
-		p.SetState(346)
+		p.SetState(354)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(347)
+		p.SetState(355)
 		p.OC_Variable()
 	}
 
@@ -3226,41 +3299,41 @@ func (p *CypherParser) OC_Merge() (localctx IOC_MergeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(349)
+		p.SetState(357)
 		p.Match(CypherParserMERGE)
 	}
-	p.SetState(351)
+	p.SetState(359)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(350)
+			p.SetState(358)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(353)
+		p.SetState(361)
 		p.OC_PatternPart()
 	}
-	p.SetState(358)
+	p.SetState(366)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(354)
+				p.SetState(362)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(355)
+				p.SetState(363)
 				p.OC_MergeAction()
 			}
 
 		}
-		p.SetState(360)
+		p.SetState(368)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
 	}
@@ -3376,52 +3449,52 @@ func (p *CypherParser) OC_MergeAction() (localctx IOC_MergeActionContext) {
 		}
 	}()
 
-	p.SetState(371)
+	p.SetState(379)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(361)
+			p.SetState(369)
 			p.Match(CypherParserON)
 		}
 		{
-			p.SetState(362)
+			p.SetState(370)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(363)
+			p.SetState(371)
 			p.Match(CypherParserMATCH)
 		}
 		{
-			p.SetState(364)
+			p.SetState(372)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(365)
+			p.SetState(373)
 			p.OC_Set()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(366)
+			p.SetState(374)
 			p.Match(CypherParserON)
 		}
 		{
-			p.SetState(367)
+			p.SetState(375)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(368)
+			p.SetState(376)
 			p.Match(CypherParserCREATE)
 		}
 		{
-			p.SetState(369)
+			p.SetState(377)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(370)
+			p.SetState(378)
 			p.OC_Set()
 		}
 
@@ -3529,22 +3602,22 @@ func (p *CypherParser) OC_Create() (localctx IOC_CreateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(373)
+		p.SetState(381)
 		p.Match(CypherParserCREATE)
 	}
-	p.SetState(375)
+	p.SetState(383)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(374)
+			p.SetState(382)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(377)
+		p.SetState(385)
 		p.OC_Pattern()
 	}
 
@@ -3669,63 +3742,63 @@ func (p *CypherParser) OC_Set() (localctx IOC_SetContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(379)
+		p.SetState(387)
 		p.Match(CypherParserSET)
 	}
-	p.SetState(381)
+	p.SetState(389)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(380)
+			p.SetState(388)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(383)
+		p.SetState(391)
 		p.OC_SetItem()
 	}
-	p.SetState(394)
+	p.SetState(402)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(385)
+			p.SetState(393)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(384)
+					p.SetState(392)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(387)
+				p.SetState(395)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(389)
+			p.SetState(397)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(388)
+					p.SetState(396)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(391)
+				p.SetState(399)
 				p.OC_SetItem()
 			}
 
 		}
-		p.SetState(396)
+		p.SetState(404)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext())
 	}
@@ -3860,139 +3933,139 @@ func (p *CypherParser) OC_SetItem() (localctx IOC_SetItemContext) {
 		}
 	}()
 
-	p.SetState(433)
+	p.SetState(441)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(397)
+			p.SetState(405)
 			p.OC_PropertyExpression()
 		}
-		p.SetState(399)
+		p.SetState(407)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(398)
+				p.SetState(406)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(401)
+			p.SetState(409)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(403)
+		p.SetState(411)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(402)
+				p.SetState(410)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(405)
+			p.SetState(413)
 			p.OC_Expression()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(407)
+			p.SetState(415)
 			p.OC_Variable()
 		}
-		p.SetState(409)
+		p.SetState(417)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(408)
+				p.SetState(416)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(411)
+			p.SetState(419)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(413)
+		p.SetState(421)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(412)
+				p.SetState(420)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(415)
+			p.SetState(423)
 			p.OC_Expression()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(417)
+			p.SetState(425)
 			p.OC_Variable()
 		}
-		p.SetState(419)
+		p.SetState(427)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(418)
+				p.SetState(426)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(421)
+			p.SetState(429)
 			p.Match(CypherParserT__3)
 		}
-		p.SetState(423)
+		p.SetState(431)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(422)
+				p.SetState(430)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(425)
+			p.SetState(433)
 			p.OC_Expression()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(427)
+			p.SetState(435)
 			p.OC_Variable()
 		}
-		p.SetState(429)
+		p.SetState(437)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(428)
+				p.SetState(436)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(431)
+			p.SetState(439)
 			p.OC_NodeLabels()
 		}
 
@@ -4122,79 +4195,79 @@ func (p *CypherParser) OC_Delete() (localctx IOC_DeleteContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(437)
+	p.SetState(445)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserDETACH {
 		{
-			p.SetState(435)
+			p.SetState(443)
 			p.Match(CypherParserDETACH)
 		}
 		{
-			p.SetState(436)
+			p.SetState(444)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(439)
+		p.SetState(447)
 		p.Match(CypherParserDELETE)
 	}
-	p.SetState(441)
+	p.SetState(449)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(440)
+			p.SetState(448)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(443)
+		p.SetState(451)
 		p.OC_Expression()
 	}
-	p.SetState(454)
+	p.SetState(462)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(445)
+			p.SetState(453)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(444)
+					p.SetState(452)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(447)
+				p.SetState(455)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(449)
+			p.SetState(457)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(448)
+					p.SetState(456)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(451)
+				p.SetState(459)
 				p.OC_Expression()
 			}
 
 		}
-		p.SetState(456)
+		p.SetState(464)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 	}
@@ -4320,56 +4393,56 @@ func (p *CypherParser) OC_Remove() (localctx IOC_RemoveContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(457)
+		p.SetState(465)
 		p.Match(CypherParserREMOVE)
 	}
 	{
-		p.SetState(458)
+		p.SetState(466)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(459)
+		p.SetState(467)
 		p.OC_RemoveItem()
 	}
-	p.SetState(470)
+	p.SetState(478)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(461)
+			p.SetState(469)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(460)
+					p.SetState(468)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(463)
+				p.SetState(471)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(465)
+			p.SetState(473)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(464)
+					p.SetState(472)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(467)
+				p.SetState(475)
 				p.OC_RemoveItem()
 			}
 
 		}
-		p.SetState(472)
+		p.SetState(480)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext())
 	}
@@ -4485,24 +4558,24 @@ func (p *CypherParser) OC_RemoveItem() (localctx IOC_RemoveItemContext) {
 		}
 	}()
 
-	p.SetState(477)
+	p.SetState(485)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(473)
+			p.SetState(481)
 			p.OC_Variable()
 		}
 		{
-			p.SetState(474)
+			p.SetState(482)
 			p.OC_NodeLabels()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(476)
+			p.SetState(484)
 			p.OC_PropertyExpression()
 		}
 
@@ -4628,42 +4701,42 @@ func (p *CypherParser) OC_InQueryCall() (localctx IOC_InQueryCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(479)
+		p.SetState(487)
 		p.Match(CypherParserCALL)
 	}
 	{
-		p.SetState(480)
+		p.SetState(488)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(481)
+		p.SetState(489)
 		p.OC_ExplicitProcedureInvocation()
 	}
-	p.SetState(488)
+	p.SetState(496)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) == 1 {
-		p.SetState(483)
+		p.SetState(491)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(482)
+				p.SetState(490)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(485)
+			p.SetState(493)
 			p.Match(CypherParserYIELD)
 		}
 		{
-			p.SetState(486)
+			p.SetState(494)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(487)
+			p.SetState(495)
 			p.OC_YieldItems()
 		}
 
@@ -4798,47 +4871,47 @@ func (p *CypherParser) OC_StandaloneCall() (localctx IOC_StandaloneCallContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(490)
+		p.SetState(498)
 		p.Match(CypherParserCALL)
 	}
 	{
-		p.SetState(491)
+		p.SetState(499)
 		p.Match(CypherParserSP)
 	}
-	p.SetState(494)
+	p.SetState(502)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(492)
+			p.SetState(500)
 			p.OC_ExplicitProcedureInvocation()
 		}
 
 	case 2:
 		{
-			p.SetState(493)
+			p.SetState(501)
 			p.OC_ImplicitProcedureInvocation()
 		}
 
 	}
-	p.SetState(500)
+	p.SetState(508)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(496)
+			p.SetState(504)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(497)
+			p.SetState(505)
 			p.Match(CypherParserYIELD)
 		}
 		{
-			p.SetState(498)
+			p.SetState(506)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(499)
+			p.SetState(507)
 			p.OC_YieldItems()
 		}
 
@@ -4970,60 +5043,60 @@ func (p *CypherParser) OC_YieldItems() (localctx IOC_YieldItemsContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(517)
+	p.SetState(525)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__4:
 		{
-			p.SetState(502)
+			p.SetState(510)
 			p.Match(CypherParserT__4)
 		}
 
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserREDUCE, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		{
-			p.SetState(503)
+			p.SetState(511)
 			p.OC_YieldItem()
 		}
-		p.SetState(514)
+		p.SetState(522)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(505)
+				p.SetState(513)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(504)
+						p.SetState(512)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(507)
+					p.SetState(515)
 					p.Match(CypherParserT__1)
 				}
-				p.SetState(509)
+				p.SetState(517)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(508)
+						p.SetState(516)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(511)
+					p.SetState(519)
 					p.OC_YieldItem()
 				}
 
 			}
-			p.SetState(516)
+			p.SetState(524)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext())
 		}
@@ -5031,23 +5104,23 @@ func (p *CypherParser) OC_YieldItems() (localctx IOC_YieldItemsContext) {
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(523)
+	p.SetState(531)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
-		p.SetState(520)
+		p.SetState(528)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(519)
+				p.SetState(527)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(522)
+			p.SetState(530)
 			p.OC_Where()
 		}
 
@@ -5167,30 +5240,30 @@ func (p *CypherParser) OC_YieldItem() (localctx IOC_YieldItemContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(530)
+	p.SetState(538)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(525)
+			p.SetState(533)
 			p.OC_ProcedureResultField()
 		}
 		{
-			p.SetState(526)
+			p.SetState(534)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(527)
+			p.SetState(535)
 			p.Match(CypherParserAS)
 		}
 		{
-			p.SetState(528)
+			p.SetState(536)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(532)
+		p.SetState(540)
 		p.OC_Variable()
 	}
 
@@ -5306,30 +5379,30 @@ func (p *CypherParser) OC_With() (localctx IOC_WithContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(534)
+		p.SetState(542)
 		p.Match(CypherParserWITH)
 	}
 	{
-		p.SetState(535)
+		p.SetState(543)
 		p.OC_ProjectionBody()
 	}
-	p.SetState(540)
+	p.SetState(548)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) == 1 {
-		p.SetState(537)
+		p.SetState(545)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(536)
+				p.SetState(544)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(539)
+			p.SetState(547)
 			p.OC_Where()
 		}
 
@@ -5432,11 +5505,11 @@ func (p *CypherParser) OC_Return() (localctx IOC_ReturnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(542)
+		p.SetState(550)
 		p.Match(CypherParserRETURN)
 	}
 	{
-		p.SetState(543)
+		p.SetState(551)
 		p.OC_ProjectionBody()
 	}
 
@@ -5575,73 +5648,73 @@ func (p *CypherParser) OC_ProjectionBody() (localctx IOC_ProjectionBodyContext)
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(549)
+	p.SetState(557)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
-		p.SetState(546)
+		p.SetState(554)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(545)
+				p.SetState(553)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(548)
+			p.SetState(556)
 			p.Match(CypherParserDISTINCT)
 		}
 
 	}
 	{
-		p.SetState(551)
+		p.SetState(559)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(552)
+		p.SetState(560)
 		p.OC_ProjectionItems()
 	}
-	p.SetState(555)
+	p.SetState(563)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(553)
+			p.SetState(561)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(554)
+			p.SetState(562)
 			p.OC_Order()
 		}
 
 	}
-	p.SetState(559)
+	p.SetState(567)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(557)
+			p.SetState(565)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(558)
+			p.SetState(566)
 			p.OC_Skip()
 		}
 
 	}
-	p.SetState(563)
+	p.SetState(571)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(561)
+			p.SetState(569)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(562)
+			p.SetState(570)
 			p.OC_Limit()
 		}
 
@@ -5762,104 +5835,104 @@ func (p *CypherParser) OC_ProjectionItems() (localctx IOC_ProjectionItemsContext
 
 	var _alt int
 
-	p.SetState(593)
+	p.SetState(601)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__4:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(565)
+			p.SetState(573)
 			p.Match(CypherParserT__4)
 		}
-		p.SetState(576)
+		p.SetState(584)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(567)
+				p.SetState(575)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(566)
+						p.SetState(574)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(569)
+					p.SetState(577)
 					p.Match(CypherParserT__1)
 				}
-				p.SetState(571)
+				p.SetState(579)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(570)
+						p.SetState(578)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(573)
+					p.SetState(581)
 					p.OC_ProjectionItem()
 				}
 
 			}
-			p.SetState(578)
+			p.SetState(586)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext())
 		}
 
-	case CypherParserT__5, CypherParserT__7, CypherParserT__12, CypherParserT__13, CypherParserT__23, CypherParserT__25, CypherParserALL, CypherParserNOT, CypherParserNULL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserStringLiteral, CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserHexLetter, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserT__5, CypherParserT__7, CypherParserT__12, CypherParserT__13, CypherParserT__18, CypherParserT__26, CypherParserALL, CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserNOT, CypherParserNULL, CypherParserREDUCE, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserStringLiteral, CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserHexLetter, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(579)
+			p.SetState(587)
 			p.OC_ProjectionItem()
 		}
-		p.SetState(590)
+		p.SetState(598)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(581)
+				p.SetState(589)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(580)
+						p.SetState(588)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(583)
+					p.SetState(591)
 					p.Match(CypherParserT__1)
 				}
-				p.SetState(585)
+				p.SetState(593)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(584)
+						p.SetState(592)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(587)
+					p.SetState(595)
 					p.OC_ProjectionItem()
 				}
 
 			}
-			p.SetState(592)
+			p.SetState(600)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext())
 		}
@@ -5981,36 +6054,36 @@ func (p *CypherParser) OC_ProjectionItem() (localctx IOC_ProjectionItemContext)
 		}
 	}()
 
-	p.SetState(602)
+	p.SetState(610)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(595)
+			p.SetState(603)
 			p.OC_Expression()
 		}
 		{
-			p.SetState(596)
+			p.SetState(604)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(597)
+			p.SetState(605)
 			p.Match(CypherParserAS)
 		}
 		{
-			p.SetState(598)
+			p.SetState(606)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(599)
+			p.SetState(607)
 			p.OC_Variable()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(601)
+			p.SetState(609)
 			p.OC_Expression()
 		}
 
@@ -6139,51 +6212,51 @@ func (p *CypherParser) OC_Order() (localctx IOC_OrderContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(604)
+		p.SetState(612)
 		p.Match(CypherParserORDER)
 	}
 	{
-		p.SetState(605)
+		p.SetState(613)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(606)
+		p.SetState(614)
 		p.Match(CypherParserBY)
 	}
 	{
-		p.SetState(607)
+		p.SetState(615)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(608)
+		p.SetState(616)
 		p.OC_SortItem()
 	}
-	p.SetState(616)
+	p.SetState(624)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserT__1 {
 		{
-			p.SetState(609)
+			p.SetState(617)
 			p.Match(CypherParserT__1)
 		}
-		p.SetState(611)
+		p.SetState(619)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(610)
+				p.SetState(618)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(613)
+			p.SetState(621)
 			p.OC_SortItem()
 		}
 
-		p.SetState(618)
+		p.SetState(626)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -6289,15 +6362,15 @@ func (p *CypherParser) OC_Skip() (localctx IOC_SkipContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(619)
+		p.SetState(627)
 		p.Match(CypherParserL_SKIP)
 	}
 	{
-		p.SetState(620)
+		p.SetState(628)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(621)
+		p.SetState(629)
 		p.OC_Expression()
 	}
 
@@ -6402,15 +6475,15 @@ func (p *CypherParser) OC_Limit() (localctx IOC_LimitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(623)
+		p.SetState(631)
 		p.Match(CypherParserLIMIT)
 	}
 	{
-		p.SetState(624)
+		p.SetState(632)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(625)
+		p.SetState(633)
 		p.OC_Expression()
 	}
 
@@ -6528,29 +6601,29 @@ func (p *CypherParser) OC_SortItem() (localctx IOC_SortItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(627)
+		p.SetState(635)
 		p.OC_Expression()
 	}
-	p.SetState(632)
+	p.SetState(640)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) == 1 {
-		p.SetState(629)
+		p.SetState(637)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(628)
+				p.SetState(636)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(631)
+			p.SetState(639)
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CypherParserASCENDING-68))|(1<<(CypherParserASC-68))|(1<<(CypherParserDESCENDING-68))|(1<<(CypherParserDESC-68)))) != 0) {
+			if !(((_la-69)&-(0x1f+1)) == 0 && ((1<<uint((_la-69)))&((1<<(CypherParserASCENDING-69))|(1<<(CypherParserASC-69))|(1<<(CypherParserDESCENDING-69))|(1<<(CypherParserDESC-69)))) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -6661,15 +6734,15 @@ func (p *CypherParser) OC_Where() (localctx IOC_WhereContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(634)
+		p.SetState(642)
 		p.Match(CypherParserWHERE)
 	}
 	{
-		p.SetState(635)
+		p.SetState(643)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(636)
+		p.SetState(644)
 		p.OC_Expression()
 	}
 
@@ -6790,48 +6863,48 @@ func (p *CypherParser) OC_Pattern() (localctx IOC_PatternContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(638)
+		p.SetState(646)
 		p.OC_PatternPart()
 	}
-	p.SetState(649)
+	p.SetState(657)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(640)
+			p.SetState(648)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(639)
+					p.SetState(647)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(642)
+				p.SetState(650)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(644)
+			p.SetState(652)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(643)
+					p.SetState(651)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(646)
+				p.SetState(654)
 				p.OC_PatternPart()
 			}
 
 		}
-		p.SetState(651)
+		p.SetState(659)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext())
 	}
@@ -6946,56 +7019,53 @@ func (p *CypherParser) OC_PatternPart() (localctx IOC_PatternPartContext) {
 		}
 	}()
 
-	p.SetState(663)
+	p.SetState(671)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(652)
+			p.SetState(660)
 			p.OC_Variable()
 		}
-		p.SetState(654)
+		p.SetState(662)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(653)
+				p.SetState(661)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(656)
+			p.SetState(664)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(658)
+		p.SetState(666)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(657)
+				p.SetState(665)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(660)
+			p.SetState(668)
 			p.OC_AnonymousPatternPart()
 		}
 
-	case CypherParserT__5:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(662)
+			p.SetState(670)
 			p.OC_AnonymousPatternPart()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -7039,6 +7109,16 @@ func NewOC_AnonymousPatternPartContext(parser antlr.Parser, parent antlr.ParserR
 
 func (s *OC_AnonymousPatternPartContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *OC_AnonymousPatternPartContext) OC_ShortestPathPattern() IOC_ShortestPathPatternContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_ShortestPathPatternContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_ShortestPathPatternContext)
+}
+
 func (s *OC_AnonymousPatternPartContext) OC_PatternElement() IOC_PatternElementContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_PatternElementContext)(nil)).Elem(), 0)
 
@@ -7089,10 +7169,171 @@ func (p *CypherParser) OC_AnonymousPatternPart() (localctx IOC_AnonymousPatternP
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(665)
-		p.OC_PatternElement()
+	p.SetState(675)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(673)
+			p.OC_ShortestPathPattern()
+		}
+
+	case CypherParserT__5:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(674)
+			p.OC_PatternElement()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IOC_ShortestPathPatternContext is an interface to support dynamic dispatch.
+type IOC_ShortestPathPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOC_ShortestPathPatternContext differentiates from other interfaces.
+	IsOC_ShortestPathPatternContext()
+}
+
+type OC_ShortestPathPatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOC_ShortestPathPatternContext() *OC_ShortestPathPatternContext {
+	var p = new(OC_ShortestPathPatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_oC_ShortestPathPattern
+	return p
+}
+
+func (*OC_ShortestPathPatternContext) IsOC_ShortestPathPatternContext() {}
+
+func NewOC_ShortestPathPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OC_ShortestPathPatternContext {
+	var p = new(OC_ShortestPathPatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_oC_ShortestPathPattern
+
+	return p
+}
+
+func (s *OC_ShortestPathPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OC_ShortestPathPatternContext) SHORTESTPATH() antlr.TerminalNode {
+	return s.GetToken(CypherParserSHORTESTPATH, 0)
+}
+
+func (s *OC_ShortestPathPatternContext) OC_PatternElement() IOC_PatternElementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_PatternElementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_PatternElementContext)
+}
+
+func (s *OC_ShortestPathPatternContext) ALLSHORTESTPATHS() antlr.TerminalNode {
+	return s.GetToken(CypherParserALLSHORTESTPATHS, 0)
+}
+
+func (s *OC_ShortestPathPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OC_ShortestPathPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OC_ShortestPathPatternContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.EnterOC_ShortestPathPattern(s)
+	}
+}
+
+func (s *OC_ShortestPathPatternContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.ExitOC_ShortestPathPattern(s)
+	}
+}
+
+func (p *CypherParser) OC_ShortestPathPattern() (localctx IOC_ShortestPathPatternContext) {
+	localctx = NewOC_ShortestPathPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, CypherParserRULE_oC_ShortestPathPattern)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(687)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CypherParserSHORTESTPATH:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(677)
+			p.Match(CypherParserSHORTESTPATH)
+		}
+		{
+			p.SetState(678)
+			p.Match(CypherParserT__5)
+		}
+		{
+			p.SetState(679)
+			p.OC_PatternElement()
+		}
+		{
+			p.SetState(680)
+			p.Match(CypherParserT__6)
+		}
+
+	case CypherParserALLSHORTESTPATHS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(682)
+			p.Match(CypherParserALLSHORTESTPATHS)
+		}
+		{
+			p.SetState(683)
+			p.Match(CypherParserT__5)
+		}
+		{
+			p.SetState(684)
+			p.OC_PatternElement()
+		}
+		{
+			p.SetState(685)
+			p.Match(CypherParserT__6)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -7209,7 +7450,7 @@ func (s *OC_PatternElementContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_PatternElement() (localctx IOC_PatternElementContext) {
 	localctx = NewOC_PatternElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, CypherParserRULE_oC_PatternElement)
+	p.EnterRule(localctx, 76, CypherParserRULE_oC_PatternElement)
 	var _la int
 
 	defer func() {
@@ -7230,55 +7471,55 @@ func (p *CypherParser) OC_PatternElement() (localctx IOC_PatternElementContext)
 
 	var _alt int
 
-	p.SetState(681)
+	p.SetState(703)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 96, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(667)
+			p.SetState(689)
 			p.OC_NodePattern()
 		}
-		p.SetState(674)
+		p.SetState(696)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(669)
+				p.SetState(691)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(668)
+						p.SetState(690)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(671)
+					p.SetState(693)
 					p.OC_PatternElementChain()
 				}
 
 			}
-			p.SetState(676)
+			p.SetState(698)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext())
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(677)
+			p.SetState(699)
 			p.Match(CypherParserT__5)
 		}
 		{
-			p.SetState(678)
+			p.SetState(700)
 			p.OC_PatternElement()
 		}
 		{
-			p.SetState(679)
+			p.SetState(701)
 			p.Match(CypherParserT__6)
 		}
 
@@ -7385,7 +7626,7 @@ func (s *OC_NodePatternContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_NodePattern() (localctx IOC_NodePatternContext) {
 	localctx = NewOC_NodePatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, CypherParserRULE_oC_NodePattern)
+	p.EnterRule(localctx, 78, CypherParserRULE_oC_NodePattern)
 	var _la int
 
 	defer func() {
@@ -7406,80 +7647,80 @@ func (p *CypherParser) OC_NodePattern() (localctx IOC_NodePatternContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(683)
+		p.SetState(705)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(685)
+	p.SetState(707)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(684)
+			p.SetState(706)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(691)
+	p.SetState(713)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserREDUCE-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 		{
-			p.SetState(687)
+			p.SetState(709)
 			p.OC_Variable()
 		}
-		p.SetState(689)
+		p.SetState(711)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(688)
+				p.SetState(710)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(697)
+	p.SetState(719)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__9 {
 		{
-			p.SetState(693)
+			p.SetState(715)
 			p.OC_NodeLabels()
 		}
-		p.SetState(695)
+		p.SetState(717)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(694)
+				p.SetState(716)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(703)
+	p.SetState(725)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__23 || _la == CypherParserT__25 {
+	if _la == CypherParserT__18 || _la == CypherParserT__26 {
 		{
-			p.SetState(699)
+			p.SetState(721)
 			p.OC_Properties()
 		}
-		p.SetState(701)
+		p.SetState(723)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(700)
+				p.SetState(722)
 				p.Match(CypherParserSP)
 			}
 
@@ -7487,7 +7728,7 @@ func (p *CypherParser) OC_NodePattern() (localctx IOC_NodePatternContext) {
 
 	}
 	{
-		p.SetState(705)
+		p.SetState(727)
 		p.Match(CypherParserT__6)
 	}
 
@@ -7578,7 +7819,7 @@ func (s *OC_PatternElementChainContext) ExitRule(listener antlr.ParseTreeListene
 
 func (p *CypherParser) OC_PatternElementChain() (localctx IOC_PatternElementChainContext) {
 	localctx = NewOC_PatternElementChainContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, CypherParserRULE_oC_PatternElementChain)
+	p.EnterRule(localctx, 80, CypherParserRULE_oC_PatternElementChain)
 	var _la int
 
 	defer func() {
@@ -7599,22 +7840,22 @@ func (p *CypherParser) OC_PatternElementChain() (localctx IOC_PatternElementChai
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(707)
+		p.SetState(729)
 		p.OC_RelationshipPattern()
 	}
-	p.SetState(709)
+	p.SetState(731)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(708)
+			p.SetState(730)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(711)
+		p.SetState(733)
 		p.OC_NodePattern()
 	}
 
@@ -7742,7 +7983,7 @@ func (s *OC_RelationshipPatternContext) ExitRule(listener antlr.ParseTreeListene
 
 func (p *CypherParser) OC_RelationshipPattern() (localctx IOC_RelationshipPatternContext) {
 	localctx = NewOC_RelationshipPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, CypherParserRULE_oC_RelationshipPattern)
+	p.EnterRule(localctx, 82, CypherParserRULE_oC_RelationshipPattern)
 	var _la int
 
 	defer func() {
@@ -7761,238 +8002,238 @@ func (p *CypherParser) OC_RelationshipPattern() (localctx IOC_RelationshipPatter
 		}
 	}()
 
-	p.SetState(777)
+	p.SetState(799)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(713)
+			p.SetState(735)
 			p.OC_LeftArrowHead()
 		}
-		p.SetState(715)
+		p.SetState(737)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(714)
+				p.SetState(736)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(717)
+			p.SetState(739)
 			p.OC_Dash()
 		}
-		p.SetState(719)
+		p.SetState(741)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 108, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(718)
+				p.SetState(740)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(722)
+		p.SetState(744)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(721)
+				p.SetState(743)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(725)
+		p.SetState(747)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(724)
+				p.SetState(746)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(727)
+			p.SetState(749)
 			p.OC_Dash()
 		}
-		p.SetState(729)
+		p.SetState(751)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(728)
+				p.SetState(750)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(731)
+			p.SetState(753)
 			p.OC_RightArrowHead()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(733)
+			p.SetState(755)
 			p.OC_LeftArrowHead()
 		}
-		p.SetState(735)
+		p.SetState(757)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(734)
+				p.SetState(756)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(737)
+			p.SetState(759)
 			p.OC_Dash()
 		}
-		p.SetState(739)
+		p.SetState(761)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 111, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 113, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(738)
+				p.SetState(760)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(742)
+		p.SetState(764)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(741)
+				p.SetState(763)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(745)
+		p.SetState(767)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(744)
+				p.SetState(766)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(747)
+			p.SetState(769)
 			p.OC_Dash()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(749)
+			p.SetState(771)
 			p.OC_Dash()
 		}
-		p.SetState(751)
+		p.SetState(773)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(750)
+				p.SetState(772)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(754)
+		p.SetState(776)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(753)
+				p.SetState(775)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(757)
+		p.SetState(779)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(756)
+				p.SetState(778)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(759)
+			p.SetState(781)
 			p.OC_Dash()
 		}
-		p.SetState(761)
+		p.SetState(783)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(760)
+				p.SetState(782)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(763)
+			p.SetState(785)
 			p.OC_RightArrowHead()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(765)
+			p.SetState(787)
 			p.OC_Dash()
 		}
-		p.SetState(767)
+		p.SetState(789)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 120, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(766)
+				p.SetState(788)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(770)
+		p.SetState(792)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(769)
+				p.SetState(791)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(773)
+		p.SetState(795)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(772)
+				p.SetState(794)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(775)
+			p.SetState(797)
 			p.OC_Dash()
 		}
 
@@ -8109,7 +8350,7 @@ func (s *OC_RelationshipDetailContext) ExitRule(listener antlr.ParseTreeListener
 
 func (p *CypherParser) OC_RelationshipDetail() (localctx IOC_RelationshipDetailContext) {
 	localctx = NewOC_RelationshipDetailContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, CypherParserRULE_oC_RelationshipDetail)
+	p.EnterRule(localctx, 84, CypherParserRULE_oC_RelationshipDetail)
 	var _la int
 
 	defer func() {
@@ -8130,91 +8371,91 @@ func (p *CypherParser) OC_RelationshipDetail() (localctx IOC_RelationshipDetailC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(779)
+		p.SetState(801)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(781)
+	p.SetState(803)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(780)
+			p.SetState(802)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(787)
+	p.SetState(809)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserREDUCE-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 		{
-			p.SetState(783)
+			p.SetState(805)
 			p.OC_Variable()
 		}
-		p.SetState(785)
+		p.SetState(807)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(784)
+				p.SetState(806)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(793)
+	p.SetState(815)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__9 {
 		{
-			p.SetState(789)
+			p.SetState(811)
 			p.OC_RelationshipTypes()
 		}
-		p.SetState(791)
+		p.SetState(813)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(790)
+				p.SetState(812)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(796)
+	p.SetState(818)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__4 {
 		{
-			p.SetState(795)
+			p.SetState(817)
 			p.OC_RangeLiteral()
 		}
 
 	}
-	p.SetState(802)
+	p.SetState(824)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__23 || _la == CypherParserT__25 {
+	if _la == CypherParserT__18 || _la == CypherParserT__26 {
 		{
-			p.SetState(798)
+			p.SetState(820)
 			p.OC_Properties()
 		}
-		p.SetState(800)
+		p.SetState(822)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(799)
+				p.SetState(821)
 				p.Match(CypherParserSP)
 			}
 
@@ -8222,7 +8463,7 @@ func (p *CypherParser) OC_RelationshipDetail() (localctx IOC_RelationshipDetailC
 
 	}
 	{
-		p.SetState(804)
+		p.SetState(826)
 		p.Match(CypherParserT__8)
 	}
 
@@ -8309,7 +8550,7 @@ func (s *OC_PropertiesContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Properties() (localctx IOC_PropertiesContext) {
 	localctx = NewOC_PropertiesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, CypherParserRULE_oC_Properties)
+	p.EnterRule(localctx, 86, CypherParserRULE_oC_Properties)
 
 	defer func() {
 		p.ExitRule()
@@ -8327,21 +8568,21 @@ func (p *CypherParser) OC_Properties() (localctx IOC_PropertiesContext) {
 		}
 	}()
 
-	p.SetState(808)
+	p.SetState(830)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserT__23:
+	case CypherParserT__18:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(806)
+			p.SetState(828)
 			p.OC_MapLiteral()
 		}
 
-	case CypherParserT__25:
+	case CypherParserT__26:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(807)
+			p.SetState(829)
 			p.OC_Parameter()
 		}
 
@@ -8443,7 +8684,7 @@ func (s *OC_RelationshipTypesContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *CypherParser) OC_RelationshipTypes() (localctx IOC_RelationshipTypesContext) {
 	localctx = NewOC_RelationshipTypesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, CypherParserRULE_oC_RelationshipTypes)
+	p.EnterRule(localctx, 88, CypherParserRULE_oC_RelationshipTypes)
 	var _la int
 
 	defer func() {
@@ -8466,76 +8707,76 @@ func (p *CypherParser) OC_RelationshipTypes() (localctx IOC_RelationshipTypesCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(810)
+		p.SetState(832)
 		p.Match(CypherParserT__9)
 	}
-	p.SetState(812)
+	p.SetState(834)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(811)
+			p.SetState(833)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(814)
+		p.SetState(836)
 		p.OC_RelTypeName()
 	}
-	p.SetState(828)
+	p.SetState(850)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 135, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 137, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(816)
+			p.SetState(838)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(815)
+					p.SetState(837)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(818)
+				p.SetState(840)
 				p.Match(CypherParserT__10)
 			}
-			p.SetState(820)
+			p.SetState(842)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserT__9 {
 				{
-					p.SetState(819)
+					p.SetState(841)
 					p.Match(CypherParserT__9)
 				}
 
 			}
-			p.SetState(823)
+			p.SetState(845)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(822)
+					p.SetState(844)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(825)
+				p.SetState(847)
 				p.OC_RelTypeName()
 			}
 
 		}
-		p.SetState(830)
+		p.SetState(852)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 135, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 137, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8632,7 +8873,7 @@ func (s *OC_NodeLabelsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_NodeLabels() (localctx IOC_NodeLabelsContext) {
 	localctx = NewOC_NodeLabelsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, CypherParserRULE_oC_NodeLabels)
+	p.EnterRule(localctx, 90, CypherParserRULE_oC_NodeLabels)
 	var _la int
 
 	defer func() {
@@ -8655,35 +8896,35 @@ func (p *CypherParser) OC_NodeLabels() (localctx IOC_NodeLabelsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(831)
+		p.SetState(853)
 		p.OC_NodeLabel()
 	}
-	p.SetState(838)
+	p.SetState(860)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 137, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 139, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(833)
+			p.SetState(855)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(832)
+					p.SetState(854)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(835)
+				p.SetState(857)
 				p.OC_NodeLabel()
 			}
 
 		}
-		p.SetState(840)
+		p.SetState(862)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 137, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 139, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8763,7 +9004,7 @@ func (s *OC_NodeLabelContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_NodeLabel() (localctx IOC_NodeLabelContext) {
 	localctx = NewOC_NodeLabelContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, CypherParserRULE_oC_NodeLabel)
+	p.EnterRule(localctx, 92, CypherParserRULE_oC_NodeLabel)
 	var _la int
 
 	defer func() {
@@ -8784,22 +9025,22 @@ func (p *CypherParser) OC_NodeLabel() (localctx IOC_NodeLabelContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(841)
+		p.SetState(863)
 		p.Match(CypherParserT__9)
 	}
-	p.SetState(843)
+	p.SetState(865)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(842)
+			p.SetState(864)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(845)
+		p.SetState(867)
 		p.OC_LabelName()
 	}
 
@@ -8897,7 +9138,7 @@ func (s *OC_RangeLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_RangeLiteral() (localctx IOC_RangeLiteralContext) {
 	localctx = NewOC_RangeLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, CypherParserRULE_oC_RangeLiteral)
+	p.EnterRule(localctx, 94, CypherParserRULE_oC_RangeLiteral)
 	var _la int
 
 	defer func() {
@@ -8918,78 +9159,78 @@ func (p *CypherParser) OC_RangeLiteral() (localctx IOC_RangeLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(847)
+		p.SetState(869)
 		p.Match(CypherParserT__4)
 	}
-	p.SetState(849)
+	p.SetState(871)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(848)
+			p.SetState(870)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(855)
+	p.SetState(877)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0 {
+	if ((_la-101)&-(0x1f+1)) == 0 && ((1<<uint((_la-101)))&((1<<(CypherParserHexInteger-101))|(1<<(CypherParserDecimalInteger-101))|(1<<(CypherParserOctalInteger-101)))) != 0 {
 		{
-			p.SetState(851)
+			p.SetState(873)
 			p.OC_IntegerLiteral()
 		}
-		p.SetState(853)
+		p.SetState(875)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(852)
+				p.SetState(874)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(867)
+	p.SetState(889)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__11 {
 		{
-			p.SetState(857)
+			p.SetState(879)
 			p.Match(CypherParserT__11)
 		}
-		p.SetState(859)
+		p.SetState(881)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(858)
+				p.SetState(880)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(865)
+		p.SetState(887)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if ((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0 {
+		if ((_la-101)&-(0x1f+1)) == 0 && ((1<<uint((_la-101)))&((1<<(CypherParserHexInteger-101))|(1<<(CypherParserDecimalInteger-101))|(1<<(CypherParserOctalInteger-101)))) != 0 {
 			{
-				p.SetState(861)
+				p.SetState(883)
 				p.OC_IntegerLiteral()
 			}
-			p.SetState(863)
+			p.SetState(885)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(862)
+					p.SetState(884)
 					p.Match(CypherParserSP)
 				}
 
@@ -9072,7 +9313,7 @@ func (s *OC_LabelNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_LabelName() (localctx IOC_LabelNameContext) {
 	localctx = NewOC_LabelNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, CypherParserRULE_oC_LabelName)
+	p.EnterRule(localctx, 96, CypherParserRULE_oC_LabelName)
 
 	defer func() {
 		p.ExitRule()
@@ -9092,7 +9333,7 @@ func (p *CypherParser) OC_LabelName() (localctx IOC_LabelNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(869)
+		p.SetState(891)
 		p.OC_SchemaName()
 	}
 
@@ -9169,7 +9410,7 @@ func (s *OC_RelTypeNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_RelTypeName() (localctx IOC_RelTypeNameContext) {
 	localctx = NewOC_RelTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, CypherParserRULE_oC_RelTypeName)
+	p.EnterRule(localctx, 98, CypherParserRULE_oC_RelTypeName)
 
 	defer func() {
 		p.ExitRule()
@@ -9189,7 +9430,7 @@ func (p *CypherParser) OC_RelTypeName() (localctx IOC_RelTypeNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(871)
+		p.SetState(893)
 		p.OC_SchemaName()
 	}
 
@@ -9266,7 +9507,7 @@ func (s *OC_ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Expression() (localctx IOC_ExpressionContext) {
 	localctx = NewOC_ExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, CypherParserRULE_oC_Expression)
+	p.EnterRule(localctx, 100, CypherParserRULE_oC_Expression)
 
 	defer func() {
 		p.ExitRule()
@@ -9286,7 +9527,7 @@ func (p *CypherParser) OC_Expression() (localctx IOC_ExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(873)
+		p.SetState(895)
 		p.OC_OrExpression()
 	}
 
@@ -9392,7 +9633,7 @@ func (s *OC_OrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_OrExpression() (localctx IOC_OrExpressionContext) {
 	localctx = NewOC_OrExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, CypherParserRULE_oC_OrExpression)
+	p.EnterRule(localctx, 102, CypherParserRULE_oC_OrExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -9414,36 +9655,36 @@ func (p *CypherParser) OC_OrExpression() (localctx IOC_OrExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(875)
+		p.SetState(897)
 		p.OC_XorExpression()
 	}
-	p.SetState(882)
+	p.SetState(904)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(876)
+				p.SetState(898)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(877)
+				p.SetState(899)
 				p.Match(CypherParserOR)
 			}
 			{
-				p.SetState(878)
+				p.SetState(900)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(879)
+				p.SetState(901)
 				p.OC_XorExpression()
 			}
 
 		}
-		p.SetState(884)
+		p.SetState(906)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9548,7 +9789,7 @@ func (s *OC_XorExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_XorExpression() (localctx IOC_XorExpressionContext) {
 	localctx = NewOC_XorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, CypherParserRULE_oC_XorExpression)
+	p.EnterRule(localctx, 104, CypherParserRULE_oC_XorExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -9570,36 +9811,36 @@ func (p *CypherParser) OC_XorExpression() (localctx IOC_XorExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(885)
+		p.SetState(907)
 		p.OC_AndExpression()
 	}
-	p.SetState(892)
+	p.SetState(914)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 149, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(886)
+				p.SetState(908)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(887)
+				p.SetState(909)
 				p.Match(CypherParserXOR)
 			}
 			{
-				p.SetState(888)
+				p.SetState(910)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(889)
+				p.SetState(911)
 				p.OC_AndExpression()
 			}
 
 		}
-		p.SetState(894)
+		p.SetState(916)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 149, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9704,7 +9945,7 @@ func (s *OC_AndExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_AndExpression() (localctx IOC_AndExpressionContext) {
 	localctx = NewOC_AndExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, CypherParserRULE_oC_AndExpression)
+	p.EnterRule(localctx, 106, CypherParserRULE_oC_AndExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -9726,36 +9967,36 @@ func (p *CypherParser) OC_AndExpression() (localctx IOC_AndExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(895)
+		p.SetState(917)
 		p.OC_NotExpression()
 	}
-	p.SetState(902)
+	p.SetState(924)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 150, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(896)
+				p.SetState(918)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(897)
+				p.SetState(919)
 				p.Match(CypherParserAND)
 			}
 			{
-				p.SetState(898)
+				p.SetState(920)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(899)
+				p.SetState(921)
 				p.OC_NotExpression()
 			}
 
 		}
-		p.SetState(904)
+		p.SetState(926)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 150, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9847,7 +10088,7 @@ func (s *OC_NotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_NotExpression() (localctx IOC_NotExpressionContext) {
 	localctx = NewOC_NotExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, CypherParserRULE_oC_NotExpression)
+	p.EnterRule(localctx, 108, CypherParserRULE_oC_NotExpression)
 	var _la int
 
 	defer func() {
@@ -9867,33 +10108,33 @@ func (p *CypherParser) OC_NotExpression() (localctx IOC_NotExpressionContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(911)
+	p.SetState(933)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserNOT {
 		{
-			p.SetState(905)
+			p.SetState(927)
 			p.Match(CypherParserNOT)
 		}
-		p.SetState(907)
+		p.SetState(929)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(906)
+				p.SetState(928)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(913)
+		p.SetState(935)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(914)
+		p.SetState(936)
 		p.OC_ComparisonExpression()
 	}
 
@@ -10001,7 +10242,7 @@ func (s *OC_ComparisonExpressionContext) ExitRule(listener antlr.ParseTreeListen
 
 func (p *CypherParser) OC_ComparisonExpression() (localctx IOC_ComparisonExpressionContext) {
 	localctx = NewOC_ComparisonExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, CypherParserRULE_oC_ComparisonExpression)
+	p.EnterRule(localctx, 110, CypherParserRULE_oC_ComparisonExpression)
 	var _la int
 
 	defer func() {
@@ -10024,35 +10265,35 @@ func (p *CypherParser) OC_ComparisonExpression() (localctx IOC_ComparisonExpress
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(916)
+		p.SetState(938)
 		p.OC_AddOrSubtractExpression()
 	}
-	p.SetState(923)
+	p.SetState(945)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 154, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(918)
+			p.SetState(940)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(917)
+					p.SetState(939)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(920)
+				p.SetState(942)
 				p.OC_PartialComparisonExpression()
 			}
 
 		}
-		p.SetState(925)
+		p.SetState(947)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 154, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10149,7 +10390,7 @@ func (s *OC_AddOrSubtractExpressionContext) ExitRule(listener antlr.ParseTreeLis
 
 func (p *CypherParser) OC_AddOrSubtractExpression() (localctx IOC_AddOrSubtractExpressionContext) {
 	localctx = NewOC_AddOrSubtractExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, CypherParserRULE_oC_AddOrSubtractExpression)
+	p.EnterRule(localctx, 112, CypherParserRULE_oC_AddOrSubtractExpression)
 	var _la int
 
 	defer func() {
@@ -10172,88 +10413,88 @@ func (p *CypherParser) OC_AddOrSubtractExpression() (localctx IOC_AddOrSubtractE
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(926)
+		p.SetState(948)
 		p.OC_MultiplyDivideModuloExpression()
 	}
-	p.SetState(945)
+	p.SetState(967)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 160, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(943)
+			p.SetState(965)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 157, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 159, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(928)
+				p.SetState(950)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(927)
+						p.SetState(949)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(930)
+					p.SetState(952)
 					p.Match(CypherParserT__12)
 				}
-				p.SetState(932)
+				p.SetState(954)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(931)
+						p.SetState(953)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(934)
+					p.SetState(956)
 					p.OC_MultiplyDivideModuloExpression()
 				}
 
 			case 2:
-				p.SetState(936)
+				p.SetState(958)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(935)
+						p.SetState(957)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(938)
+					p.SetState(960)
 					p.Match(CypherParserT__13)
 				}
-				p.SetState(940)
+				p.SetState(962)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(939)
+						p.SetState(961)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(942)
+					p.SetState(964)
 					p.OC_MultiplyDivideModuloExpression()
 				}
 
 			}
 
 		}
-		p.SetState(947)
+		p.SetState(969)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 160, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10350,7 +10591,7 @@ func (s *OC_MultiplyDivideModuloExpressionContext) ExitRule(listener antlr.Parse
 
 func (p *CypherParser) OC_MultiplyDivideModuloExpression() (localctx IOC_MultiplyDivideModuloExpressionContext) {
 	localctx = NewOC_MultiplyDivideModuloExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, CypherParserRULE_oC_MultiplyDivideModuloExpression)
+	p.EnterRule(localctx, 114, CypherParserRULE_oC_MultiplyDivideModuloExpression)
 	var _la int
 
 	defer func() {
@@ -10373,120 +10614,120 @@ func (p *CypherParser) OC_MultiplyDivideModuloExpression() (localctx IOC_Multipl
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(948)
+		p.SetState(970)
 		p.OC_PowerOfExpression()
 	}
-	p.SetState(975)
+	p.SetState(997)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 166, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 168, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(973)
+			p.SetState(995)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 165, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 167, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(950)
+				p.SetState(972)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(949)
+						p.SetState(971)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(952)
+					p.SetState(974)
 					p.Match(CypherParserT__4)
 				}
-				p.SetState(954)
+				p.SetState(976)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(953)
+						p.SetState(975)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(956)
+					p.SetState(978)
 					p.OC_PowerOfExpression()
 				}
 
 			case 2:
-				p.SetState(958)
+				p.SetState(980)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(957)
+						p.SetState(979)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(960)
+					p.SetState(982)
 					p.Match(CypherParserT__14)
 				}
-				p.SetState(962)
+				p.SetState(984)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(961)
+						p.SetState(983)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(964)
+					p.SetState(986)
 					p.OC_PowerOfExpression()
 				}
 
 			case 3:
-				p.SetState(966)
+				p.SetState(988)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(965)
+						p.SetState(987)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(968)
+					p.SetState(990)
 					p.Match(CypherParserT__15)
 				}
-				p.SetState(970)
+				p.SetState(992)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(969)
+						p.SetState(991)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(972)
+					p.SetState(994)
 					p.OC_PowerOfExpression()
 				}
 
 			}
 
 		}
-		p.SetState(977)
+		p.SetState(999)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 166, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 168, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10583,7 +10824,7 @@ func (s *OC_PowerOfExpressionContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *CypherParser) OC_PowerOfExpression() (localctx IOC_PowerOfExpressionContext) {
 	localctx = NewOC_PowerOfExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, CypherParserRULE_oC_PowerOfExpression)
+	p.EnterRule(localctx, 116, CypherParserRULE_oC_PowerOfExpression)
 	var _la int
 
 	defer func() {
@@ -10606,50 +10847,50 @@ func (p *CypherParser) OC_PowerOfExpression() (localctx IOC_PowerOfExpressionCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(978)
+		p.SetState(1000)
 		p.OC_UnaryAddOrSubtractExpression()
 	}
-	p.SetState(989)
+	p.SetState(1011)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 169, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 171, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(980)
+			p.SetState(1002)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(979)
+					p.SetState(1001)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(982)
+				p.SetState(1004)
 				p.Match(CypherParserT__16)
 			}
-			p.SetState(984)
+			p.SetState(1006)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(983)
+					p.SetState(1005)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(986)
+				p.SetState(1008)
 				p.OC_UnaryAddOrSubtractExpression()
 			}
 
 		}
-		p.SetState(991)
+		p.SetState(1013)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 169, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 171, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10733,7 +10974,7 @@ func (s *OC_UnaryAddOrSubtractExpressionContext) ExitRule(listener antlr.ParseTr
 
 func (p *CypherParser) OC_UnaryAddOrSubtractExpression() (localctx IOC_UnaryAddOrSubtractExpressionContext) {
 	localctx = NewOC_UnaryAddOrSubtractExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, CypherParserRULE_oC_UnaryAddOrSubtractExpression)
+	p.EnterRule(localctx, 118, CypherParserRULE_oC_UnaryAddOrSubtractExpression)
 	var _la int
 
 	defer func() {
@@ -10753,13 +10994,13 @@ func (p *CypherParser) OC_UnaryAddOrSubtractExpression() (localctx IOC_UnaryAddO
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(998)
+	p.SetState(1020)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserT__12 || _la == CypherParserT__13 {
 		{
-			p.SetState(992)
+			p.SetState(1014)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == CypherParserT__12 || _la == CypherParserT__13) {
@@ -10769,24 +11010,24 @@ func (p *CypherParser) OC_UnaryAddOrSubtractExpression() (localctx IOC_UnaryAddO
 				p.Consume()
 			}
 		}
-		p.SetState(994)
+		p.SetState(1016)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(993)
+				p.SetState(1015)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(1000)
+		p.SetState(1022)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1001)
+		p.SetState(1023)
 		p.OC_StringListNullOperatorExpression()
 	}
 
@@ -10932,7 +11173,7 @@ func (s *OC_StringListNullOperatorExpressionContext) ExitRule(listener antlr.Par
 
 func (p *CypherParser) OC_StringListNullOperatorExpression() (localctx IOC_StringListNullOperatorExpressionContext) {
 	localctx = NewOC_StringListNullOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, CypherParserRULE_oC_StringListNullOperatorExpression)
+	p.EnterRule(localctx, 120, CypherParserRULE_oC_StringListNullOperatorExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -10954,42 +11195,42 @@ func (p *CypherParser) OC_StringListNullOperatorExpression() (localctx IOC_Strin
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1003)
+		p.SetState(1025)
 		p.OC_PropertyOrLabelsExpression()
 	}
-	p.SetState(1009)
+	p.SetState(1031)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 173, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 175, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1007)
+			p.SetState(1029)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 172, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 174, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(1004)
+					p.SetState(1026)
 					p.OC_StringOperatorExpression()
 				}
 
 			case 2:
 				{
-					p.SetState(1005)
+					p.SetState(1027)
 					p.OC_ListOperatorExpression()
 				}
 
 			case 3:
 				{
-					p.SetState(1006)
+					p.SetState(1028)
 					p.OC_NullOperatorExpression()
 				}
 
 			}
 
 		}
-		p.SetState(1011)
+		p.SetState(1033)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 173, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 175, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11100,7 +11341,7 @@ func (s *OC_ListOperatorExpressionContext) ExitRule(listener antlr.ParseTreeList
 
 func (p *CypherParser) OC_ListOperatorExpression() (localctx IOC_ListOperatorExpressionContext) {
 	localctx = NewOC_ListOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, CypherParserRULE_oC_ListOperatorExpression)
+	p.EnterRule(localctx, 122, CypherParserRULE_oC_ListOperatorExpression)
 	var _la int
 
 	defer func() {
@@ -11119,106 +11360,106 @@ func (p *CypherParser) OC_ListOperatorExpression() (localctx IOC_ListOperatorExp
 		}
 	}()
 
-	p.SetState(1037)
+	p.SetState(1059)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 179, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 181, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1012)
+			p.SetState(1034)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1013)
+			p.SetState(1035)
 			p.Match(CypherParserIN)
 		}
-		p.SetState(1015)
+		p.SetState(1037)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1014)
+				p.SetState(1036)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1017)
+			p.SetState(1039)
 			p.OC_PropertyOrLabelsExpression()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(1019)
+		p.SetState(1041)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1018)
+				p.SetState(1040)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1021)
+			p.SetState(1043)
 			p.Match(CypherParserT__7)
 		}
 		{
-			p.SetState(1022)
+			p.SetState(1044)
 			p.OC_Expression()
 		}
 		{
-			p.SetState(1023)
+			p.SetState(1045)
 			p.Match(CypherParserT__8)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(1026)
+		p.SetState(1048)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1025)
+				p.SetState(1047)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1028)
+			p.SetState(1050)
 			p.Match(CypherParserT__7)
 		}
-		p.SetState(1030)
+		p.SetState(1052)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__18)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserREDUCE-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 			{
-				p.SetState(1029)
+				p.SetState(1051)
 				p.OC_Expression()
 			}
 
 		}
 		{
-			p.SetState(1032)
+			p.SetState(1054)
 			p.Match(CypherParserT__11)
 		}
-		p.SetState(1034)
+		p.SetState(1056)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__18)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserREDUCE-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 			{
-				p.SetState(1033)
+				p.SetState(1055)
 				p.OC_Expression()
 			}
 
 		}
 		{
-			p.SetState(1036)
+			p.SetState(1058)
 			p.Match(CypherParserT__8)
 		}
 
@@ -11321,7 +11562,7 @@ func (s *OC_StringOperatorExpressionContext) ExitRule(listener antlr.ParseTreeLi
 
 func (p *CypherParser) OC_StringOperatorExpression() (localctx IOC_StringOperatorExpressionContext) {
 	localctx = NewOC_StringOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, CypherParserRULE_oC_StringOperatorExpression)
+	p.EnterRule(localctx, 124, CypherParserRULE_oC_StringOperatorExpression)
 	var _la int
 
 	defer func() {
@@ -11341,69 +11582,86 @@ func (p *CypherParser) OC_StringOperatorExpression() (localctx IOC_StringOperato
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1049)
+	p.SetState(1075)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 180, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 183, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(1039)
+			p.SetState(1061)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1040)
+			p.SetState(1062)
 			p.Match(CypherParserSTARTS)
 		}
 		{
-			p.SetState(1041)
+			p.SetState(1063)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1042)
+			p.SetState(1064)
 			p.Match(CypherParserWITH)
 		}
 
 	case 2:
 		{
-			p.SetState(1043)
+			p.SetState(1065)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1044)
+			p.SetState(1066)
 			p.Match(CypherParserENDS)
 		}
 		{
-			p.SetState(1045)
+			p.SetState(1067)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1046)
+			p.SetState(1068)
 			p.Match(CypherParserWITH)
 		}
 
 	case 3:
 		{
-			p.SetState(1047)
+			p.SetState(1069)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1048)
+			p.SetState(1070)
 			p.Match(CypherParserCONTAINS)
 		}
 
+	case 4:
+		p.SetState(1072)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1071)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1074)
+			p.Match(CypherParserT__17)
+		}
+
 	}
-	p.SetState(1052)
+	p.SetState(1078)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1051)
+			p.SetState(1077)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1054)
+		p.SetState(1080)
 		p.OC_PropertyOrLabelsExpression()
 	}
 
@@ -11490,7 +11748,7 @@ func (s *OC_NullOperatorExpressionContext) ExitRule(listener antlr.ParseTreeList
 
 func (p *CypherParser) OC_NullOperatorExpression() (localctx IOC_NullOperatorExpressionContext) {
 	localctx = NewOC_NullOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, CypherParserRULE_oC_NullOperatorExpression)
+	p.EnterRule(localctx, 126, CypherParserRULE_oC_NullOperatorExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -11508,52 +11766,52 @@ func (p *CypherParser) OC_NullOperatorExpression() (localctx IOC_NullOperatorExp
 		}
 	}()
 
-	p.SetState(1066)
+	p.SetState(1092)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 182, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 185, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1056)
+			p.SetState(1082)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1057)
+			p.SetState(1083)
 			p.Match(CypherParserIS)
 		}
 		{
-			p.SetState(1058)
+			p.SetState(1084)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1059)
+			p.SetState(1085)
 			p.Match(CypherParserNULL)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1060)
+			p.SetState(1086)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1061)
+			p.SetState(1087)
 			p.Match(CypherParserIS)
 		}
 		{
-			p.SetState(1062)
+			p.SetState(1088)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1063)
+			p.SetState(1089)
 			p.Match(CypherParserNOT)
 		}
 		{
-			p.SetState(1064)
+			p.SetState(1090)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1065)
+			p.SetState(1091)
 			p.Match(CypherParserNULL)
 		}
 
@@ -11673,7 +11931,7 @@ func (s *OC_PropertyOrLabelsExpressionContext) ExitRule(listener antlr.ParseTree
 
 func (p *CypherParser) OC_PropertyOrLabelsExpression() (localctx IOC_PropertyOrLabelsExpressionContext) {
 	localctx = NewOC_PropertyOrLabelsExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, CypherParserRULE_oC_PropertyOrLabelsExpression)
+	p.EnterRule(localctx, 128, CypherParserRULE_oC_PropertyOrLabelsExpression)
 	var _la int
 
 	defer func() {
@@ -11696,53 +11954,53 @@ func (p *CypherParser) OC_PropertyOrLabelsExpression() (localctx IOC_PropertyOrL
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1068)
+		p.SetState(1094)
 		p.OC_Atom()
 	}
-	p.SetState(1075)
+	p.SetState(1101)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 187, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1070)
+			p.SetState(1096)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1069)
+					p.SetState(1095)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1072)
+				p.SetState(1098)
 				p.OC_PropertyLookup()
 			}
 
 		}
-		p.SetState(1077)
+		p.SetState(1103)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 187, p.GetParserRuleContext())
 	}
-	p.SetState(1082)
+	p.SetState(1108)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 186, p.GetParserRuleContext()) == 1 {
-		p.SetState(1079)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 189, p.GetParserRuleContext()) == 1 {
+		p.SetState(1105)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1078)
+				p.SetState(1104)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1081)
+			p.SetState(1107)
 			p.OC_NodeLabels()
 		}
 
@@ -11907,6 +12165,26 @@ func (s *OC_AtomContext) OC_FunctionInvocation() IOC_FunctionInvocationContext {
 	return t.(IOC_FunctionInvocationContext)
 }
 
+func (s *OC_AtomContext) OC_MapProjection() IOC_MapProjectionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_MapProjectionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_MapProjectionContext)
+}
+
+func (s *OC_AtomContext) OC_Reduce() IOC_ReduceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_ReduceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_ReduceContext)
+}
+
 func (s *OC_AtomContext) OC_Variable() IOC_VariableContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_VariableContext)(nil)).Elem(), 0)
 
@@ -11939,7 +12217,7 @@ func (s *OC_AtomContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Atom() (localctx IOC_AtomContext) {
 	localctx = NewOC_AtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, CypherParserRULE_oC_Atom)
+	p.EnterRule(localctx, 130, CypherParserRULE_oC_Atom)
 	var _la int
 
 	defer func() {
@@ -11958,330 +12236,1051 @@ func (p *CypherParser) OC_Atom() (localctx IOC_AtomContext) {
 		}
 	}()
 
-	p.SetState(1162)
+	p.SetState(1190)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 202, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 205, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1084)
+			p.SetState(1110)
 			p.OC_Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1085)
+			p.SetState(1111)
 			p.OC_Parameter()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1086)
+			p.SetState(1112)
 			p.OC_CaseExpression()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1087)
+			p.SetState(1113)
 			p.Match(CypherParserCOUNT)
 		}
-		p.SetState(1089)
+		p.SetState(1115)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1088)
+				p.SetState(1114)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1091)
+			p.SetState(1117)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1093)
+		p.SetState(1119)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1092)
+				p.SetState(1118)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1095)
+			p.SetState(1121)
 			p.Match(CypherParserT__4)
 		}
-		p.SetState(1097)
+		p.SetState(1123)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1096)
+				p.SetState(1122)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1099)
+			p.SetState(1125)
 			p.Match(CypherParserT__6)
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1100)
+			p.SetState(1126)
 			p.OC_ListComprehension()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1101)
+			p.SetState(1127)
 			p.OC_PatternComprehension()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1102)
+			p.SetState(1128)
 			p.Match(CypherParserALL)
 		}
-		p.SetState(1104)
+		p.SetState(1130)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1103)
+				p.SetState(1129)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1106)
+			p.SetState(1132)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1108)
+		p.SetState(1134)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1107)
+				p.SetState(1133)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1110)
+			p.SetState(1136)
+			p.OC_FilterExpression()
+		}
+		p.SetState(1138)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1137)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1140)
+			p.Match(CypherParserT__6)
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1142)
+			p.Match(CypherParserANY)
+		}
+		p.SetState(1144)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1143)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1146)
+			p.Match(CypherParserT__5)
+		}
+		p.SetState(1148)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1147)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1150)
+			p.OC_FilterExpression()
+		}
+		p.SetState(1152)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1151)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1154)
+			p.Match(CypherParserT__6)
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(1156)
+			p.Match(CypherParserNONE)
+		}
+		p.SetState(1158)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1157)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1160)
+			p.Match(CypherParserT__5)
+		}
+		p.SetState(1162)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1161)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1164)
 			p.OC_FilterExpression()
 		}
-		p.SetState(1112)
+		p.SetState(1166)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1111)
-				p.Match(CypherParserSP)
-			}
+		if _la == CypherParserSP {
+			{
+				p.SetState(1165)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1168)
+			p.Match(CypherParserT__6)
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(1170)
+			p.Match(CypherParserSINGLE)
+		}
+		p.SetState(1172)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1171)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1174)
+			p.Match(CypherParserT__5)
+		}
+		p.SetState(1176)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1175)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1178)
+			p.OC_FilterExpression()
+		}
+		p.SetState(1180)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1179)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1182)
+			p.Match(CypherParserT__6)
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(1184)
+			p.OC_RelationshipsPattern()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(1185)
+			p.OC_ParenthesizedExpression()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(1186)
+			p.OC_FunctionInvocation()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(1187)
+			p.OC_MapProjection()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(1188)
+			p.OC_Reduce()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(1189)
+			p.OC_Variable()
+		}
+
+	}
+
+	return localctx
+}
+
+// IOC_ReduceContext is an interface to support dynamic dispatch.
+type IOC_ReduceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOC_ReduceContext differentiates from other interfaces.
+	IsOC_ReduceContext()
+}
+
+type OC_ReduceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOC_ReduceContext() *OC_ReduceContext {
+	var p = new(OC_ReduceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_oC_Reduce
+	return p
+}
+
+func (*OC_ReduceContext) IsOC_ReduceContext() {}
+
+func NewOC_ReduceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OC_ReduceContext {
+	var p = new(OC_ReduceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_oC_Reduce
+
+	return p
+}
+
+func (s *OC_ReduceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OC_ReduceContext) REDUCE() antlr.TerminalNode {
+	return s.GetToken(CypherParserREDUCE, 0)
+}
+
+func (s *OC_ReduceContext) OC_Variable() IOC_VariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_VariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_VariableContext)
+}
+
+func (s *OC_ReduceContext) AllOC_Expression() []IOC_ExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOC_ExpressionContext)(nil)).Elem())
+	var tst = make([]IOC_ExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IOC_ExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OC_ReduceContext) OC_Expression(i int) IOC_ExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_ExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_ExpressionContext)
+}
+
+func (s *OC_ReduceContext) OC_IdInColl() IOC_IdInCollContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_IdInCollContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_IdInCollContext)
+}
+
+func (s *OC_ReduceContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *OC_ReduceContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *OC_ReduceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OC_ReduceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OC_ReduceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.EnterOC_Reduce(s)
+	}
+}
+
+func (s *OC_ReduceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.ExitOC_Reduce(s)
+	}
+}
+
+func (p *CypherParser) OC_Reduce() (localctx IOC_ReduceContext) {
+	localctx = NewOC_ReduceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, CypherParserRULE_oC_Reduce)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1192)
+		p.Match(CypherParserREDUCE)
+	}
+	p.SetState(1194)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1193)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1196)
+		p.Match(CypherParserT__5)
+	}
+	p.SetState(1198)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1197)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1200)
+		p.OC_Variable()
+	}
+	p.SetState(1202)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1201)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1204)
+		p.Match(CypherParserT__2)
+	}
+	p.SetState(1206)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1205)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1208)
+		p.OC_Expression()
+	}
+	p.SetState(1210)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1209)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1212)
+		p.Match(CypherParserT__1)
+	}
+	p.SetState(1214)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1213)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1216)
+		p.OC_IdInColl()
+	}
+	p.SetState(1218)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1217)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1220)
+		p.Match(CypherParserT__10)
+	}
+	p.SetState(1222)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1221)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1224)
+		p.OC_Expression()
+	}
+	p.SetState(1226)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1225)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1228)
+		p.Match(CypherParserT__6)
+	}
+
+	return localctx
+}
+
+// IOC_MapProjectionContext is an interface to support dynamic dispatch.
+type IOC_MapProjectionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOC_MapProjectionContext differentiates from other interfaces.
+	IsOC_MapProjectionContext()
+}
+
+type OC_MapProjectionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOC_MapProjectionContext() *OC_MapProjectionContext {
+	var p = new(OC_MapProjectionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_oC_MapProjection
+	return p
+}
+
+func (*OC_MapProjectionContext) IsOC_MapProjectionContext() {}
+
+func NewOC_MapProjectionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OC_MapProjectionContext {
+	var p = new(OC_MapProjectionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_oC_MapProjection
+
+	return p
+}
+
+func (s *OC_MapProjectionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OC_MapProjectionContext) OC_Variable() IOC_VariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_VariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_VariableContext)
+}
+
+func (s *OC_MapProjectionContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *OC_MapProjectionContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *OC_MapProjectionContext) AllOC_MapProjectionItem() []IOC_MapProjectionItemContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOC_MapProjectionItemContext)(nil)).Elem())
+	var tst = make([]IOC_MapProjectionItemContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IOC_MapProjectionItemContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OC_MapProjectionContext) OC_MapProjectionItem(i int) IOC_MapProjectionItemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_MapProjectionItemContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_MapProjectionItemContext)
+}
+
+func (s *OC_MapProjectionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OC_MapProjectionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OC_MapProjectionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.EnterOC_MapProjection(s)
+	}
+}
+
+func (s *OC_MapProjectionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.ExitOC_MapProjection(s)
+	}
+}
+
+func (p *CypherParser) OC_MapProjection() (localctx IOC_MapProjectionContext) {
+	localctx = NewOC_MapProjectionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, CypherParserRULE_oC_MapProjection)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1230)
+		p.OC_Variable()
+	}
+	p.SetState(1232)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1231)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	{
+		p.SetState(1234)
+		p.Match(CypherParserT__18)
+	}
+	p.SetState(1236)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserSP {
+		{
+			p.SetState(1235)
+			p.Match(CypherParserSP)
+		}
+
+	}
+	p.SetState(1255)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CypherParserT__20 || (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserUNION-47))|(1<<(CypherParserALL-47))|(1<<(CypherParserOPTIONAL-47))|(1<<(CypherParserMATCH-47))|(1<<(CypherParserUNWIND-47))|(1<<(CypherParserAS-47))|(1<<(CypherParserMERGE-47))|(1<<(CypherParserON-47))|(1<<(CypherParserCREATE-47))|(1<<(CypherParserSET-47))|(1<<(CypherParserDETACH-47))|(1<<(CypherParserDELETE-47))|(1<<(CypherParserREMOVE-47))|(1<<(CypherParserWITH-47))|(1<<(CypherParserRETURN-47))|(1<<(CypherParserDISTINCT-47))|(1<<(CypherParserORDER-47))|(1<<(CypherParserBY-47))|(1<<(CypherParserL_SKIP-47))|(1<<(CypherParserLIMIT-47))|(1<<(CypherParserASCENDING-47))|(1<<(CypherParserASC-47))|(1<<(CypherParserDESCENDING-47))|(1<<(CypherParserDESC-47))|(1<<(CypherParserWHERE-47))|(1<<(CypherParserSHORTESTPATH-47))|(1<<(CypherParserALLSHORTESTPATHS-47))|(1<<(CypherParserOR-47))|(1<<(CypherParserXOR-47))|(1<<(CypherParserAND-47)))) != 0) || (((_la-79)&-(0x1f+1)) == 0 && ((1<<uint((_la-79)))&((1<<(CypherParserNOT-79))|(1<<(CypherParserIN-79))|(1<<(CypherParserSTARTS-79))|(1<<(CypherParserENDS-79))|(1<<(CypherParserCONTAINS-79))|(1<<(CypherParserIS-79))|(1<<(CypherParserNULL-79))|(1<<(CypherParserREDUCE-79))|(1<<(CypherParserCOUNT-79))|(1<<(CypherParserANY-79))|(1<<(CypherParserNONE-79))|(1<<(CypherParserSINGLE-79))|(1<<(CypherParserTRUE-79))|(1<<(CypherParserFALSE-79))|(1<<(CypherParserEXISTS-79))|(1<<(CypherParserCASE-79))|(1<<(CypherParserELSE-79))|(1<<(CypherParserEND-79))|(1<<(CypherParserWHEN-79))|(1<<(CypherParserTHEN-79))|(1<<(CypherParserHexLetter-79)))) != 0) || (((_la-113)&-(0x1f+1)) == 0 && ((1<<uint((_la-113)))&((1<<(CypherParserCONSTRAINT-113))|(1<<(CypherParserDO-113))|(1<<(CypherParserFOR-113))|(1<<(CypherParserREQUIRE-113))|(1<<(CypherParserUNIQUE-113))|(1<<(CypherParserMANDATORY-113))|(1<<(CypherParserSCALAR-113))|(1<<(CypherParserOF-113))|(1<<(CypherParserADD-113))|(1<<(CypherParserDROP-113))|(1<<(CypherParserFILTER-113))|(1<<(CypherParserEXTRACT-113))|(1<<(CypherParserUnescapedSymbolicName-113))|(1<<(CypherParserEscapedSymbolicName-113)))) != 0) {
+		{
+			p.SetState(1238)
+			p.OC_MapProjectionItem()
+		}
+		p.SetState(1240)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1239)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		p.SetState(1252)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CypherParserT__1 {
+			{
+				p.SetState(1242)
+				p.Match(CypherParserT__1)
+			}
+			p.SetState(1244)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == CypherParserSP {
+				{
+					p.SetState(1243)
+					p.Match(CypherParserSP)
+				}
+
+			}
+			{
+				p.SetState(1246)
+				p.OC_MapProjectionItem()
+			}
+			p.SetState(1248)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == CypherParserSP {
+				{
+					p.SetState(1247)
+					p.Match(CypherParserSP)
+				}
+
+			}
+
+			p.SetState(1254)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(1257)
+		p.Match(CypherParserT__19)
+	}
+
+	return localctx
+}
+
+// IOC_MapProjectionItemContext is an interface to support dynamic dispatch.
+type IOC_MapProjectionItemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOC_MapProjectionItemContext differentiates from other interfaces.
+	IsOC_MapProjectionItemContext()
+}
+
+type OC_MapProjectionItemContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOC_MapProjectionItemContext() *OC_MapProjectionItemContext {
+	var p = new(OC_MapProjectionItemContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_oC_MapProjectionItem
+	return p
+}
+
+func (*OC_MapProjectionItemContext) IsOC_MapProjectionItemContext() {}
+
+func NewOC_MapProjectionItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OC_MapProjectionItemContext {
+	var p = new(OC_MapProjectionItemContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_oC_MapProjectionItem
+
+	return p
+}
+
+func (s *OC_MapProjectionItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OC_MapProjectionItemContext) OC_PropertyLookup() IOC_PropertyLookupContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_PropertyLookupContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_PropertyLookupContext)
+}
+
+func (s *OC_MapProjectionItemContext) OC_PropertyKeyName() IOC_PropertyKeyNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_PropertyKeyNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_PropertyKeyNameContext)
+}
+
+func (s *OC_MapProjectionItemContext) OC_Expression() IOC_ExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_ExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_ExpressionContext)
+}
+
+func (s *OC_MapProjectionItemContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *OC_MapProjectionItemContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *OC_MapProjectionItemContext) OC_Variable() IOC_VariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_VariableContext)(nil)).Elem(), 0)
 
-		}
-		{
-			p.SetState(1114)
-			p.Match(CypherParserT__6)
-		}
+	if t == nil {
+		return nil
+	}
 
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(1116)
-			p.Match(CypherParserANY)
-		}
-		p.SetState(1118)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	return t.(IOC_VariableContext)
+}
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1117)
-				p.Match(CypherParserSP)
-			}
+func (s *OC_MapProjectionItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-		}
-		{
-			p.SetState(1120)
-			p.Match(CypherParserT__5)
-		}
-		p.SetState(1122)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+func (s *OC_MapProjectionItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1121)
-				p.Match(CypherParserSP)
-			}
+func (s *OC_MapProjectionItemContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.EnterOC_MapProjectionItem(s)
+	}
+}
 
-		}
-		{
-			p.SetState(1124)
-			p.OC_FilterExpression()
-		}
-		p.SetState(1126)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+func (s *OC_MapProjectionItemContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.ExitOC_MapProjectionItem(s)
+	}
+}
 
-		if _la == CypherParserSP {
-			{
-				p.SetState(1125)
-				p.Match(CypherParserSP)
-			}
+func (p *CypherParser) OC_MapProjectionItem() (localctx IOC_MapProjectionItemContext) {
+	localctx = NewOC_MapProjectionItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, CypherParserRULE_oC_MapProjectionItem)
+	var _la int
 
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
-		{
-			p.SetState(1128)
-			p.Match(CypherParserT__6)
-		}
+	}()
 
-	case 9:
-		p.EnterOuterAlt(localctx, 9)
+	p.SetState(1276)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 225, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1130)
-			p.Match(CypherParserNONE)
+			p.SetState(1259)
+			p.OC_PropertyLookup()
 		}
-		p.SetState(1132)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == CypherParserSP {
-			{
-				p.SetState(1131)
-				p.Match(CypherParserSP)
-			}
 
-		}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1134)
-			p.Match(CypherParserT__5)
+			p.SetState(1260)
+			p.OC_PropertyKeyName()
 		}
-		p.SetState(1136)
+		p.SetState(1262)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1135)
+				p.SetState(1261)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1138)
-			p.OC_FilterExpression()
+			p.SetState(1264)
+			p.Match(CypherParserT__9)
 		}
-		p.SetState(1140)
+		p.SetState(1266)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1139)
+				p.SetState(1265)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1142)
-			p.Match(CypherParserT__6)
-		}
-
-	case 10:
-		p.EnterOuterAlt(localctx, 10)
-		{
-			p.SetState(1144)
-			p.Match(CypherParserSINGLE)
+			p.SetState(1268)
+			p.OC_Expression()
 		}
-		p.SetState(1146)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == CypherParserSP {
-			{
-				p.SetState(1145)
-				p.Match(CypherParserSP)
-			}
 
-		}
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1148)
-			p.Match(CypherParserT__5)
+			p.SetState(1270)
+			p.OC_Variable()
 		}
-		p.SetState(1150)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == CypherParserSP {
-			{
-				p.SetState(1149)
-				p.Match(CypherParserSP)
-			}
 
-		}
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1152)
-			p.OC_FilterExpression()
+			p.SetState(1271)
+			p.Match(CypherParserT__20)
 		}
-		p.SetState(1154)
+		p.SetState(1273)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1153)
+				p.SetState(1272)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1156)
-			p.Match(CypherParserT__6)
-		}
-
-	case 11:
-		p.EnterOuterAlt(localctx, 11)
-		{
-			p.SetState(1158)
-			p.OC_RelationshipsPattern()
-		}
-
-	case 12:
-		p.EnterOuterAlt(localctx, 12)
-		{
-			p.SetState(1159)
-			p.OC_ParenthesizedExpression()
-		}
-
-	case 13:
-		p.EnterOuterAlt(localctx, 13)
-		{
-			p.SetState(1160)
-			p.OC_FunctionInvocation()
-		}
-
-	case 14:
-		p.EnterOuterAlt(localctx, 14)
-		{
-			p.SetState(1161)
-			p.OC_Variable()
+			p.SetState(1275)
+			p.Match(CypherParserT__4)
 		}
 
 	}
@@ -12397,7 +13396,7 @@ func (s *OC_LiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Literal() (localctx IOC_LiteralContext) {
 	localctx = NewOC_LiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, CypherParserRULE_oC_Literal)
+	p.EnterRule(localctx, 138, CypherParserRULE_oC_Literal)
 
 	defer func() {
 		p.ExitRule()
@@ -12415,49 +13414,49 @@ func (p *CypherParser) OC_Literal() (localctx IOC_LiteralContext) {
 		}
 	}()
 
-	p.SetState(1170)
+	p.SetState(1284)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1164)
+			p.SetState(1278)
 			p.OC_NumberLiteral()
 		}
 
 	case CypherParserStringLiteral:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1165)
+			p.SetState(1279)
 			p.Match(CypherParserStringLiteral)
 		}
 
 	case CypherParserTRUE, CypherParserFALSE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1166)
+			p.SetState(1280)
 			p.OC_BooleanLiteral()
 		}
 
 	case CypherParserNULL:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1167)
+			p.SetState(1281)
 			p.Match(CypherParserNULL)
 		}
 
-	case CypherParserT__23:
+	case CypherParserT__18:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1168)
+			p.SetState(1282)
 			p.OC_MapLiteral()
 		}
 
 	case CypherParserT__7:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1169)
+			p.SetState(1283)
 			p.OC_ListLiteral()
 		}
 
@@ -12536,7 +13535,7 @@ func (s *OC_BooleanLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_BooleanLiteral() (localctx IOC_BooleanLiteralContext) {
 	localctx = NewOC_BooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, CypherParserRULE_oC_BooleanLiteral)
+	p.EnterRule(localctx, 140, CypherParserRULE_oC_BooleanLiteral)
 	var _la int
 
 	defer func() {
@@ -12557,7 +13556,7 @@ func (p *CypherParser) OC_BooleanLiteral() (localctx IOC_BooleanLiteralContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1172)
+		p.SetState(1286)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == CypherParserTRUE || _la == CypherParserFALSE) {
@@ -12662,7 +13661,7 @@ func (s *OC_ListLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_ListLiteral() (localctx IOC_ListLiteralContext) {
 	localctx = NewOC_ListLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, CypherParserRULE_oC_ListLiteral)
+	p.EnterRule(localctx, 142, CypherParserRULE_oC_ListLiteral)
 	var _la int
 
 	defer func() {
@@ -12683,84 +13682,84 @@ func (p *CypherParser) OC_ListLiteral() (localctx IOC_ListLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1174)
+		p.SetState(1288)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(1176)
+	p.SetState(1290)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1175)
+			p.SetState(1289)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1195)
+	p.SetState(1309)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__18)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserREDUCE-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 		{
-			p.SetState(1178)
+			p.SetState(1292)
 			p.OC_Expression()
 		}
-		p.SetState(1180)
+		p.SetState(1294)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1179)
+				p.SetState(1293)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1192)
+		p.SetState(1306)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1182)
+				p.SetState(1296)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1184)
+			p.SetState(1298)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1183)
+					p.SetState(1297)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1186)
+				p.SetState(1300)
 				p.OC_Expression()
 			}
-			p.SetState(1188)
+			p.SetState(1302)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1187)
+					p.SetState(1301)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1194)
+			p.SetState(1308)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1197)
+		p.SetState(1311)
 		p.Match(CypherParserT__8)
 	}
 
@@ -12841,7 +13840,7 @@ func (s *OC_PartialComparisonExpressionContext) ExitRule(listener antlr.ParseTre
 
 func (p *CypherParser) OC_PartialComparisonExpression() (localctx IOC_PartialComparisonExpressionContext) {
 	localctx = NewOC_PartialComparisonExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, CypherParserRULE_oC_PartialComparisonExpression)
+	p.EnterRule(localctx, 144, CypherParserRULE_oC_PartialComparisonExpression)
 	var _la int
 
 	defer func() {
@@ -12860,139 +13859,139 @@ func (p *CypherParser) OC_PartialComparisonExpression() (localctx IOC_PartialCom
 		}
 	}()
 
-	p.SetState(1229)
+	p.SetState(1343)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__2:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1199)
+			p.SetState(1313)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(1201)
+		p.SetState(1315)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1200)
+				p.SetState(1314)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1203)
+			p.SetState(1317)
 			p.OC_AddOrSubtractExpression()
 		}
 
-	case CypherParserT__17:
+	case CypherParserT__21:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1204)
-			p.Match(CypherParserT__17)
+			p.SetState(1318)
+			p.Match(CypherParserT__21)
 		}
-		p.SetState(1206)
+		p.SetState(1320)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1205)
+				p.SetState(1319)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1208)
+			p.SetState(1322)
 			p.OC_AddOrSubtractExpression()
 		}
 
-	case CypherParserT__18:
+	case CypherParserT__22:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1209)
-			p.Match(CypherParserT__18)
+			p.SetState(1323)
+			p.Match(CypherParserT__22)
 		}
-		p.SetState(1211)
+		p.SetState(1325)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1210)
+				p.SetState(1324)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1213)
+			p.SetState(1327)
 			p.OC_AddOrSubtractExpression()
 		}
 
-	case CypherParserT__19:
+	case CypherParserT__23:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1214)
-			p.Match(CypherParserT__19)
+			p.SetState(1328)
+			p.Match(CypherParserT__23)
 		}
-		p.SetState(1216)
+		p.SetState(1330)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1215)
+				p.SetState(1329)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1218)
+			p.SetState(1332)
 			p.OC_AddOrSubtractExpression()
 		}
 
-	case CypherParserT__20:
+	case CypherParserT__24:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1219)
-			p.Match(CypherParserT__20)
+			p.SetState(1333)
+			p.Match(CypherParserT__24)
 		}
-		p.SetState(1221)
+		p.SetState(1335)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1220)
+				p.SetState(1334)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1223)
+			p.SetState(1337)
 			p.OC_AddOrSubtractExpression()
 		}
 
-	case CypherParserT__21:
+	case CypherParserT__25:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1224)
-			p.Match(CypherParserT__21)
+			p.SetState(1338)
+			p.Match(CypherParserT__25)
 		}
-		p.SetState(1226)
+		p.SetState(1340)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1225)
+				p.SetState(1339)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1228)
+			p.SetState(1342)
 			p.OC_AddOrSubtractExpression()
 		}
 
@@ -13081,7 +14080,7 @@ func (s *OC_ParenthesizedExpressionContext) ExitRule(listener antlr.ParseTreeLis
 
 func (p *CypherParser) OC_ParenthesizedExpression() (localctx IOC_ParenthesizedExpressionContext) {
 	localctx = NewOC_ParenthesizedExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, CypherParserRULE_oC_ParenthesizedExpression)
+	p.EnterRule(localctx, 146, CypherParserRULE_oC_ParenthesizedExpression)
 	var _la int
 
 	defer func() {
@@ -13102,37 +14101,37 @@ func (p *CypherParser) OC_ParenthesizedExpression() (localctx IOC_ParenthesizedE
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1231)
+		p.SetState(1345)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(1233)
+	p.SetState(1347)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1232)
+			p.SetState(1346)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1235)
+		p.SetState(1349)
 		p.OC_Expression()
 	}
-	p.SetState(1237)
+	p.SetState(1351)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1236)
+			p.SetState(1350)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1239)
+		p.SetState(1353)
 		p.Match(CypherParserT__6)
 	}
 
@@ -13240,7 +14239,7 @@ func (s *OC_RelationshipsPatternContext) ExitRule(listener antlr.ParseTreeListen
 
 func (p *CypherParser) OC_RelationshipsPattern() (localctx IOC_RelationshipsPatternContext) {
 	localctx = NewOC_RelationshipsPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, CypherParserRULE_oC_RelationshipsPattern)
+	p.EnterRule(localctx, 148, CypherParserRULE_oC_RelationshipsPattern)
 	var _la int
 
 	defer func() {
@@ -13263,28 +14262,28 @@ func (p *CypherParser) OC_RelationshipsPattern() (localctx IOC_RelationshipsPatt
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1241)
+		p.SetState(1355)
 		p.OC_NodePattern()
 	}
-	p.SetState(1246)
+	p.SetState(1360)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(1243)
+			p.SetState(1357)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1242)
+					p.SetState(1356)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1245)
+				p.SetState(1359)
 				p.OC_PatternElementChain()
 			}
 
@@ -13292,9 +14291,9 @@ func (p *CypherParser) OC_RelationshipsPattern() (localctx IOC_RelationshipsPatt
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(1248)
+		p.SetState(1362)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 220, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 243, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13384,7 +14383,7 @@ func (s *OC_FilterExpressionContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *CypherParser) OC_FilterExpression() (localctx IOC_FilterExpressionContext) {
 	localctx = NewOC_FilterExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, CypherParserRULE_oC_FilterExpression)
+	p.EnterRule(localctx, 150, CypherParserRULE_oC_FilterExpression)
 	var _la int
 
 	defer func() {
@@ -13405,26 +14404,26 @@ func (p *CypherParser) OC_FilterExpression() (localctx IOC_FilterExpressionConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1250)
+		p.SetState(1364)
 		p.OC_IdInColl()
 	}
-	p.SetState(1255)
+	p.SetState(1369)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 222, p.GetParserRuleContext()) == 1 {
-		p.SetState(1252)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 245, p.GetParserRuleContext()) == 1 {
+		p.SetState(1366)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1251)
+				p.SetState(1365)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1254)
+			p.SetState(1368)
 			p.OC_Where()
 		}
 
@@ -13525,7 +14524,7 @@ func (s *OC_IdInCollContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_IdInColl() (localctx IOC_IdInCollContext) {
 	localctx = NewOC_IdInCollContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, CypherParserRULE_oC_IdInColl)
+	p.EnterRule(localctx, 152, CypherParserRULE_oC_IdInColl)
 
 	defer func() {
 		p.ExitRule()
@@ -13545,23 +14544,23 @@ func (p *CypherParser) OC_IdInColl() (localctx IOC_IdInCollContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1257)
+		p.SetState(1371)
 		p.OC_Variable()
 	}
 	{
-		p.SetState(1258)
+		p.SetState(1372)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(1259)
+		p.SetState(1373)
 		p.Match(CypherParserIN)
 	}
 	{
-		p.SetState(1260)
+		p.SetState(1374)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(1261)
+		p.SetState(1375)
 		p.OC_Expression()
 	}
 
@@ -13673,7 +14672,7 @@ func (s *OC_FunctionInvocationContext) ExitRule(listener antlr.ParseTreeListener
 
 func (p *CypherParser) OC_FunctionInvocation() (localctx IOC_FunctionInvocationContext) {
 	localctx = NewOC_FunctionInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, CypherParserRULE_oC_FunctionInvocation)
+	p.EnterRule(localctx, 154, CypherParserRULE_oC_FunctionInvocation)
 	var _la int
 
 	defer func() {
@@ -13694,121 +14693,121 @@ func (p *CypherParser) OC_FunctionInvocation() (localctx IOC_FunctionInvocationC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1263)
+		p.SetState(1377)
 		p.OC_FunctionName()
 	}
-	p.SetState(1265)
+	p.SetState(1379)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1264)
+			p.SetState(1378)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1267)
+		p.SetState(1381)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(1269)
+	p.SetState(1383)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1268)
+			p.SetState(1382)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1275)
+	p.SetState(1389)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserDISTINCT {
 		{
-			p.SetState(1271)
+			p.SetState(1385)
 			p.Match(CypherParserDISTINCT)
 		}
-		p.SetState(1273)
+		p.SetState(1387)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1272)
+				p.SetState(1386)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(1294)
+	p.SetState(1408)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__18)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserREDUCE-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 		{
-			p.SetState(1277)
+			p.SetState(1391)
 			p.OC_Expression()
 		}
-		p.SetState(1279)
+		p.SetState(1393)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1278)
+				p.SetState(1392)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1291)
+		p.SetState(1405)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1281)
+				p.SetState(1395)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1283)
+			p.SetState(1397)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1282)
+					p.SetState(1396)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1285)
+				p.SetState(1399)
 				p.OC_Expression()
 			}
-			p.SetState(1287)
+			p.SetState(1401)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1286)
+					p.SetState(1400)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1293)
+			p.SetState(1407)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1296)
+		p.SetState(1410)
 		p.Match(CypherParserT__6)
 	}
 
@@ -13899,7 +14898,7 @@ func (s *OC_FunctionNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_FunctionName() (localctx IOC_FunctionNameContext) {
 	localctx = NewOC_FunctionNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, CypherParserRULE_oC_FunctionName)
+	p.EnterRule(localctx, 156, CypherParserRULE_oC_FunctionName)
 
 	defer func() {
 		p.ExitRule()
@@ -13917,25 +14916,25 @@ func (p *CypherParser) OC_FunctionName() (localctx IOC_FunctionNameContext) {
 		}
 	}()
 
-	p.SetState(1302)
+	p.SetState(1416)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserREDUCE, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1298)
+			p.SetState(1412)
 			p.OC_Namespace()
 		}
 		{
-			p.SetState(1299)
+			p.SetState(1413)
 			p.OC_SymbolicName()
 		}
 
 	case CypherParserEXISTS:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1301)
+			p.SetState(1415)
 			p.Match(CypherParserEXISTS)
 		}
 
@@ -14047,7 +15046,7 @@ func (s *OC_ExplicitProcedureInvocationContext) ExitRule(listener antlr.ParseTre
 
 func (p *CypherParser) OC_ExplicitProcedureInvocation() (localctx IOC_ExplicitProcedureInvocationContext) {
 	localctx = NewOC_ExplicitProcedureInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, CypherParserRULE_oC_ExplicitProcedureInvocation)
+	p.EnterRule(localctx, 158, CypherParserRULE_oC_ExplicitProcedureInvocation)
 	var _la int
 
 	defer func() {
@@ -14068,99 +15067,99 @@ func (p *CypherParser) OC_ExplicitProcedureInvocation() (localctx IOC_ExplicitPr
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1304)
+		p.SetState(1418)
 		p.OC_ProcedureName()
 	}
-	p.SetState(1306)
+	p.SetState(1420)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1305)
+			p.SetState(1419)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1308)
+		p.SetState(1422)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(1310)
+	p.SetState(1424)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1309)
+			p.SetState(1423)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1329)
+	p.SetState(1443)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__18)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserREDUCE-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 		{
-			p.SetState(1312)
+			p.SetState(1426)
 			p.OC_Expression()
 		}
-		p.SetState(1314)
+		p.SetState(1428)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1313)
+				p.SetState(1427)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1326)
+		p.SetState(1440)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1316)
+				p.SetState(1430)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1318)
+			p.SetState(1432)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1317)
+					p.SetState(1431)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1320)
+				p.SetState(1434)
 				p.OC_Expression()
 			}
-			p.SetState(1322)
+			p.SetState(1436)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1321)
+					p.SetState(1435)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1328)
+			p.SetState(1442)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1331)
+		p.SetState(1445)
 		p.Match(CypherParserT__6)
 	}
 
@@ -14237,7 +15236,7 @@ func (s *OC_ImplicitProcedureInvocationContext) ExitRule(listener antlr.ParseTre
 
 func (p *CypherParser) OC_ImplicitProcedureInvocation() (localctx IOC_ImplicitProcedureInvocationContext) {
 	localctx = NewOC_ImplicitProcedureInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, CypherParserRULE_oC_ImplicitProcedureInvocation)
+	p.EnterRule(localctx, 160, CypherParserRULE_oC_ImplicitProcedureInvocation)
 
 	defer func() {
 		p.ExitRule()
@@ -14257,7 +15256,7 @@ func (p *CypherParser) OC_ImplicitProcedureInvocation() (localctx IOC_ImplicitPr
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1333)
+		p.SetState(1447)
 		p.OC_ProcedureName()
 	}
 
@@ -14334,7 +15333,7 @@ func (s *OC_ProcedureResultFieldContext) ExitRule(listener antlr.ParseTreeListen
 
 func (p *CypherParser) OC_ProcedureResultField() (localctx IOC_ProcedureResultFieldContext) {
 	localctx = NewOC_ProcedureResultFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, CypherParserRULE_oC_ProcedureResultField)
+	p.EnterRule(localctx, 162, CypherParserRULE_oC_ProcedureResultField)
 
 	defer func() {
 		p.ExitRule()
@@ -14354,7 +15353,7 @@ func (p *CypherParser) OC_ProcedureResultField() (localctx IOC_ProcedureResultFi
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1335)
+		p.SetState(1449)
 		p.OC_SymbolicName()
 	}
 
@@ -14441,7 +15440,7 @@ func (s *OC_ProcedureNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_ProcedureName() (localctx IOC_ProcedureNameContext) {
 	localctx = NewOC_ProcedureNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, CypherParserRULE_oC_ProcedureName)
+	p.EnterRule(localctx, 164, CypherParserRULE_oC_ProcedureName)
 
 	defer func() {
 		p.ExitRule()
@@ -14461,11 +15460,11 @@ func (p *CypherParser) OC_ProcedureName() (localctx IOC_ProcedureNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1337)
+		p.SetState(1451)
 		p.OC_Namespace()
 	}
 	{
-		p.SetState(1338)
+		p.SetState(1452)
 		p.OC_SymbolicName()
 	}
 
@@ -14555,7 +15554,7 @@ func (s *OC_NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Namespace() (localctx IOC_NamespaceContext) {
 	localctx = NewOC_NamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, CypherParserRULE_oC_Namespace)
+	p.EnterRule(localctx, 166, CypherParserRULE_oC_Namespace)
 
 	defer func() {
 		p.ExitRule()
@@ -14576,25 +15575,25 @@ func (p *CypherParser) OC_Namespace() (localctx IOC_NamespaceContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1345)
+	p.SetState(1459)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 240, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 263, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(1340)
+				p.SetState(1454)
 				p.OC_SymbolicName()
 			}
 			{
-				p.SetState(1341)
-				p.Match(CypherParserT__22)
+				p.SetState(1455)
+				p.Match(CypherParserT__20)
 			}
 
 		}
-		p.SetState(1347)
+		p.SetState(1461)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 240, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 263, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -14688,7 +15687,7 @@ func (s *OC_ListComprehensionContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *CypherParser) OC_ListComprehension() (localctx IOC_ListComprehensionContext) {
 	localctx = NewOC_ListComprehensionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, CypherParserRULE_oC_ListComprehension)
+	p.EnterRule(localctx, 168, CypherParserRULE_oC_ListComprehension)
 	var _la int
 
 	defer func() {
@@ -14709,73 +15708,73 @@ func (p *CypherParser) OC_ListComprehension() (localctx IOC_ListComprehensionCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1348)
+		p.SetState(1462)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(1350)
+	p.SetState(1464)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1349)
+			p.SetState(1463)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1352)
+		p.SetState(1466)
 		p.OC_FilterExpression()
 	}
-	p.SetState(1361)
+	p.SetState(1475)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 244, p.GetParserRuleContext()) == 1 {
-		p.SetState(1354)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 267, p.GetParserRuleContext()) == 1 {
+		p.SetState(1468)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1353)
+				p.SetState(1467)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1356)
+			p.SetState(1470)
 			p.Match(CypherParserT__10)
 		}
-		p.SetState(1358)
+		p.SetState(1472)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1357)
+				p.SetState(1471)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1360)
+			p.SetState(1474)
 			p.OC_Expression()
 		}
 
 	}
-	p.SetState(1364)
+	p.SetState(1478)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1363)
+			p.SetState(1477)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1366)
+		p.SetState(1480)
 		p.Match(CypherParserT__8)
 	}
 
@@ -14897,7 +15896,7 @@ func (s *OC_PatternComprehensionContext) ExitRule(listener antlr.ParseTreeListen
 
 func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehensionContext) {
 	localctx = NewOC_PatternComprehensionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, CypherParserRULE_oC_PatternComprehension)
+	p.EnterRule(localctx, 170, CypherParserRULE_oC_PatternComprehension)
 	var _la int
 
 	defer func() {
@@ -14918,51 +15917,51 @@ func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehens
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1368)
+		p.SetState(1482)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(1370)
+	p.SetState(1484)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1369)
+			p.SetState(1483)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1380)
+	p.SetState(1494)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserREDUCE-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0) {
 		{
-			p.SetState(1372)
+			p.SetState(1486)
 			p.OC_Variable()
 		}
-		p.SetState(1374)
+		p.SetState(1488)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1373)
+				p.SetState(1487)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1376)
+			p.SetState(1490)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(1378)
+		p.SetState(1492)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1377)
+				p.SetState(1491)
 				p.Match(CypherParserSP)
 			}
 
@@ -14970,51 +15969,51 @@ func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehens
 
 	}
 	{
-		p.SetState(1382)
+		p.SetState(1496)
 		p.OC_RelationshipsPattern()
 	}
-	p.SetState(1384)
+	p.SetState(1498)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1383)
+			p.SetState(1497)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1394)
+	p.SetState(1508)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserWHERE {
 		{
-			p.SetState(1386)
+			p.SetState(1500)
 			p.Match(CypherParserWHERE)
 		}
-		p.SetState(1388)
+		p.SetState(1502)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1387)
+				p.SetState(1501)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1390)
+			p.SetState(1504)
 			p.OC_Expression()
 		}
-		p.SetState(1392)
+		p.SetState(1506)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1391)
+				p.SetState(1505)
 				p.Match(CypherParserSP)
 			}
 
@@ -15022,37 +16021,37 @@ func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehens
 
 	}
 	{
-		p.SetState(1396)
+		p.SetState(1510)
 		p.Match(CypherParserT__10)
 	}
-	p.SetState(1398)
+	p.SetState(1512)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1397)
+			p.SetState(1511)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1400)
+		p.SetState(1514)
 		p.OC_Expression()
 	}
-	p.SetState(1402)
+	p.SetState(1516)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1401)
+			p.SetState(1515)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1404)
+		p.SetState(1518)
 		p.Match(CypherParserT__8)
 	}
 
@@ -15133,7 +16132,7 @@ func (s *OC_PropertyLookupContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_PropertyLookup() (localctx IOC_PropertyLookupContext) {
 	localctx = NewOC_PropertyLookupContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, CypherParserRULE_oC_PropertyLookup)
+	p.EnterRule(localctx, 172, CypherParserRULE_oC_PropertyLookup)
 	var _la int
 
 	defer func() {
@@ -15154,23 +16153,23 @@ func (p *CypherParser) OC_PropertyLookup() (localctx IOC_PropertyLookupContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1406)
-		p.Match(CypherParserT__22)
+		p.SetState(1520)
+		p.Match(CypherParserT__20)
 	}
-	p.SetState(1408)
+	p.SetState(1522)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1407)
+			p.SetState(1521)
 			p.Match(CypherParserSP)
 		}
 
 	}
 
 	{
-		p.SetState(1410)
+		p.SetState(1524)
 		p.OC_PropertyKeyName()
 	}
 
@@ -15303,7 +16302,7 @@ func (s *OC_CaseExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext) {
 	localctx = NewOC_CaseExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, CypherParserRULE_oC_CaseExpression)
+	p.EnterRule(localctx, 174, CypherParserRULE_oC_CaseExpression)
 	var _la int
 
 	defer func() {
@@ -15325,33 +16324,33 @@ func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1434)
+	p.SetState(1548)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 262, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 285, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(1412)
+			p.SetState(1526)
 			p.Match(CypherParserCASE)
 		}
-		p.SetState(1417)
+		p.SetState(1531)
 		p.GetErrorHandler().Sync(p)
 		_alt = 1
 		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			switch _alt {
 			case 1:
-				p.SetState(1414)
+				p.SetState(1528)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(1413)
+						p.SetState(1527)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(1416)
+					p.SetState(1530)
 					p.OC_CaseAlternatives()
 				}
 
@@ -15359,50 +16358,50 @@ func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext)
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(1419)
+			p.SetState(1533)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 258, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 281, p.GetParserRuleContext())
 		}
 
 	case 2:
 		{
-			p.SetState(1421)
+			p.SetState(1535)
 			p.Match(CypherParserCASE)
 		}
-		p.SetState(1423)
+		p.SetState(1537)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1422)
+				p.SetState(1536)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1425)
+			p.SetState(1539)
 			p.OC_Expression()
 		}
-		p.SetState(1430)
+		p.SetState(1544)
 		p.GetErrorHandler().Sync(p)
 		_alt = 1
 		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			switch _alt {
 			case 1:
-				p.SetState(1427)
+				p.SetState(1541)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(1426)
+						p.SetState(1540)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(1429)
+					p.SetState(1543)
 					p.OC_CaseAlternatives()
 				}
 
@@ -15410,61 +16409,61 @@ func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext)
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(1432)
+			p.SetState(1546)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 261, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 284, p.GetParserRuleContext())
 		}
 
 	}
-	p.SetState(1444)
+	p.SetState(1558)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 265, p.GetParserRuleContext()) == 1 {
-		p.SetState(1437)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 288, p.GetParserRuleContext()) == 1 {
+		p.SetState(1551)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1436)
+				p.SetState(1550)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1439)
+			p.SetState(1553)
 			p.Match(CypherParserELSE)
 		}
-		p.SetState(1441)
+		p.SetState(1555)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1440)
+				p.SetState(1554)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1443)
+			p.SetState(1557)
 			p.OC_Expression()
 		}
 
 	}
-	p.SetState(1447)
+	p.SetState(1561)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1446)
+			p.SetState(1560)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1449)
+		p.SetState(1563)
 		p.Match(CypherParserEND)
 	}
 
@@ -15570,7 +16569,7 @@ func (s *OC_CaseAlternativesContext) ExitRule(listener antlr.ParseTreeListener)
 
 func (p *CypherParser) OC_CaseAlternatives() (localctx IOC_CaseAlternativesContext) {
 	localctx = NewOC_CaseAlternativesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, CypherParserRULE_oC_CaseAlternatives)
+	p.EnterRule(localctx, 176, CypherParserRULE_oC_CaseAlternatives)
 	var _la int
 
 	defer func() {
@@ -15591,52 +16590,52 @@ func (p *CypherParser) OC_CaseAlternatives() (localctx IOC_CaseAlternativesConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1451)
+		p.SetState(1565)
 		p.Match(CypherParserWHEN)
 	}
-	p.SetState(1453)
+	p.SetState(1567)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1452)
+			p.SetState(1566)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1455)
+		p.SetState(1569)
 		p.OC_Expression()
 	}
-	p.SetState(1457)
+	p.SetState(1571)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1456)
+			p.SetState(1570)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1459)
+		p.SetState(1573)
 		p.Match(CypherParserTHEN)
 	}
-	p.SetState(1461)
+	p.SetState(1575)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1460)
+			p.SetState(1574)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1463)
+		p.SetState(1577)
 		p.OC_Expression()
 	}
 
@@ -15713,7 +16712,7 @@ func (s *OC_VariableContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Variable() (localctx IOC_VariableContext) {
 	localctx = NewOC_VariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, CypherParserRULE_oC_Variable)
+	p.EnterRule(localctx, 178, CypherParserRULE_oC_Variable)
 
 	defer func() {
 		p.ExitRule()
@@ -15733,7 +16732,7 @@ func (p *CypherParser) OC_Variable() (localctx IOC_VariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1465)
+		p.SetState(1579)
 		p.OC_SymbolicName()
 	}
 
@@ -15820,7 +16819,7 @@ func (s *OC_NumberLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_NumberLiteral() (localctx IOC_NumberLiteralContext) {
 	localctx = NewOC_NumberLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, CypherParserRULE_oC_NumberLiteral)
+	p.EnterRule(localctx, 180, CypherParserRULE_oC_NumberLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -15838,21 +16837,21 @@ func (p *CypherParser) OC_NumberLiteral() (localctx IOC_NumberLiteralContext) {
 		}
 	}()
 
-	p.SetState(1469)
+	p.SetState(1583)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserExponentDecimalReal, CypherParserRegularDecimalReal:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1467)
+			p.SetState(1581)
 			p.OC_DoubleLiteral()
 		}
 
 	case CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1468)
+			p.SetState(1582)
 			p.OC_IntegerLiteral()
 		}
 
@@ -15977,7 +16976,7 @@ func (s *OC_MapLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_MapLiteral() (localctx IOC_MapLiteralContext) {
 	localctx = NewOC_MapLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, CypherParserRULE_oC_MapLiteral)
+	p.EnterRule(localctx, 182, CypherParserRULE_oC_MapLiteral)
 	var _la int
 
 	defer func() {
@@ -15998,145 +16997,145 @@ func (p *CypherParser) OC_MapLiteral() (localctx IOC_MapLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1471)
-		p.Match(CypherParserT__23)
+		p.SetState(1585)
+		p.Match(CypherParserT__18)
 	}
-	p.SetState(1473)
+	p.SetState(1587)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1472)
+			p.SetState(1586)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1508)
+	p.SetState(1622)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46))|(1<<(CypherParserDESCENDING-46))|(1<<(CypherParserDESC-46))|(1<<(CypherParserWHERE-46))|(1<<(CypherParserOR-46))|(1<<(CypherParserXOR-46))|(1<<(CypherParserAND-46))|(1<<(CypherParserNOT-46))|(1<<(CypherParserIN-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserCOUNT-78))|(1<<(CypherParserANY-78))|(1<<(CypherParserNONE-78))|(1<<(CypherParserSINGLE-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserHexLetter-78))|(1<<(CypherParserCONSTRAINT-78)))) != 0) || (((_la-110)&-(0x1f+1)) == 0 && ((1<<uint((_la-110)))&((1<<(CypherParserDO-110))|(1<<(CypherParserFOR-110))|(1<<(CypherParserREQUIRE-110))|(1<<(CypherParserUNIQUE-110))|(1<<(CypherParserMANDATORY-110))|(1<<(CypherParserSCALAR-110))|(1<<(CypherParserOF-110))|(1<<(CypherParserADD-110))|(1<<(CypherParserDROP-110))|(1<<(CypherParserFILTER-110))|(1<<(CypherParserEXTRACT-110))|(1<<(CypherParserUnescapedSymbolicName-110))|(1<<(CypherParserEscapedSymbolicName-110)))) != 0) {
+	if (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserUNION-47))|(1<<(CypherParserALL-47))|(1<<(CypherParserOPTIONAL-47))|(1<<(CypherParserMATCH-47))|(1<<(CypherParserUNWIND-47))|(1<<(CypherParserAS-47))|(1<<(CypherParserMERGE-47))|(1<<(CypherParserON-47))|(1<<(CypherParserCREATE-47))|(1<<(CypherParserSET-47))|(1<<(CypherParserDETACH-47))|(1<<(CypherParserDELETE-47))|(1<<(CypherParserREMOVE-47))|(1<<(CypherParserWITH-47))|(1<<(CypherParserRETURN-47))|(1<<(CypherParserDISTINCT-47))|(1<<(CypherParserORDER-47))|(1<<(CypherParserBY-47))|(1<<(CypherParserL_SKIP-47))|(1<<(CypherParserLIMIT-47))|(1<<(CypherParserASCENDING-47))|(1<<(CypherParserASC-47))|(1<<(CypherParserDESCENDING-47))|(1<<(CypherParserDESC-47))|(1<<(CypherParserWHERE-47))|(1<<(CypherParserSHORTESTPATH-47))|(1<<(CypherParserALLSHORTESTPATHS-47))|(1<<(CypherParserOR-47))|(1<<(CypherParserXOR-47))|(1<<(CypherParserAND-47)))) != 0) || (((_la-79)&-(0x1f+1)) == 0 && ((1<<uint((_la-79)))&((1<<(CypherParserNOT-79))|(1<<(CypherParserIN-79))|(1<<(CypherParserSTARTS-79))|(1<<(CypherParserENDS-79))|(1<<(CypherParserCONTAINS-79))|(1<<(CypherParserIS-79))|(1<<(CypherParserNULL-79))|(1<<(CypherParserREDUCE-79))|(1<<(CypherParserCOUNT-79))|(1<<(CypherParserANY-79))|(1<<(CypherParserNONE-79))|(1<<(CypherParserSINGLE-79))|(1<<(CypherParserTRUE-79))|(1<<(CypherParserFALSE-79))|(1<<(CypherParserEXISTS-79))|(1<<(CypherParserCASE-79))|(1<<(CypherParserELSE-79))|(1<<(CypherParserEND-79))|(1<<(CypherParserWHEN-79))|(1<<(CypherParserTHEN-79))|(1<<(CypherParserHexLetter-79)))) != 0) || (((_la-113)&-(0x1f+1)) == 0 && ((1<<uint((_la-113)))&((1<<(CypherParserCONSTRAINT-113))|(1<<(CypherParserDO-113))|(1<<(CypherParserFOR-113))|(1<<(CypherParserREQUIRE-113))|(1<<(CypherParserUNIQUE-113))|(1<<(CypherParserMANDATORY-113))|(1<<(CypherParserSCALAR-113))|(1<<(CypherParserOF-113))|(1<<(CypherParserADD-113))|(1<<(CypherParserDROP-113))|(1<<(CypherParserFILTER-113))|(1<<(CypherParserEXTRACT-113))|(1<<(CypherParserUnescapedSymbolicName-113))|(1<<(CypherParserEscapedSymbolicName-113)))) != 0) {
 		{
-			p.SetState(1475)
+			p.SetState(1589)
 			p.OC_PropertyKeyName()
 		}
-		p.SetState(1477)
+		p.SetState(1591)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1476)
+				p.SetState(1590)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1479)
+			p.SetState(1593)
 			p.Match(CypherParserT__9)
 		}
-		p.SetState(1481)
+		p.SetState(1595)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1480)
+				p.SetState(1594)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1483)
+			p.SetState(1597)
 			p.OC_Expression()
 		}
-		p.SetState(1485)
+		p.SetState(1599)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1484)
+				p.SetState(1598)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1505)
+		p.SetState(1619)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1487)
+				p.SetState(1601)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1489)
+			p.SetState(1603)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1488)
+					p.SetState(1602)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1491)
+				p.SetState(1605)
 				p.OC_PropertyKeyName()
 			}
-			p.SetState(1493)
+			p.SetState(1607)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1492)
+					p.SetState(1606)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1495)
+				p.SetState(1609)
 				p.Match(CypherParserT__9)
 			}
-			p.SetState(1497)
+			p.SetState(1611)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1496)
+					p.SetState(1610)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1499)
+				p.SetState(1613)
 				p.OC_Expression()
 			}
-			p.SetState(1501)
+			p.SetState(1615)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1500)
+					p.SetState(1614)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1507)
+			p.SetState(1621)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1510)
-		p.Match(CypherParserT__24)
+		p.SetState(1624)
+		p.Match(CypherParserT__19)
 	}
 
 	return localctx
@@ -16216,7 +17215,7 @@ func (s *OC_ParameterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Parameter() (localctx IOC_ParameterContext) {
 	localctx = NewOC_ParameterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, CypherParserRULE_oC_Parameter)
+	p.EnterRule(localctx, 184, CypherParserRULE_oC_Parameter)
 
 	defer func() {
 		p.ExitRule()
@@ -16236,22 +17235,22 @@ func (p *CypherParser) OC_Parameter() (localctx IOC_ParameterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1512)
-		p.Match(CypherParserT__25)
+		p.SetState(1626)
+		p.Match(CypherParserT__26)
 	}
-	p.SetState(1515)
+	p.SetState(1629)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserREDUCE, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		{
-			p.SetState(1513)
+			p.SetState(1627)
 			p.OC_SymbolicName()
 		}
 
 	case CypherParserDecimalInteger:
 		{
-			p.SetState(1514)
+			p.SetState(1628)
 			p.Match(CypherParserDecimalInteger)
 		}
 
@@ -16363,7 +17362,7 @@ func (s *OC_PropertyExpressionContext) ExitRule(listener antlr.ParseTreeListener
 
 func (p *CypherParser) OC_PropertyExpression() (localctx IOC_PropertyExpressionContext) {
 	localctx = NewOC_PropertyExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, CypherParserRULE_oC_PropertyExpression)
+	p.EnterRule(localctx, 186, CypherParserRULE_oC_PropertyExpression)
 	var _la int
 
 	defer func() {
@@ -16386,28 +17385,28 @@ func (p *CypherParser) OC_PropertyExpression() (localctx IOC_PropertyExpressionC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1517)
+		p.SetState(1631)
 		p.OC_Atom()
 	}
-	p.SetState(1522)
+	p.SetState(1636)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(1519)
+			p.SetState(1633)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1518)
+					p.SetState(1632)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1521)
+				p.SetState(1635)
 				p.OC_PropertyLookup()
 			}
 
@@ -16415,9 +17414,9 @@ func (p *CypherParser) OC_PropertyExpression() (localctx IOC_PropertyExpressionC
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(1524)
+		p.SetState(1638)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 283, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 306, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -16493,7 +17492,7 @@ func (s *OC_PropertyKeyNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_PropertyKeyName() (localctx IOC_PropertyKeyNameContext) {
 	localctx = NewOC_PropertyKeyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, CypherParserRULE_oC_PropertyKeyName)
+	p.EnterRule(localctx, 188, CypherParserRULE_oC_PropertyKeyName)
 
 	defer func() {
 		p.ExitRule()
@@ -16513,7 +17512,7 @@ func (p *CypherParser) OC_PropertyKeyName() (localctx IOC_PropertyKeyNameContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1526)
+		p.SetState(1640)
 		p.OC_SchemaName()
 	}
 
@@ -16592,7 +17591,7 @@ func (s *OC_IntegerLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_IntegerLiteral() (localctx IOC_IntegerLiteralContext) {
 	localctx = NewOC_IntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, CypherParserRULE_oC_IntegerLiteral)
+	p.EnterRule(localctx, 190, CypherParserRULE_oC_IntegerLiteral)
 	var _la int
 
 	defer func() {
@@ -16613,10 +17612,10 @@ func (p *CypherParser) OC_IntegerLiteral() (localctx IOC_IntegerLiteralContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1528)
+		p.SetState(1642)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0) {
+		if !(((_la-101)&-(0x1f+1)) == 0 && ((1<<uint((_la-101)))&((1<<(CypherParserHexInteger-101))|(1<<(CypherParserDecimalInteger-101))|(1<<(CypherParserOctalInteger-101)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -16695,7 +17694,7 @@ func (s *OC_DoubleLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_DoubleLiteral() (localctx IOC_DoubleLiteralContext) {
 	localctx = NewOC_DoubleLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, CypherParserRULE_oC_DoubleLiteral)
+	p.EnterRule(localctx, 192, CypherParserRULE_oC_DoubleLiteral)
 	var _la int
 
 	defer func() {
@@ -16716,7 +17715,7 @@ func (p *CypherParser) OC_DoubleLiteral() (localctx IOC_DoubleLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1530)
+		p.SetState(1644)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == CypherParserExponentDecimalReal || _la == CypherParserRegularDecimalReal) {
@@ -16810,7 +17809,7 @@ func (s *OC_SchemaNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_SchemaName() (localctx IOC_SchemaNameContext) {
 	localctx = NewOC_SchemaNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, CypherParserRULE_oC_SchemaName)
+	p.EnterRule(localctx, 194, CypherParserRULE_oC_SchemaName)
 
 	defer func() {
 		p.ExitRule()
@@ -16828,21 +17827,21 @@ func (p *CypherParser) OC_SchemaName() (localctx IOC_SchemaNameContext) {
 		}
 	}()
 
-	p.SetState(1534)
+	p.SetState(1648)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserREDUCE, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1532)
+			p.SetState(1646)
 			p.OC_SymbolicName()
 		}
 
 	case CypherParserUNION, CypherParserALL, CypherParserOPTIONAL, CypherParserMATCH, CypherParserUNWIND, CypherParserAS, CypherParserMERGE, CypherParserON, CypherParserCREATE, CypherParserSET, CypherParserDETACH, CypherParserDELETE, CypherParserREMOVE, CypherParserWITH, CypherParserRETURN, CypherParserDISTINCT, CypherParserORDER, CypherParserBY, CypherParserL_SKIP, CypherParserLIMIT, CypherParserASCENDING, CypherParserASC, CypherParserDESCENDING, CypherParserDESC, CypherParserWHERE, CypherParserOR, CypherParserXOR, CypherParserAND, CypherParserNOT, CypherParserIN, CypherParserSTARTS, CypherParserENDS, CypherParserCONTAINS, CypherParserIS, CypherParserNULL, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserELSE, CypherParserEND, CypherParserWHEN, CypherParserTHEN, CypherParserCONSTRAINT, CypherParserDO, CypherParserFOR, CypherParserREQUIRE, CypherParserUNIQUE, CypherParserMANDATORY, CypherParserSCALAR, CypherParserOF, CypherParserADD, CypherParserDROP:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1533)
+			p.SetState(1647)
 			p.OC_ReservedWord()
 		}
 
@@ -17125,7 +18124,7 @@ func (s *OC_ReservedWordContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_ReservedWord() (localctx IOC_ReservedWordContext) {
 	localctx = NewOC_ReservedWordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, CypherParserRULE_oC_ReservedWord)
+	p.EnterRule(localctx, 196, CypherParserRULE_oC_ReservedWord)
 	var _la int
 
 	defer func() {
@@ -17146,10 +18145,10 @@ func (p *CypherParser) OC_ReservedWord() (localctx IOC_ReservedWordContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1536)
+		p.SetState(1650)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46))|(1<<(CypherParserDESCENDING-46))|(1<<(CypherParserDESC-46))|(1<<(CypherParserWHERE-46))|(1<<(CypherParserOR-46))|(1<<(CypherParserXOR-46))|(1<<(CypherParserAND-46))|(1<<(CypherParserNOT-46))|(1<<(CypherParserIN-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserCONSTRAINT-78)))) != 0) || (((_la-110)&-(0x1f+1)) == 0 && ((1<<uint((_la-110)))&((1<<(CypherParserDO-110))|(1<<(CypherParserFOR-110))|(1<<(CypherParserREQUIRE-110))|(1<<(CypherParserUNIQUE-110))|(1<<(CypherParserMANDATORY-110))|(1<<(CypherParserSCALAR-110))|(1<<(CypherParserOF-110))|(1<<(CypherParserADD-110))|(1<<(CypherParserDROP-110)))) != 0)) {
+		if !((((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserUNION-47))|(1<<(CypherParserALL-47))|(1<<(CypherParserOPTIONAL-47))|(1<<(CypherParserMATCH-47))|(1<<(CypherParserUNWIND-47))|(1<<(CypherParserAS-47))|(1<<(CypherParserMERGE-47))|(1<<(CypherParserON-47))|(1<<(CypherParserCREATE-47))|(1<<(CypherParserSET-47))|(1<<(CypherParserDETACH-47))|(1<<(CypherParserDELETE-47))|(1<<(CypherParserREMOVE-47))|(1<<(CypherParserWITH-47))|(1<<(CypherParserRETURN-47))|(1<<(CypherParserDISTINCT-47))|(1<<(CypherParserORDER-47))|(1<<(CypherParserBY-47))|(1<<(CypherParserL_SKIP-47))|(1<<(CypherParserLIMIT-47))|(1<<(CypherParserASCENDING-47))|(1<<(CypherParserASC-47))|(1<<(CypherParserDESCENDING-47))|(1<<(CypherParserDESC-47))|(1<<(CypherParserWHERE-47))|(1<<(CypherParserOR-47))|(1<<(CypherParserXOR-47))|(1<<(CypherParserAND-47)))) != 0) || (((_la-79)&-(0x1f+1)) == 0 && ((1<<uint((_la-79)))&((1<<(CypherParserNOT-79))|(1<<(CypherParserIN-79))|(1<<(CypherParserSTARTS-79))|(1<<(CypherParserENDS-79))|(1<<(CypherParserCONTAINS-79))|(1<<(CypherParserIS-79))|(1<<(CypherParserNULL-79))|(1<<(CypherParserTRUE-79))|(1<<(CypherParserFALSE-79))|(1<<(CypherParserEXISTS-79))|(1<<(CypherParserCASE-79))|(1<<(CypherParserELSE-79))|(1<<(CypherParserEND-79))|(1<<(CypherParserWHEN-79))|(1<<(CypherParserTHEN-79)))) != 0) || (((_la-113)&-(0x1f+1)) == 0 && ((1<<uint((_la-113)))&((1<<(CypherParserCONSTRAINT-113))|(1<<(CypherParserDO-113))|(1<<(CypherParserFOR-113))|(1<<(CypherParserREQUIRE-113))|(1<<(CypherParserUNIQUE-113))|(1<<(CypherParserMANDATORY-113))|(1<<(CypherParserSCALAR-113))|(1<<(CypherParserOF-113))|(1<<(CypherParserADD-113))|(1<<(CypherParserDROP-113)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17234,6 +18233,18 @@ func (s *OC_SymbolicNameContext) SINGLE() antlr.TerminalNode {
 	return s.GetToken(CypherParserSINGLE, 0)
 }
 
+func (s *OC_SymbolicNameContext) REDUCE() antlr.TerminalNode {
+	return s.GetToken(CypherParserREDUCE, 0)
+}
+
+func (s *OC_SymbolicNameContext) SHORTESTPATH() antlr.TerminalNode {
+	return s.GetToken(CypherParserSHORTESTPATH, 0)
+}
+
+func (s *OC_SymbolicNameContext) ALLSHORTESTPATHS() antlr.TerminalNode {
+	return s.GetToken(CypherParserALLSHORTESTPATHS, 0)
+}
+
 func (s *OC_SymbolicNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -17256,7 +18267,7 @@ func (s *OC_SymbolicNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_SymbolicName() (localctx IOC_SymbolicNameContext) {
 	localctx = NewOC_SymbolicNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, CypherParserRULE_oC_SymbolicName)
+	p.EnterRule(localctx, 198, CypherParserRULE_oC_SymbolicName)
 	var _la int
 
 	defer func() {
@@ -17277,10 +18288,10 @@ func (p *CypherParser) OC_SymbolicName() (localctx IOC_SymbolicNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1538)
+		p.SetState(1652)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0)) {
+		if !((((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserREDUCE-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(CypherParserFILTER-123))|(1<<(CypherParserEXTRACT-123))|(1<<(CypherParserUnescapedSymbolicName-123))|(1<<(CypherParserEscapedSymbolicName-123)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17350,7 +18361,7 @@ func (s *OC_LeftArrowHeadContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_LeftArrowHead() (localctx IOC_LeftArrowHeadContext) {
 	localctx = NewOC_LeftArrowHeadContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, CypherParserRULE_oC_LeftArrowHead)
+	p.EnterRule(localctx, 200, CypherParserRULE_oC_LeftArrowHead)
 	var _la int
 
 	defer func() {
@@ -17371,10 +18382,10 @@ func (p *CypherParser) OC_LeftArrowHead() (localctx IOC_LeftArrowHeadContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1540)
+		p.SetState(1654)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__18)|(1<<CypherParserT__26)|(1<<CypherParserT__27)|(1<<CypherParserT__28)|(1<<CypherParserT__29))) != 0) {
+		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__22)|(1<<CypherParserT__27)|(1<<CypherParserT__28)|(1<<CypherParserT__29)|(1<<CypherParserT__30))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17444,7 +18455,7 @@ func (s *OC_RightArrowHeadContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_RightArrowHead() (localctx IOC_RightArrowHeadContext) {
 	localctx = NewOC_RightArrowHeadContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, CypherParserRULE_oC_RightArrowHead)
+	p.EnterRule(localctx, 202, CypherParserRULE_oC_RightArrowHead)
 	var _la int
 
 	defer func() {
@@ -17465,10 +18476,10 @@ func (p *CypherParser) OC_RightArrowHead() (localctx IOC_RightArrowHeadContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1542)
+		p.SetState(1656)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-20)&-(0x1f+1)) == 0 && ((1<<uint((_la-20)))&((1<<(CypherParserT__19-20))|(1<<(CypherParserT__30-20))|(1<<(CypherParserT__31-20))|(1<<(CypherParserT__32-20))|(1<<(CypherParserT__33-20)))) != 0) {
+		if !(((_la-24)&-(0x1f+1)) == 0 && ((1<<uint((_la-24)))&((1<<(CypherParserT__23-24))|(1<<(CypherParserT__31-24))|(1<<(CypherParserT__32-24))|(1<<(CypherParserT__33-24))|(1<<(CypherParserT__34-24)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17538,7 +18549,7 @@ func (s *OC_DashContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CypherParser) OC_Dash() (localctx IOC_DashContext) {
 	localctx = NewOC_DashContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, CypherParserRULE_oC_Dash)
+	p.EnterRule(localctx, 204, CypherParserRULE_oC_Dash)
 	var _la int
 
 	defer func() {
@@ -17559,10 +18570,10 @@ func (p *CypherParser) OC_Dash() (localctx IOC_DashContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1544)
+		p.SetState(1658)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-14)&-(0x1f+1)) == 0 && ((1<<uint((_la-14)))&((1<<(CypherParserT__13-14))|(1<<(CypherParserT__34-14))|(1<<(CypherParserT__35-14))|(1<<(CypherParserT__36-14))|(1<<(CypherParserT__37-14))|(1<<(CypherParserT__38-14))|(1<<(CypherParserT__39-14))|(1<<(CypherParserT__40-14))|(1<<(CypherParserT__41-14))|(1<<(CypherParserT__42-14))|(1<<(CypherParserT__43-14))|(1<<(CypherParserT__44-14)))) != 0) {
+		if !(_la == CypherParserT__13 || (((_la-36)&-(0x1f+1)) == 0 && ((1<<uint((_la-36)))&((1<<(CypherParserT__35-36))|(1<<(CypherParserT__36-36))|(1<<(CypherParserT__37-36))|(1<<(CypherParserT__38-36))|(1<<(CypherParserT__39-36))|(1<<(CypherParserT__40-36))|(1<<(CypherParserT__41-36))|(1<<(CypherParserT__42-36))|(1<<(CypherParserT__43-36))|(1<<(CypherParserT__44-36))|(1<<(CypherParserT__45-36)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -0,0 +1,129 @@
+package opencypher
+
+import (
+	"fmt"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func init() {
+	globalFuncs["nodes"] = Function{
+		Name:      "nodes",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: nodesFunc,
+	}
+	globalFuncs["relationships"] = Function{
+		Name:      "relationships",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: relationshipsFunc,
+	}
+	globalFuncs["length"] = Function{
+		Name:      "length",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: lengthFunc,
+	}
+	globalFuncs["startNode"] = Function{
+		Name:      "startNode",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: startNodeFunc,
+	}
+	globalFuncs["endNode"] = Function{
+		Name:      "endNode",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: endNodeFunc,
+	}
+}
+
+// valueAsPath returns the path, or a path containing a single node
+func valueAsPath(v Value) (*lpg.Path, error) {
+	switch val := v.Get().(type) {
+	case *lpg.Path:
+		return val, nil
+	case *lpg.Node:
+		return lpg.PathFromNode(val), nil
+	case *lpg.Edge:
+		return lpg.NewPathFromElements(lpg.PathElement{Edge: val}), nil
+	}
+	return nil, fmt.Errorf("Not a path: %v", v.Get())
+}
+
+// valueAsEdge returns the edge, or the edge of a single-edge path
+func valueAsEdge(v Value) (*lpg.Edge, error) {
+	switch val := v.Get().(type) {
+	case *lpg.Edge:
+		return val, nil
+	case *lpg.Path:
+		if val.NumEdges() == 1 {
+			return val.GetEdge(0), nil
+		}
+	}
+	return nil, fmt.Errorf("Not a relationship: %v", v.Get())
+}
+
+func nodesFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	path, err := valueAsPath(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In nodes: %w", err)
+	}
+	out := make([]Value, 0, path.NumNodes())
+	for i := 0; i < path.NumNodes(); i++ {
+		out = append(out, RValue{Value: path.GetNode(i)})
+	}
+	return RValue{Value: out}, nil
+}
+
+func relationshipsFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	path, err := valueAsPath(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In relationships: %w", err)
+	}
+	out := make([]Value, 0, path.NumEdges())
+	for i := 0; i < path.NumEdges(); i++ {
+		out = append(out, RValue{Value: path.GetEdge(i)})
+	}
+	return RValue{Value: out}, nil
+}
+
+func lengthFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	path, err := valueAsPath(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In length: %w", err)
+	}
+	return RValue{Value: path.NumEdges()}, nil
+}
+
+func startNodeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	edge, err := valueAsEdge(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In startNode: %w", err)
+	}
+	return RValue{Value: edge.GetFrom()}, nil
+}
+
+func endNodeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	edge, err := valueAsEdge(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In endNode: %w", err)
+	}
+	return RValue{Value: edge.GetTo()}, nil
+}
@@ -0,0 +1,60 @@
+package opencypher
+
+import (
+	"testing"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func TestNamedPaths(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `MATCH p = (a {name:'Andy'})-[*]->(b) RETURN p AS p, length(p) AS len, [n IN nodes(p) | n.name] AS names ORDER BY len DESC`, g)
+	if len(rs.Rows) != 3 {
+		t.Fatalf("Expecting 3 rows, got %v", rs)
+	}
+	row := rs.Rows[0]
+	if _, ok := row["p"].Get().(*lpg.Path); !ok || row["len"].Get() != 2 {
+		t.Errorf("Wrong row: %v", row)
+	}
+	names := row["names"].Get().([]Value)
+	if len(names) != 3 || names[0].Get() != "Andy" || names[1].Get() != "Peter" || names[2].Get() != "Emil" {
+		t.Errorf("Wrong path: %v", names)
+	}
+
+	// Path follows the pattern direction
+	rs = runTestMatch(t, `MATCH p = (a {name:'Emil'})<--(b) RETURN [n IN nodes(p) | n.name] AS names, [r IN relationships(p) | startNode(r).name] AS froms`, g)
+	if len(rs.Rows) != 1 {
+		t.Fatalf("Expecting 1 row, got %v", rs)
+	}
+	names = rs.Rows[0]["names"].Get().([]Value)
+	if len(names) != 2 || names[0].Get() != "Emil" || names[1].Get() != "Peter" {
+		t.Errorf("Wrong path: %v", names)
+	}
+	froms := rs.Rows[0]["froms"].Get().([]Value)
+	if len(froms) != 1 || froms[0].Get() != "Peter" {
+		t.Errorf("Wrong relationships: %v", froms)
+	}
+	rs = runTestMatch(t, `MATCH p = (a {name:'Emil'})<-[*2]-(b) RETURN [n IN nodes(p) | n.name] AS names`, g)
+	if len(rs.Rows) != 1 {
+		t.Fatalf("Expecting 1 row, got %v", rs)
+	}
+	names = rs.Rows[0]["names"].Get().([]Value)
+	if len(names) != 3 || names[0].Get() != "Emil" || names[2].Get() != "Andy" {
+		t.Errorf("Wrong path: %v", names)
+	}
+
+	rs = runTestMatch(t, `MATCH p = (a {name:'Timothy'}) RETURN length(p) AS len, size(nodes(p)) AS n`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["len"].Get() != 0 || rs.Rows[0]["n"].Get() != 1 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH (a {name:'Andy'})-[r]->(b {name:'Peter'}) RETURN startNode(r).name AS s, endNode(r).name AS e`, g)
+	if rs.Rows[0]["s"].Get() != "Andy" || rs.Rows[0]["e"].Get() != "Peter" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person) OPTIONAL MATCH p = (a)-->(b {name:'Emil'}) RETURN a.name AS name, length(p) AS len ORDER BY name`, g)
+	if len(rs.Rows) != 4 || rs.Rows[0]["len"].Get() != nil || rs.Rows[2]["len"].Get() != 1 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}
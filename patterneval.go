@@ -1,6 +1,8 @@
 package opencypher
 
 import (
+	"fmt"
+
 	"github.com/cloudprivacylabs/lpg/v2"
 )
 
@@ -60,6 +62,9 @@ func (match Match) GetResults(ctx *EvalContext) (ResultSet, error) {
 		if err != nil {
 			return *NewResultSet(), err
 		}
+		if match.Pattern.Parts[i].variable != nil {
+			namePathItems(p, i)
+		}
 		patterns = append(patterns, p)
 	}
 
@@ -80,19 +85,37 @@ func (match Match) GetResults(ctx *EvalContext) (ResultSet, error) {
 
 	nextPattern = func(prevContext *EvalContext, pat []lpg.Pattern, index int) error {
 		newContext := prevContext.SubContext()
-		symbols, err := BuildPatternSymbols(newContext, pat[0])
-		if err != nil {
-			return err
+		if hasNullSymbol(newContext, pat[0]) {
+			// A pattern with a variable bound to null does not match
+			return nil
 		}
-		results := matchResultAccumulator{
-			evalCtx: newContext,
-			result:  NewResultSet(),
-		}
-		err = pat[0].Run(newContext.graph, symbols, &results)
-		if err != nil {
-			return err
+		part := match.Pattern.Parts[index]
+		var rows []map[string]Value
+		if part.shortest != nil {
+			var err error
+			rows, err = part.shortest.match(newContext, part.variable)
+			if err != nil {
+				return err
+			}
+		} else {
+			symbols, err := BuildPatternSymbols(newContext, pat[0])
+			if err != nil {
+				return err
+			}
+			results := matchResultAccumulator{
+				evalCtx: newContext,
+				result:  NewResultSet(),
+			}
+			err = pat[0].Run(newContext.graph, symbols, &results)
+			if err != nil {
+				return err
+			}
+			rows = results.result.Rows
 		}
-		for _, row := range results.result.Rows {
+		for _, row := range rows {
+			if part.variable != nil && part.shortest == nil {
+				row[string(*part.variable)] = RValue{Value: getMatchedPath(pat[0], row)}
+			}
 			for k, v := range row {
 				newContext.SetVar(k, v)
 			}
@@ -120,9 +143,84 @@ func (match Match) GetResults(ctx *EvalContext) (ResultSet, error) {
 	if err := nextPattern(ctx, patterns, 0); err != nil {
 		return ResultSet{}, err
 	}
+	if match.Optional && len(results.Rows) == 0 {
+		// Bind the new variables of the pattern to null
+		row := make(map[string]Value)
+		for i, p := range patterns {
+			for symbol := range p.GetSymbolNames().M {
+				if !IsNamedVar(symbol) {
+					continue
+				}
+				if _, err := ctx.GetVar(symbol); err != nil {
+					row[symbol] = RValue{}
+				}
+			}
+			if v := match.Pattern.Parts[i].variable; v != nil {
+				row[string(*v)] = RValue{}
+			}
+		}
+		results.Rows = append(results.Rows, row)
+	}
 	return *results, nil
 }
 
+// namePathItems assigns names to the unnamed items of the pattern of
+// a named path, so the matched path can be built from the captured
+// symbols. The names start with a digit, so they cannot clash with
+// variable names.
+func namePathItems(pattern lpg.Pattern, partIndex int) {
+	for i := range pattern {
+		if len(pattern[i].Name) == 0 {
+			pattern[i].Name = fmt.Sprintf("%d.%d", partIndex, i)
+		}
+	}
+}
+
+// getMatchedPath builds the path matched by a named pattern from the
+// captured symbols, and removes the symbols assigned by
+// namePathItems from the row.
+func getMatchedPath(pattern lpg.Pattern, row map[string]Value) *lpg.Path {
+	current := row[pattern[0].Name].Get().(*lpg.Node)
+	path := lpg.PathFromNode(current)
+	for i := 1; i+1 < len(pattern); i += 2 {
+		segment, _ := row[pattern[i].Name].Get().(*lpg.Path)
+		if segment != nil && segment.NumEdges() > 0 {
+			edges := make([]*lpg.Edge, 0, segment.NumEdges())
+			for j := 0; j < segment.NumEdges(); j++ {
+				edges = append(edges, segment.GetEdge(j))
+			}
+			if segment.First() != current {
+				for l, r := 0, len(edges)-1; l < r; l, r = l+1, r-1 {
+					edges[l], edges[r] = edges[r], edges[l]
+				}
+			}
+			for _, edge := range edges {
+				pe := lpg.PathElement{Edge: edge, Reverse: edge.GetFrom() != current}
+				path.Append(pe)
+				current = pe.GetTargetNode()
+			}
+		}
+	}
+	for _, item := range pattern {
+		if !IsNamedVar(item.Name) {
+			delete(row, item.Name)
+		}
+	}
+	return path
+}
+
+// hasNullSymbol returns true if a variable of the pattern is bound
+// to null in the context
+func hasNullSymbol(ctx *EvalContext, pattern lpg.Pattern) bool {
+	for symbol := range pattern.GetSymbolNames().M {
+		value, err := ctx.GetVar(symbol)
+		if err == nil && value.Get() == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // BuildPatternSymbols copies all the symbols referenced in the
 // pattern from the context, and puts them in a map.
 func BuildPatternSymbols(ctx *EvalContext, pattern lpg.Pattern) (map[string]*lpg.PatternSymbol, error) {
@@ -322,3 +420,79 @@ func (p *Properties) getPropertiesMap(ctx *EvalContext) (map[string]interface{},
 	}
 	return properties, nil
 }
+
+// match finds the matches of the pattern part. Variables that are
+// already defined in ctx constrain the match. If the pattern part is
+// a named path, the matched paths are added to the results.
+func (part PatternPart) match(ctx *EvalContext) (*matchResultAccumulator, error) {
+	pattern, err := part.getPattern(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if part.variable != nil {
+		namePathItems(pattern, 0)
+	}
+	matchCtx := ctx.SubContext()
+	acc := &matchResultAccumulator{
+		evalCtx: matchCtx,
+		result:  NewResultSet(),
+	}
+	if hasNullSymbol(matchCtx, pattern) {
+		return acc, nil
+	}
+	symbols, err := BuildPatternSymbols(matchCtx, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if err := pattern.Run(matchCtx.graph, symbols, acc); err != nil {
+		return nil, err
+	}
+	if acc.err != nil {
+		return nil, acc.err
+	}
+	if part.variable != nil {
+		for _, row := range acc.result.Rows {
+			row[string(*part.variable)] = RValue{Value: getMatchedPath(pattern, row)}
+		}
+	}
+	return acc, nil
+}
+
+// Evaluate a pattern predicate. Returns true if the pattern has a
+// match.
+func (rel relationshipsPattern) Evaluate(ctx *EvalContext) (Value, error) {
+	acc, err := PatternPart{start: rel.start, path: rel.chain}.match(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return RValue{Value: len(acc.result.Rows) > 0}, nil
+}
+
+// Evaluate a pattern comprehension. The expression is evaluated for
+// each match of the pattern that satisfies the WHERE predicate.
+func (p patternComprehension) Evaluate(ctx *EvalContext) (Value, error) {
+	acc, err := PatternPart{variable: p.variable, start: p.rel.start, path: p.rel.chain}.match(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]Value, 0, len(acc.result.Rows))
+	for _, row := range acc.result.Rows {
+		rowCtx := ctx.SubContext()
+		rowCtx.SetVars(row)
+		if p.where != nil {
+			v, err := p.where.Evaluate(rowCtx)
+			if err != nil {
+				return nil, err
+			}
+			if b, _ := ValueAsBool(v); !b {
+				continue
+			}
+		}
+		v, err := p.expr.Evaluate(rowCtx)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, RValue{Value: v.Get()})
+	}
+	return RValue{Value: ret}, nil
+}
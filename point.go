@@ -0,0 +1,164 @@
+package opencypher
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Spatial reference identifiers of the supported coordinate
+// reference systems
+const (
+	SRIDCartesian   = 7203
+	SRIDCartesian3D = 9157
+	SRIDWGS84       = 4326
+	SRIDWGS843D     = 4979
+)
+
+// earthRadius is the radius of the earth in meters used for WGS-84
+// distances
+const earthRadius = 6378140.0
+
+// Point is a spatial point in a cartesian or WGS-84 coordinate
+// reference system. For WGS-84 points, X is the longitude, Y is the
+// latitude, and Z is the height. Z is not used for 2D points.
+type Point struct {
+	SRID int
+	X    float64
+	Y    float64
+	Z    float64
+}
+
+// Is3D returns if the point has a Z coordinate
+func (p Point) Is3D() bool {
+	return p.SRID == SRIDCartesian3D || p.SRID == SRIDWGS843D
+}
+
+// IsGeographic returns if the point is a WGS-84 point
+func (p Point) IsGeographic() bool {
+	return p.SRID == SRIDWGS84 || p.SRID == SRIDWGS843D
+}
+
+// CRS returns the name of the coordinate reference system of the
+// point
+func (p Point) CRS() string {
+	switch p.SRID {
+	case SRIDCartesian:
+		return "cartesian"
+	case SRIDCartesian3D:
+		return "cartesian-3d"
+	case SRIDWGS84:
+		return "wgs-84"
+	case SRIDWGS843D:
+		return "wgs-84-3d"
+	}
+	return ""
+}
+
+func (p Point) String() string {
+	f := func(x float64) string {
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	if p.Is3D() {
+		return fmt.Sprintf("point({x: %s, y: %s, z: %s, crs: '%s'})", f(p.X), f(p.Y), f(p.Z), p.CRS())
+	}
+	return fmt.Sprintf("point({x: %s, y: %s, crs: '%s'})", f(p.X), f(p.Y), p.CRS())
+}
+
+// Equal returns if the points are in the same coordinate reference
+// system and have the same coordinates
+func (p Point) Equal(q Point) bool {
+	return p.SRID == q.SRID && p.X == q.X && p.Y == q.Y && (!p.Is3D() || p.Z == q.Z)
+}
+
+// compare orders points by their coordinate reference systems, and
+// then by their coordinates
+func (p Point) compare(q Point) int {
+	if p.SRID != q.SRID {
+		return p.SRID - q.SRID
+	}
+	for _, c := range [][2]float64{{p.X, q.X}, {p.Y, q.Y}, {p.Z, q.Z}} {
+		if c[0] < c[1] {
+			return -1
+		}
+		if c[0] > c[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Distance returns the distance between two points. For WGS-84
+// points, the distance is in meters. The second return value is false
+// if the points are in different coordinate reference systems.
+func (p Point) Distance(q Point) (float64, bool) {
+	if p.SRID != q.SRID {
+		return 0, false
+	}
+	dz := 0.0
+	if p.Is3D() {
+		dz = p.Z - q.Z
+	}
+	if !p.IsGeographic() {
+		dx, dy := p.X-q.X, p.Y-q.Y
+		return math.Sqrt(dx*dx + dy*dy + dz*dz), true
+	}
+	// Haversine formula
+	lat1, lat2 := p.Y*math.Pi/180, q.Y*math.Pi/180
+	dlat := lat2 - lat1
+	dlon := (q.X - p.X) * math.Pi / 180
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	d := 2 * earthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return math.Sqrt(d*d + dz*dz), true
+}
+
+// WithinBBox returns if the point is within the bounding box given by
+// the lower left and upper right corners. For WGS-84 points, if the
+// lower left longitude is greater than the upper right longitude, the
+// box crosses the 180th meridian. The second return value is false if
+// the points are in different coordinate reference systems.
+func (p Point) WithinBBox(lowerLeft, upperRight Point) (bool, bool) {
+	if p.SRID != lowerLeft.SRID || p.SRID != upperRight.SRID {
+		return false, false
+	}
+	inX := p.X >= lowerLeft.X && p.X <= upperRight.X
+	if p.IsGeographic() && lowerLeft.X > upperRight.X {
+		inX = p.X >= lowerLeft.X || p.X <= upperRight.X
+	}
+	ret := inX && p.Y >= lowerLeft.Y && p.Y <= upperRight.Y
+	if p.Is3D() {
+		ret = ret && p.Z >= lowerLeft.Z && p.Z <= upperRight.Z
+	}
+	return ret, true
+}
+
+// component returns the named component of the point
+func (p Point) component(name string) (Value, error) {
+	switch name {
+	case "x":
+		return RValue{Value: p.X}, nil
+	case "y":
+		return RValue{Value: p.Y}, nil
+	case "z":
+		if p.Is3D() {
+			return RValue{Value: p.Z}, nil
+		}
+	case "longitude":
+		if p.IsGeographic() {
+			return RValue{Value: p.X}, nil
+		}
+	case "latitude":
+		if p.IsGeographic() {
+			return RValue{Value: p.Y}, nil
+		}
+	case "height":
+		if p.IsGeographic() && p.Is3D() {
+			return RValue{Value: p.Z}, nil
+		}
+	case "crs":
+		return RValue{Value: p.CRS()}, nil
+	case "srid":
+		return RValue{Value: p.SRID}, nil
+	}
+	return nil, ErrValueDoesNotHaveProperties{Value: p, Property: name}
+}
@@ -0,0 +1,191 @@
+package opencypher
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "point",
+			MinArgs:   1,
+			MaxArgs:   1,
+			ValueFunc: pointFunc,
+		},
+		Function{
+			Name:      "point.distance",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: pointDistanceFunc,
+		},
+		Function{
+			Name:      "point.withinBBox",
+			MinArgs:   3,
+			MaxArgs:   3,
+			ValueFunc: pointWithinBBoxFunc,
+		},
+	)
+}
+
+var crsSRIDs = map[string]int{
+	"cartesian":    SRIDCartesian,
+	"cartesian-3d": SRIDCartesian3D,
+	"wgs-84":       SRIDWGS84,
+	"wgs-84-3d":    SRIDWGS843D,
+}
+
+// pointFromMap builds a point from a map containing x, y, and
+// optionally z, or longitude, latitude, and optionally height. The
+// coordinate reference system can be given using crs or srid. The
+// second return value is false if any of the components is null.
+func pointFromMap(props map[string]Value) (Point, bool, error) {
+	for _, v := range props {
+		if v.Get() == nil {
+			return Point{}, false, nil
+		}
+	}
+	coord := func(key string) (float64, bool, error) {
+		v, ok := props[key]
+		if !ok {
+			return 0, false, nil
+		}
+		f, err := valueAsFloat(v)
+		if err != nil {
+			return 0, false, fmt.Errorf("%s: %w", key, err)
+		}
+		return f, true, nil
+	}
+	keys := []string{"x", "y", "z"}
+	_, hasLongitude := props["longitude"]
+	_, hasLatitude := props["latitude"]
+	geographic := hasLongitude || hasLatitude
+	if geographic {
+		keys = []string{"longitude", "latitude", "height"}
+	}
+	ret := Point{}
+	coords := []*float64{&ret.X, &ret.Y, &ret.Z}
+	has3D := false
+	for i, key := range keys {
+		f, ok, err := coord(key)
+		if err != nil {
+			return Point{}, false, err
+		}
+		if !ok && i < 2 {
+			return Point{}, false, fmt.Errorf("%s required", key)
+		}
+		*coords[i] = f
+		has3D = i == 2 && ok
+	}
+	if geographic {
+		if _, ok := props["x"]; ok {
+			return Point{}, false, fmt.Errorf("Both x and longitude given")
+		}
+		if ret.Y < -90 || ret.Y > 90 {
+			return Point{}, false, fmt.Errorf("Invalid latitude: %v", ret.Y)
+		}
+	}
+	switch {
+	case geographic && has3D:
+		ret.SRID = SRIDWGS843D
+	case geographic:
+		ret.SRID = SRIDWGS84
+	case has3D:
+		ret.SRID = SRIDCartesian3D
+	default:
+		ret.SRID = SRIDCartesian
+	}
+	srid := ret.SRID
+	if v, ok := props["crs"]; ok {
+		str, err := ValueAsString(v)
+		if err != nil {
+			return Point{}, false, fmt.Errorf("crs: %w", err)
+		}
+		if srid, ok = crsSRIDs[strings.ToLower(str)]; !ok {
+			return Point{}, false, fmt.Errorf("Unknown crs: %s", str)
+		}
+	}
+	if v, ok := props["srid"]; ok {
+		n, err := ValueAsInt(v)
+		if err != nil {
+			return Point{}, false, fmt.Errorf("srid: %w", err)
+		}
+		srid = n
+	}
+	// Cartesian coordinates can be used for WGS-84 points if the crs
+	// is given
+	if srid != ret.SRID && !(srid == SRIDWGS84 && ret.SRID == SRIDCartesian) && !(srid == SRIDWGS843D && ret.SRID == SRIDCartesian3D) {
+		return Point{}, false, fmt.Errorf("Coordinates do not match the coordinate reference system %d", srid)
+	}
+	ret.SRID = srid
+	return ret, true, nil
+}
+
+func pointFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	props, ok := args[0].Get().(map[string]Value)
+	if !ok {
+		return nil, fmt.Errorf("In point: Map required: %v", args[0].Get())
+	}
+	p, ok, err := pointFromMap(props)
+	if err != nil {
+		return nil, fmt.Errorf("In point: %w", err)
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: p}, nil
+}
+
+// pointArgs returns the point arguments. The second return value is
+// false if any argument is null.
+func pointArgs(fname string, args []Value) ([]Point, bool, error) {
+	ret := make([]Point, 0, len(args))
+	for _, arg := range args {
+		if arg.Get() == nil {
+			return nil, false, nil
+		}
+		p, ok := arg.Get().(Point)
+		if !ok {
+			return nil, false, fmt.Errorf("In %s: Point required: %v", fname, arg.Get())
+		}
+		ret = append(ret, p)
+	}
+	return ret, true, nil
+}
+
+// pointDistanceFunc returns the distance between two points, or
+// null if the points are in different coordinate reference systems
+func pointDistanceFunc(ctx *EvalContext, args []Value) (Value, error) {
+	points, ok, err := pointArgs("point.distance", args)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	d, ok := points[0].Distance(points[1])
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: d}, nil
+}
+
+// pointWithinBBoxFunc returns if a point is within a bounding box, or
+// null if the points are in different coordinate reference systems
+func pointWithinBBoxFunc(ctx *EvalContext, args []Value) (Value, error) {
+	points, ok, err := pointArgs("point.withinBBox", args)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return RValue{}, nil
+	}
+	in, ok := points[0].WithinBBox(points[1], points[2])
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: in}, nil
+}
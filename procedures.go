@@ -0,0 +1,236 @@
+package opencypher
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Procedure describes a procedure that can be called using CALL
+type Procedure struct {
+	Name    string
+	MinArgs int
+	MaxArgs int
+	// Args are the names of the procedure arguments. If the procedure
+	// is called without an argument list, the arguments are read from
+	// the query parameters with these names.
+	Args []string
+	// Outputs are the names of the columns produced by the procedure
+	Outputs []string
+	// Func calls emit for each row produced by the procedure. If emit
+	// returns an error, Func should stop and return that error.
+	Func func(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error
+}
+
+type ErrInvalidProcedureCall struct {
+	Msg string
+}
+
+func (e ErrInvalidProcedureCall) Error() string {
+	return "Invalid procedure call: " + e.Msg
+}
+
+// RegisterGlobalProcedure registers procedures that can be called
+// from all queries
+func RegisterGlobalProcedure(proc ...Procedure) {
+	for _, p := range proc {
+		globalProcedures[p.Name] = p
+	}
+}
+
+var globalProcedures = map[string]Procedure{}
+
+// getProcedure returns the procedure, and the evaluated arguments
+func (call procedureCall) getProcedure(ctx *EvalContext) (Procedure, []Value, error) {
+	name := make([]string, 0, len(call.name))
+	for _, x := range call.name {
+		name = append(name, string(x))
+	}
+	proc, err := ctx.GetProcedure(name)
+	if err != nil {
+		return Procedure{}, nil, err
+	}
+	args := make([]Value, 0, len(call.args))
+	if call.implicit {
+		for _, arg := range proc.Args {
+			v, err := ctx.GetParameter("$" + arg)
+			if err != nil {
+				if _, ok := err.(ErrUnknownParameter); ok {
+					break
+				}
+				return Procedure{}, nil, err
+			}
+			args = append(args, v)
+		}
+	} else {
+		for _, arg := range call.args {
+			v, err := arg.Evaluate(ctx)
+			if err != nil {
+				return Procedure{}, nil, err
+			}
+			args = append(args, v)
+		}
+	}
+	if len(args) < proc.MinArgs {
+		return Procedure{}, nil, ErrInvalidProcedureCall{Msg: fmt.Sprintf("'%s' needs at least %d arguments", proc.Name, proc.MinArgs)}
+	}
+	if proc.MaxArgs != -1 && len(args) > proc.MaxArgs {
+		return Procedure{}, nil, ErrInvalidProcedureCall{Msg: fmt.Sprintf("'%s' accepts at most %d arguments", proc.Name, proc.MaxArgs)}
+	}
+	return proc, args, nil
+}
+
+// getYieldItems returns the procedure outputs bound to variables. If
+// there is no YIELD or it is YIELD *, all outputs are bound to
+// variables with the same name.
+func (call procedureCall) getYieldItems(proc Procedure) ([]yieldItem, error) {
+	if len(call.yieldItems) == 0 {
+		ret := make([]yieldItem, 0, len(proc.Outputs))
+		for _, x := range proc.Outputs {
+			ret = append(ret, yieldItem{field: x, variable: variable(x)})
+		}
+		return ret, nil
+	}
+	for _, item := range call.yieldItems {
+		found := false
+		for _, x := range proc.Outputs {
+			if x == item.field {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, ErrInvalidProcedureCall{Msg: fmt.Sprintf("'%s' does not have output '%s'", proc.Name, item.field)}
+		}
+	}
+	return call.yieldItems, nil
+}
+
+// GetResults calls the procedure, and returns the yielded rows
+func (call procedureCall) GetResults(ctx *EvalContext) (ResultSet, error) {
+	proc, args, err := call.getProcedure(ctx)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	items, err := call.getYieldItems(proc)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	ret := *NewResultSet()
+	for _, item := range items {
+		ret.Cols = append(ret.Cols, string(item.variable))
+	}
+	err = proc.Func(ctx, args, func(output map[string]Value) error {
+		row := make(map[string]Value, len(items))
+		for _, item := range items {
+			v, ok := output[item.field]
+			if !ok || v == nil {
+				v = RValue{}
+			}
+			row[string(item.variable)] = v
+		}
+		if call.where != nil {
+			rowCtx := ctx.SubContext()
+			rowCtx.SetVars(row)
+			v, err := call.where.Evaluate(rowCtx)
+			if err != nil {
+				return err
+			}
+			if b, _ := ValueAsBool(v); !b {
+				return nil
+			}
+		}
+		ret.Append(row)
+		return nil
+	})
+	if err != nil {
+		return ResultSet{}, fmt.Errorf("In %s: %w", proc.Name, err)
+	}
+	if len(proc.Outputs) == 0 {
+		// A procedure without outputs does not change the cardinality
+		// of the query
+		ret.Rows = []map[string]Value{{}}
+	}
+	return ret, nil
+}
+
+// standaloneCall is a query that consists of a single CALL
+type standaloneCall struct {
+	procedureCall
+}
+
+func (call standaloneCall) Evaluate(ctx *EvalContext) (Value, error) {
+	rs, err := call.GetResults(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rs.Cols) == 0 {
+		// Procedure without outputs
+		rs.Rows = nil
+	}
+	return RValue{Value: rs}, nil
+}
+
+// parseConfig parses the configuration map of a procedure by calling
+// the function of each option given in the map. Null options are
+// ignored, and a null map is an empty map.
+func parseConfig(v Value, options map[string]func(Value) error) error {
+	if v == nil || v.Get() == nil {
+		return nil
+	}
+	cfg, ok := v.Get().(map[string]Value)
+	if !ok {
+		return fmt.Errorf("Config must be a map: %v", v.Get())
+	}
+	for k, val := range cfg {
+		if val.Get() == nil {
+			continue
+		}
+		option, ok := options[k]
+		if !ok {
+			return fmt.Errorf("Unknown config option: %s", k)
+		}
+		if err := option(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDirection parses the direction config option. The direction
+// is one of OUTGOING, INCOMING, or BOTH.
+func parseDirection(v Value) (outgoing, incoming bool, err error) {
+	s, err := ValueAsString(v)
+	if err != nil {
+		return false, false, err
+	}
+	switch strings.ToUpper(s) {
+	case "OUTGOING":
+		return true, false, nil
+	case "INCOMING":
+		return false, true, nil
+	case "BOTH":
+		return true, true, nil
+	}
+	return false, false, fmt.Errorf("Invalid direction: %s", s)
+}
+
+// valueAsStrings returns a string, or a list of strings as a string
+// slice
+func valueAsStrings(v Value) ([]string, error) {
+	if s, ok := v.Get().(string); ok {
+		return []string{s}, nil
+	}
+	list, ok := v.Get().([]Value)
+	if !ok {
+		return nil, fmt.Errorf("String or list of strings required: %v", v.Get())
+	}
+	ret := make([]string, 0, len(list))
+	for _, x := range list {
+		s, err := ValueAsString(x)
+		if err != nil {
+			return nil, fmt.Errorf("String or list of strings required: %v", v.Get())
+		}
+		ret = append(ret, s)
+	}
+	return ret, nil
+}
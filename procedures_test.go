@@ -0,0 +1,93 @@
+package opencypher
+
+import (
+	"testing"
+)
+
+func init() {
+	RegisterGlobalProcedure(Procedure{
+		Name:    "test.seq",
+		MinArgs: 1,
+		MaxArgs: 2,
+		Args:    []string{"n", "step"},
+		Outputs: []string{"value", "square"},
+		Func: func(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+			n, err := ValueAsInt(args[0])
+			if err != nil {
+				return err
+			}
+			step := 1
+			if len(args) > 1 {
+				if step, err = ValueAsInt(args[1]); err != nil {
+					return err
+				}
+			}
+			for i := 1; i <= n; i += step {
+				if err := emit(map[string]Value{"value": ValueOf(i), "square": ValueOf(i * i)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func TestProcedureCall(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `CALL test.seq(3)`, g)
+	if len(rs.Rows) != 3 || rs.Rows[2]["value"].Get() != 3 || rs.Rows[2]["square"].Get() != 9 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `CALL test.seq(5, 2) YIELD square AS sq WHERE sq > 1`, g)
+	if len(rs.Rows) != 2 || rs.Rows[0]["sq"].Get() != 9 || rs.Rows[0]["square"] != nil {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	ctx := NewEvalContext(g)
+	ctx.SetParameter("$n", ValueOf(2))
+	v, err := ParseAndEvaluate(`CALL test.seq`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs := v.Get().(ResultSet); len(rs.Rows) != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// In-query call, joined with incoming rows
+	rs = runTestMatch(t, `MATCH (a:Person) WHERE a.age > 35 CALL test.seq(2) YIELD value RETURN a.name AS name, value AS value ORDER BY name, value`, g)
+	if len(rs.Rows) != 4 || rs.Rows[0]["name"].Get() != "Andy" || rs.Rows[1]["value"].Get() != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `UNWIND [1, 2] AS n CALL test.seq(n) YIELD value WITH n, count(*) AS c RETURN n AS n, c AS c ORDER BY n`, g)
+	if len(rs.Rows) != 2 || rs.Rows[0]["c"].Get() != 1 || rs.Rows[1]["c"].Get() != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	for _, q := range []string{`CALL test.nothing()`, `CALL test.seq()`, `CALL test.seq(1) YIELD x`} {
+		if _, err := ParseAndEvaluate(q, NewEvalContext(g)); err == nil {
+			t.Errorf("%s: Expecting error", q)
+		}
+	}
+}
+
+func TestSchemaProcedures(t *testing.T) {
+	g := getPersonGraph()
+	g.NewNode([]string{"City"}, map[string]interface{}{"name": "Oslo", "population": 700000})
+	rs := runTestMatch(t, `CALL db.labels()`, g)
+	if len(rs.Rows) != 2 || rs.Rows[0]["label"].Get() != "City" || rs.Rows[0]["count"].Get() != 1 || rs.Rows[1]["count"].Get() != 4 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `CALL db.relationshipTypes() YIELD relationshipType, count`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["relationshipType"].Get() != "KNOWS" || rs.Rows[0]["count"].Get() != 3 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `CALL db.propertyKeys() YIELD propertyKey AS key, count RETURN key AS key, count AS count ORDER BY count DESC, key`, g)
+	if len(rs.Rows) != 3 || rs.Rows[0]["key"].Get() != "name" || rs.Rows[0]["count"].Get() != 5 || rs.Rows[2]["key"].Get() != "population" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `CALL db.labels`, g)
+	if len(rs.Rows) != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}
@@ -0,0 +1,76 @@
+package opencypher
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+// procedureIndexes are the in-memory indexes created by procedures,
+// by graph and index name. An index is a snapshot of the graph at the
+// time it is created, but the nodes deleted by DELETE after that are
+// skipped when the index is queried. The registry keeps the graph,
+// and its nodes, from being garbage collected until DropIndexes is
+// called for it.
+var procedureIndexes = struct {
+	sync.Mutex
+	graphs map[*lpg.Graph]map[string]interface{}
+}{graphs: make(map[*lpg.Graph]map[string]interface{})}
+
+// DropIndexes removes the in-memory indexes created by procedures
+// for the graph. It must be called when a graph that has indexes is
+// no longer used, otherwise the graph is never garbage collected.
+func DropIndexes(graph *lpg.Graph) {
+	procedureIndexes.Lock()
+	defer procedureIndexes.Unlock()
+	delete(procedureIndexes.graphs, graph)
+}
+
+// createIndex stores the index for the graph of the context,
+// replacing any existing index with the same name, and emits the name
+// and the number of indexed nodes
+func createIndex(ctx *EvalContext, name string, index interface{}, nodeCount int, emit func(map[string]Value) error) error {
+	procedureIndexes.Lock()
+	indexes := procedureIndexes.graphs[ctx.graph]
+	if indexes == nil {
+		indexes = make(map[string]interface{})
+		procedureIndexes.graphs[ctx.graph] = indexes
+	}
+	indexes[name] = index
+	procedureIndexes.Unlock()
+	return emit(map[string]Value{
+		"name":      RValue{Value: name},
+		"nodeCount": RValue{Value: nodeCount},
+	})
+}
+
+// lookupIndex returns the index of the graph of the context with the
+// given name. The caller checks the type of the index.
+func lookupIndex(ctx *EvalContext, nameArg Value) (string, interface{}, error) {
+	name, err := ValueAsString(nameArg)
+	if err != nil {
+		return "", nil, fmt.Errorf("name: %w", err)
+	}
+	procedureIndexes.Lock()
+	defer procedureIndexes.Unlock()
+	return name, procedureIndexes.graphs[ctx.graph][name], nil
+}
+
+// nodeRemover is implemented by indexes that skip deleted nodes
+type nodeRemover interface {
+	// removeNode is called when the node is deleted from the graph
+	removeNode(*lpg.Node)
+}
+
+// removeFromIndexes tells the indexes of the graph of the node that
+// the node is deleted
+func removeFromIndexes(node *lpg.Node) {
+	procedureIndexes.Lock()
+	defer procedureIndexes.Unlock()
+	for _, index := range procedureIndexes.graphs[node.GetGraph()] {
+		if r, ok := index.(nodeRemover); ok {
+			r.removeNode(node)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package opencypher
+
+import (
+	"sort"
+)
+
+// getWithNames returns the names of the variables projected by a
+// WITH clause. Expressions that are not variable references must be
+// aliased.
+func (p projectionItems) getWithNames() ([]string, error) {
+	ret := make([]string, 0, len(p.items))
+	for _, item := range p.items {
+		if item.variable != nil {
+			ret = append(ret, string(*item.variable))
+			continue
+		}
+		if v, ok := exprAsVariable(item.expr); ok {
+			ret = append(ret, string(v))
+			continue
+		}
+		return nil, ErrInvalidExpression("Expression in WITH must be aliased")
+	}
+	return ret, nil
+}
+
+// exprAsVariable returns the variable if the expression is only a
+// variable reference
+func exprAsVariable(expr Expression) (variable, bool) {
+	var pl propertyOrLabelsExpression
+	switch e := expr.(type) {
+	case variable:
+		return e, true
+	case stringListNullOperatorExpression:
+		if len(e.parts) != 0 {
+			return "", false
+		}
+		pl = e.propertyOrLabels
+	case propertyOrLabelsExpression:
+		pl = e
+	default:
+		return "", false
+	}
+	if len(pl.propertyLookup) != 0 || pl.nodeLabels != nil {
+		return "", false
+	}
+	v, ok := pl.atom.(variable)
+	return v, ok
+}
+
+// getSkipLimit evaluates the SKIP and LIMIT expressions. Returns -1
+// for the ones that are not specified.
+func (pb projectionBody) getSkipLimit(ctx *EvalContext) (skip, limit int, err error) {
+	skip, limit = -1, -1
+	if pb.skip != nil {
+		skip, err = ValueAsInt(pb.skip.Evaluate(ctx))
+		if err != nil {
+			return
+		}
+	}
+	if pb.limit != nil {
+		limit, err = ValueAsInt(pb.limit.Evaluate(ctx))
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// project evaluates the projection body for all input rows, and
+// stores the projected values using the given names. The projected
+// rows are then made distinct, sorted, skipped and limited as
+// described in the projection body.
+func (pb projectionBody) project(ctx *EvalContext, input ResultSet, names []string) (ResultSet, error) {
+	type sortableRow struct {
+		row  map[string]Value
+		keys []Value
+	}
+	skip, limit, err := pb.getSkipLimit(ctx)
+	if err != nil {
+		return ResultSet{}, err
+	}
+
+	projected, err := pb.items.projectRows(ctx, input.Rows, names)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	seen := NewResultSet()
+	rows := make([]sortableRow, 0, len(projected))
+	for _, prow := range projected {
+		row := prow.row
+		if pb.distinct {
+			if seen.find(row) != -1 {
+				continue
+			}
+			seen.Rows = append(seen.Rows, row)
+		}
+		var keys []Value
+		if pb.order != nil {
+			// Sort keys can refer to projected values, as well as the
+			// variables of the input row
+			keyCtx := prow.vars.SubContext()
+			keyCtx.SetVars(row)
+			keys = make([]Value, 0, len(pb.order.items))
+			for _, item := range pb.order.items {
+				v, err := item.expr.Evaluate(keyCtx)
+				if err != nil {
+					return ResultSet{}, err
+				}
+				keys = append(keys, v)
+			}
+		}
+		rows = append(rows, sortableRow{row: row, keys: keys})
+	}
+	if pb.order != nil {
+		sort.SliceStable(rows, func(i, j int) bool {
+			return pb.order.less(rows[i].keys, rows[j].keys)
+		})
+	}
+
+	ret := *NewResultSet()
+	ret.Cols = pb.items.getColumns(input, names)
+	for index, row := range rows {
+		if skip != -1 && index < skip {
+			continue
+		}
+		if limit != -1 && len(ret.Rows) >= limit {
+			break
+		}
+		ret.Append(row.row)
+	}
+	return ret, nil
+}
+
+// projectedRow is a row produced by a projection, together with the
+// context the row is projected in
+type projectedRow struct {
+	row  map[string]Value
+	vars *EvalContext
+}
+
+// projectRows projects the input rows. If the projection contains
+// aggregation functions, the rows are grouped by the values of the
+// remaining projection items, and one row is produced for each
+// group.
+func (prj projectionItems) projectRows(ctx *EvalContext, rows []map[string]Value, names []string) ([]projectedRow, error) {
+	aggregates := make([][]aggregateExpr, len(prj.items))
+	hasAggregates := false
+	for i, item := range prj.items {
+		a, err := getAggregates(ctx, item.expr)
+		if err != nil {
+			return nil, err
+		}
+		aggregates[i] = a
+		if len(a) > 0 {
+			hasAggregates = true
+		}
+	}
+	if hasAggregates {
+		return prj.projectGroups(ctx, rows, names, aggregates)
+	}
+	ret := make([]projectedRow, 0, len(rows))
+	for _, row := range rows {
+		rowCtx := ctx.SubContext()
+		out, err := prj.projectAs(rowCtx, row, names)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, projectedRow{row: out, vars: rowCtx})
+	}
+	return ret, nil
+}
+
+// getColumns returns the column names of the projection. If the
+// projection includes all variables, the named columns of the input
+// are included as well.
+func (p projectionItems) getColumns(input ResultSet, names []string) []string {
+	if !p.all {
+		return names
+	}
+	ret := make([]string, 0)
+	seen := make(map[string]struct{})
+	add := func(s string) {
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			ret = append(ret, s)
+		}
+	}
+	cols := input.Cols
+	if len(cols) == 0 && len(input.Rows) > 0 {
+		for k := range input.Rows[0] {
+			cols = append(cols, k)
+		}
+		sort.Strings(cols)
+	}
+	for _, c := range cols {
+		if IsNamedResult(c) {
+			add(c)
+		}
+	}
+	for _, n := range names {
+		add(n)
+	}
+	return ret
+}
+
+// less compares two sets of sort keys
+func (o *order) less(keys1, keys2 []Value) bool {
+	for i, item := range o.items {
+		c := compareOrderable(keys1[i].Get(), keys2[i].Get())
+		if c == 0 {
+			continue
+		}
+		if item.asc {
+			return c < 0
+		}
+		return c > 0
+	}
+	return false
+}
+
+// evaluate projects the input rows, and filters the projected rows
+// using the WHERE expression
+func (w withClause) evaluate(ctx *EvalContext, input ResultSet) (ResultSet, error) {
+	names, err := w.projection.items.getWithNames()
+	if err != nil {
+		return ResultSet{}, err
+	}
+	rs, err := w.projection.project(ctx, input, names)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	if w.where == nil {
+		return rs, nil
+	}
+	ret := *NewResultSet()
+	ret.Cols = rs.Cols
+	for _, row := range rs.Rows {
+		rowCtx := ctx.SubContext()
+		rowCtx.SetVars(row)
+		v, err := w.where.Evaluate(rowCtx)
+		if err != nil {
+			return ResultSet{}, err
+		}
+		if b, _ := ValueAsBool(v); b {
+			ret.Append(row)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,482 @@
+package opencypher
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func getPersonGraph() *lpg.Graph {
+	g := lpg.NewGraph()
+	andy := g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Andy", "age": 36})
+	timothy := g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Timothy", "age": 25})
+	peter := g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Peter", "age": 34})
+	emil := g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Emil", "age": 41})
+	g.NewEdge(andy, timothy, "KNOWS", nil)
+	g.NewEdge(andy, peter, "KNOWS", nil)
+	g.NewEdge(peter, emil, "KNOWS", nil)
+	return g
+}
+
+func TestWith(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `MATCH (a:Person)-[:KNOWS]->(b)
+WITH b, a.name AS source
+WHERE b.age > 30
+MATCH (b)-[:KNOWS]->(c)
+RETURN source AS source, b.name AS b, c.name AS c`, g)
+	if len(rs.Rows) != 1 {
+		t.Fatalf("Expecting 1 row, got %v", rs)
+	}
+	if rs.Rows[0]["source"].Get() != "Andy" || rs.Rows[0]["b"].Get() != "Peter" || rs.Rows[0]["c"].Get() != "Emil" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// Only projected variables are visible after WITH
+	_, err := ParseAndEvaluate(`MATCH (a:Person)-[:KNOWS]->(b) WITH b RETURN a`, NewEvalContext(g))
+	if err == nil {
+		t.Errorf("Expecting unknown variable error")
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person) WITH a.name AS name ORDER BY a.age DESC SKIP 1 LIMIT 2 RETURN name AS name`, g)
+	if len(rs.Rows) != 2 || rs.Rows[0]["name"].Get() != "Andy" || rs.Rows[1]["name"].Get() != "Peter" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person)-[:KNOWS]->() WITH DISTINCT a RETURN a.name AS name`, g)
+	if len(rs.Rows) != 2 {
+		t.Errorf("Expecting 2 rows, got %v", rs)
+	}
+
+	rs = runTestMatch(t, `CREATE (n:City {name: 'Oslo'}) WITH n MATCH (m:City) RETURN m.name AS name`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["name"].Get() != "Oslo" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	if _, err := ParseAndEvaluate(`MATCH (a:Person) WITH a.name RETURN a`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error for unaliased expression")
+	}
+}
+
+func TestAggregation(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `MATCH (a:Person)-[:KNOWS]->(b) RETURN a.name AS name, count(*) AS n, collect(b.name) AS friends`, g)
+	if len(rs.Rows) != 2 {
+		t.Fatalf("Expecting 2 rows, got %v", rs)
+	}
+	for _, row := range rs.Rows {
+		switch row["name"].Get() {
+		case "Andy":
+			if row["n"].Get() != 2 || len(row["friends"].Get().([]Value)) != 2 {
+				t.Errorf("Wrong result: %v", row)
+			}
+		case "Peter":
+			if row["n"].Get() != 1 || len(row["friends"].Get().([]Value)) != 1 {
+				t.Errorf("Wrong result: %v", row)
+			}
+		default:
+			t.Errorf("Unexpected row: %v", row)
+		}
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person) RETURN count(a) AS n, sum(a.age) AS s, avg(a.age) AS avg, min(a.age) AS min, max(a.name) AS max`, g)
+	if len(rs.Rows) != 1 {
+		t.Fatalf("Expecting 1 row, got %v", rs)
+	}
+	row := rs.Rows[0]
+	if row["n"].Get() != 4 || row["s"].Get() != 136 || row["avg"].Get() != 34.0 || row["min"].Get() != 25 || row["max"].Get() != "Timothy" {
+		t.Errorf("Wrong result: %v", row)
+	}
+
+	// Aggregation over no rows
+	rs = runTestMatch(t, `MATCH (a:Nothing) RETURN count(*) AS n, sum(a.age) AS s, avg(a.age) AS avg, collect(a) AS c`, g)
+	if len(rs.Rows) != 1 {
+		t.Fatalf("Expecting 1 row, got %v", rs)
+	}
+	row = rs.Rows[0]
+	if row["n"].Get() != 0 || row["s"].Get() != 0 || row["avg"].Get() != nil || len(row["c"].Get().([]Value)) != 0 {
+		t.Errorf("Wrong result: %v", row)
+	}
+	rs = runTestMatch(t, `MATCH (a:Nothing) RETURN a.name AS name, count(*) AS n`, g)
+	if len(rs.Rows) != 0 {
+		t.Errorf("Expecting no rows, got %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person)-[:KNOWS]->(b) RETURN count(DISTINCT a) AS n, count(a) AS m`, g)
+	if rs.Rows[0]["n"].Get() != 2 || rs.Rows[0]["m"].Get() != 3 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person) RETURN percentileDisc(a.age, 0.5) AS d, percentileCont(a.age, 0.5) AS c, stDevP(a.age) AS sd`, g)
+	if rs.Rows[0]["d"].Get() != 34 || rs.Rows[0]["c"].Get() != 35.0 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// Aggregation in WITH, and expressions over aggregates
+	rs = runTestMatch(t, `MATCH (a:Person)-[:KNOWS]->(b) WITH a, count(b) AS n WHERE n > 1 RETURN a.name AS name, n * 10 AS x`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["name"].Get() != "Andy" || rs.Rows[0]["x"].Get() != 20 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (a:Person) RETURN count(*) + 1 AS n`, g)
+	if rs.Rows[0]["n"].Get() != 5 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	if _, err := ParseAndEvaluate(`MATCH (a:Person) WHERE count(a) > 1 RETURN a`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error for aggregation in WHERE")
+	}
+
+	// Numbers with the same value are in the same group
+	rs = runTestMatch(t, `UNWIND [1, 1.0, 2] AS x RETURN x AS x, count(*) AS n, count(DISTINCT x) AS d`, g)
+	if len(rs.Rows) != 2 || rs.Rows[0]["n"].Get() != 2 || rs.Rows[0]["d"].Get() != 1 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `UNWIND [1, 'a', 2.5, null] AS x RETURN min(x) AS min, max(x) AS max`, g)
+	if rs.Rows[0]["min"].Get() != "a" || rs.Rows[0]["max"].Get() != 2.5 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// Group keys of different values are not confused
+	rs = runTestMatch(t, `UNWIND [['a;string:b', 'x'], ['a', 'b;string:x']] AS p RETURN p[0] AS x, p[1] AS y, count(*) AS n`, g)
+	if len(rs.Rows) != 2 {
+		t.Errorf("Expecting 2 rows, got %v", rs)
+	}
+	rs = runTestMatch(t, `UNWIND [['a,string:b'], ['a', 'b'], {k: 'a'}, {`+"`k:string:a`"+`: 1}] AS x RETURN count(DISTINCT x) AS n`, g)
+	if rs.Rows[0]["n"].Get() != 4 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	for _, query := range []string{
+		`UNWIND [0, duration('P1D')] AS x RETURN sum(x) AS s`,
+		`UNWIND [duration('P1D'), 0] AS x RETURN sum(x) AS s`,
+	} {
+		if _, err := ParseAndEvaluate(query, NewEvalContext(g)); err == nil {
+			t.Errorf("%s: Expecting error", query)
+		}
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	g := getPersonGraph()
+	g.NewNode([]string{"Person"}, map[string]interface{}{"name": "Nobody"})
+	names := func(rs ResultSet) []interface{} {
+		ret := make([]interface{}, 0)
+		for _, row := range rs.Rows {
+			ret = append(ret, row["name"].Get())
+		}
+		return ret
+	}
+	check := func(query string, expected ...interface{}) {
+		t.Helper()
+		rs := runTestMatch(t, query, g)
+		got := names(rs)
+		if len(got) != len(expected) {
+			t.Errorf("%s: Expected %v, got %v", query, expected, got)
+			return
+		}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Errorf("%s: Expected %v, got %v", query, expected, got)
+				return
+			}
+		}
+	}
+	check(`MATCH (a:Person) RETURN a.name AS name ORDER BY a.age`, "Timothy", "Peter", "Andy", "Emil", "Nobody")
+	check(`MATCH (a:Person) RETURN a.name AS name ORDER BY a.age DESC`, "Nobody", "Emil", "Andy", "Peter", "Timothy")
+	check(`MATCH (a:Person) RETURN a.name AS name ORDER BY name DESC LIMIT 2`, "Timothy", "Peter")
+	check(`MATCH (a:Person) RETURN a.name AS name ORDER BY a.age SKIP 1 LIMIT 2`, "Peter", "Andy")
+	check(`MATCH (a:Person)-[:KNOWS]->() RETURN DISTINCT a.name AS name ORDER BY name`, "Andy", "Peter")
+	check(`MATCH (a:Person) WHERE a.age > 30 RETURN a.name AS name ORDER BY a.age % 2, a.name`, "Andy", "Peter", "Emil")
+	check(`MATCH (a:Person)-[:KNOWS]->(b) RETURN a.name AS name, count(b) AS n ORDER BY count(b) DESC`, "Andy", "Peter")
+	check(`MATCH (a:Person)-[:KNOWS]->(b) RETURN a.name AS name, collect(b.age) AS ages ORDER BY size( collect ( b.age ) )`, "Peter", "Andy")
+
+	// Values of different types are ordered by type
+	rs := runTestMatch(t, `MATCH (a:Person) RETURN a.name AS name ORDER BY CASE WHEN a.age > 35 THEN a.name ELSE a.age END`, g)
+	if got := names(rs); got[0] != "Andy" || got[1] != "Emil" || got[2] != "Timothy" || got[4] != "Nobody" {
+		t.Errorf("Wrong order: %v", got)
+	}
+
+	// SKIP and LIMIT after a top level update
+	rs = runTestMatch(t, `CREATE (n:City {name:'Oslo'}) RETURN n.name AS name SKIP 1`, g)
+	if len(rs.Rows) != 0 {
+		t.Errorf("Expecting no rows, got %v", rs)
+	}
+	rs = runTestMatch(t, `RETURN 1 AS x LIMIT 0`, g)
+	if len(rs.Rows) != 0 {
+		t.Errorf("Expecting no rows, got %v", rs)
+	}
+}
+
+func TestUnwind(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `UNWIND [1, 2, 3] AS x RETURN x AS x`, g)
+	if len(rs.Rows) != 3 || rs.Rows[2]["x"].Get() != 3 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	for _, q := range []string{`UNWIND [] AS x RETURN x AS x`, `UNWIND null AS x RETURN x AS x`} {
+		rs = runTestMatch(t, q, g)
+		if len(rs.Rows) != 0 {
+			t.Errorf("%s: Expecting no rows, got %v", q, rs)
+		}
+	}
+
+	// Joined with incoming rows
+	rs = runTestMatch(t, `MATCH (a:Person) WHERE a.age > 35 UNWIND [a.name, a.age] AS x RETURN x AS x`, g)
+	if len(rs.Rows) != 4 {
+		t.Errorf("Expecting 4 rows, got %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (a:Person) WITH collect(a.age) AS ages UNWIND ages AS age RETURN sum(age) AS total`, g)
+	if rs.Rows[0]["total"].Get() != 136 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// Batch insert from a parameter
+	g = lpg.NewGraph()
+	ctx := NewEvalContext(g)
+	ctx.SetParameter("$rows", ValueOf([]interface{}{
+		map[string]interface{}{"name": "a", "n": 1},
+		map[string]interface{}{"name": "b", "n": 2},
+	}))
+	v, err := ParseAndEvaluate(`UNWIND $rows AS row CREATE (x:Item {name: row.name, n: row.n}) RETURN x.name AS name`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.NumNodes() != 2 {
+		t.Errorf("Expecting 2 nodes, got %d", g.NumNodes())
+	}
+	if rs := v.Get().(ResultSet); len(rs.Rows) != 2 || rs.Rows[0]["name"].Get() != "a" || rs.Rows[1]["name"].Get() != "b" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}
+
+func TestOptionalMatch(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `MATCH (a:Person) OPTIONAL MATCH (a)-[r:KNOWS]->(b) RETURN a.name AS a, b.name AS b, type(r) AS t, labels(b) AS l ORDER BY a, b`, g)
+	if len(rs.Rows) != 5 {
+		t.Fatalf("Expecting 5 rows, got %v", rs)
+	}
+	for _, row := range rs.Rows {
+		switch row["a"].Get() {
+		case "Emil", "Timothy":
+			if row["b"].Get() != nil || row["t"].Get() != nil || row["l"].Get() != nil {
+				t.Errorf("Expecting nulls: %v", row)
+			}
+		default:
+			if row["b"].Get() == nil || row["t"].Get() != "KNOWS" {
+				t.Errorf("Wrong row: %v", row)
+			}
+		}
+	}
+
+	// WHERE is part of the optional match
+	rs = runTestMatch(t, `MATCH (a:Person {name:'Andy'}) OPTIONAL MATCH (a)-->(b) WHERE b.age > 50 RETURN a.name AS a, b AS b`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["a"].Get() != "Andy" || rs.Rows[0]["b"].Get() != nil {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `OPTIONAL MATCH (n:Nothing) RETURN n AS n`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["n"].Get() != nil {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// Null variables do not match in later patterns
+	rs = runTestMatch(t, `MATCH (a:Person) OPTIONAL MATCH (a)-->(b) MATCH (b)-->(c) RETURN a.name AS a, c.name AS c`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["a"].Get() != "Andy" || rs.Rows[0]["c"].Get() != "Emil" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (a:Person) OPTIONAL MATCH (a)-->(b) OPTIONAL MATCH (b)-->(c) RETURN count(c) AS n, count(*) AS m`, g)
+	if rs.Rows[0]["n"].Get() != 1 || rs.Rows[0]["m"].Get() != 5 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}
+
+func TestListPredicates(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `RETURN [x IN [1,2,3,4,5,6] WHERE x % 2 = 0 | x * 10] AS a, [x IN [1,2] | x + 1] AS b, [x IN [1,null,3] WHERE x > 1] AS c, [x IN null | x] AS d`, g)
+	row := rs.Rows[0]
+	if a := row["a"].Get().([]Value); len(a) != 3 || a[2].Get() != 60 {
+		t.Errorf("Wrong a: %v", row)
+	}
+	if b := row["b"].Get().([]Value); len(b) != 2 || b[0].Get() != 2 {
+		t.Errorf("Wrong b: %v", row)
+	}
+	if c := row["c"].Get().([]Value); len(c) != 1 || c[0].Get() != 3 {
+		t.Errorf("Wrong c: %v", row)
+	}
+	if row["d"].Get() != nil {
+		t.Errorf("Wrong d: %v", row)
+	}
+
+	for q, expected := range map[string]interface{}{
+		`all(x IN [1,2,3] WHERE x > 0)`:     true,
+		`all(x IN [1,null,3] WHERE x > 1)`:  false,
+		`all(x IN [1,null,3] WHERE x > 0)`:  nil,
+		`all(x IN [] WHERE x > 0)`:          true,
+		`any(x IN [1,null,3] WHERE x > 2)`:  true,
+		`any(x IN [1,null,3] WHERE x > 5)`:  nil,
+		`any(x IN [] WHERE x > 0)`:          false,
+		`none(x IN [1,2,3] WHERE x > 5)`:    true,
+		`none(x IN [1,null] WHERE x > 0)`:   false,
+		`none(x IN [1,null] WHERE x > 1)`:   nil,
+		`single(x IN [1,2,3] WHERE x > 2)`:  true,
+		`single(x IN [1,2,3] WHERE x > 1)`:  false,
+		`single(x IN [1,null] WHERE x > 0)`: nil,
+		`single(x IN null WHERE x > 0)`:     nil,
+	} {
+		rs := runTestMatch(t, "RETURN "+q, g)
+		if v := rs.Rows[0]["1"].Get(); v != expected {
+			t.Errorf("%s: Expected %v, got %v", q, expected, v)
+		}
+	}
+
+	g.NewNode([]string{"Doc"}, map[string]interface{}{"tags": []Value{ValueOf("a"), ValueOf("b")}})
+	g.NewNode([]string{"Doc"}, map[string]interface{}{"tags": []Value{ValueOf("c")}})
+	rs = runTestMatch(t, `MATCH (d:Doc) WHERE any(t IN d.tags WHERE t = 'b') RETURN d`, g)
+	if len(rs.Rows) != 1 {
+		t.Errorf("Expecting 1 row, got %v", rs)
+	}
+}
+
+func TestPatternExpressions(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `MATCH (a:Person) RETURN a.name AS name, [(a)-[:KNOWS]->(b) WHERE b.age > 30 | b.name] AS friends ORDER BY name`, g)
+	if len(rs.Rows) != 4 {
+		t.Fatalf("Expecting 4 rows, got %v", rs)
+	}
+	for _, row := range rs.Rows {
+		friends := row["friends"].Get().([]Value)
+		switch row["name"].Get() {
+		case "Andy":
+			if len(friends) != 1 || friends[0].Get() != "Peter" {
+				t.Errorf("Wrong row: %v", row)
+			}
+		case "Peter":
+			if len(friends) != 1 || friends[0].Get() != "Emil" {
+				t.Errorf("Wrong row: %v", row)
+			}
+		default:
+			if len(friends) != 0 {
+				t.Errorf("Wrong row: %v", row)
+			}
+		}
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person {name:'Andy'}) RETURN size([p = (a)-->() | p]) AS n`, g)
+	if rs.Rows[0]["n"].Get() != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH (a:Person) WHERE (a)-[:KNOWS]->(:Person {name:'Emil'}) RETURN a.name AS name`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["name"].Get() != "Peter" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (a:Person) WHERE NOT (a)-->() RETURN a.name AS name ORDER BY name`, g)
+	if len(rs.Rows) != 2 || rs.Rows[0]["name"].Get() != "Emil" || rs.Rows[1]["name"].Get() != "Timothy" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (a:Person) WHERE (a)<--() RETURN count(*) AS n`, g)
+	if rs.Rows[0]["n"].Get() != 3 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}
+
+func TestMapProjection(t *testing.T) {
+	g := getPersonGraph()
+	rs := runTestMatch(t, `MATCH (n:Person {name:'Andy'}) WITH n, 2 AS x RETURN n {.name, .age, total: x + 1, x, .missing} AS m`, g)
+	m := rs.Rows[0]["m"].Get().(map[string]Value)
+	if len(m) != 5 || m["name"].Get() != "Andy" || m["age"].Get() != 36 || m["total"].Get() != 3 || m["x"].Get() != 2 || m["missing"].Get() != nil {
+		t.Errorf("Wrong result: %v", m)
+	}
+	rs = runTestMatch(t, `MATCH (n:Person {name:'Andy'}) RETURN n{.*, name: n.name + '!'} AS m`, g)
+	m = rs.Rows[0]["m"].Get().(map[string]Value)
+	if len(m) != 2 || m["name"].Get() != "Andy!" || m["age"].Get() != 36 {
+		t.Errorf("Wrong result: %v", m)
+	}
+	// Projections of maps, nested and aggregated projections
+	rs = runTestMatch(t, `MATCH (a:Person {name:'Andy'})-[r]->(b) WITH a, collect(b {.name}) AS friends, {x: 1, y: 'a {.b}'} AS data RETURN a {.name, friends, d: data {.y}, count: size(friends)} AS m`, g)
+	m = rs.Rows[0]["m"].Get().(map[string]Value)
+	if len(m["friends"].Get().([]Value)) != 2 || m["count"].Get() != 2 || m["d"].Get().(map[string]Value)["y"].Get() != "a {.b}" {
+		t.Errorf("Wrong result: %v", m)
+	}
+	rs = runTestMatch(t, `MATCH (n:Person) RETURN n {.name} AS m ORDER BY m.name`, g)
+	if len(rs.Rows) != 4 || rs.Rows[0]["m"].Get().(map[string]Value)["name"].Get() != "Andy" {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (n:Person {name:'Andy'}) RETURN n {first: n.name} AS m`, g)
+	m = rs.Rows[0]["m"].Get().(map[string]Value)
+	if len(m) != 1 || m["first"].Get() != "Andy" {
+		t.Errorf("Wrong result: %v", m)
+	}
+	rs = runTestMatch(t, `OPTIONAL MATCH (n:Nothing) RETURN n {.name} AS m`, g)
+	if rs.Rows[0]["m"].Get() != nil {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}
+
+func TestDynamicPropertyAccess(t *testing.T) {
+	g := getPersonGraph()
+	ctx := NewEvalContext(g)
+	ctx.SetParameter("$key", ValueOf("age"))
+	v, err := ParseAndEvaluate(`MATCH (n:Person {name:'Andy'})-[r]->(m {name:'Peter'}) WITH n, r, {a: 1} AS data RETURN n[$key] AS age, n['na' + 'me'] AS name, n['x'] AS x, data['a'] AS a, r['x'] AS rx, n[null] AS y`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := v.Get().(ResultSet).Rows[0]
+	if row["age"].Get() != 36 || row["name"].Get() != "Andy" || row["x"].Get() != nil || row["a"].Get() != 1 || row["rx"].Get() != nil || row["y"].Get() != nil {
+		t.Errorf("Wrong result: %v", row)
+	}
+	if _, err := ParseAndEvaluate(`MATCH (n:Person) RETURN n[1]`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error")
+	}
+}
+
+func TestEntityFunctions(t *testing.T) {
+	g := getPersonGraph()
+	var andy, peter *lpg.Node
+	for nodes := g.GetNodes(); nodes.Next(); {
+		node := nodes.Node()
+		switch name, _ := node.GetProperty("name"); name {
+		case "Andy":
+			andy = node
+		case "Peter":
+			peter = node
+		}
+	}
+	edge := g.NewEdge(peter, andy, "LIKES", map[string]interface{}{"since": 2020})
+	ctx := NewEvalContext(g)
+	v, err := ParseAndEvaluate(`MATCH (n:Person {name:'Peter'})-[r:LIKES]->(m) RETURN id(n) AS nid, elementId(n) AS neid, id(r) AS rid, elementId(r) AS reid, startNode(r) AS s, endNode(r) AS e, keys(r) AS keys, properties(n) AS props, exists(n.age) AS hasAge, exists(n.x) AS hasX, id(null) AS nullid`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := v.Get().(ResultSet).Rows[0]
+	if row["nid"].Get() != peter.GetID() || row["neid"].Get() != fmt.Sprintf("n:%d", peter.GetID()) {
+		t.Errorf("Wrong node id: %v", row)
+	}
+	if row["rid"].Get() != edge.GetID() || row["reid"].Get() != fmt.Sprintf("e:%d", edge.GetID()) {
+		t.Errorf("Wrong edge id: %v", row)
+	}
+	if row["s"].Get() != peter || row["e"].Get() != andy {
+		t.Errorf("Wrong start/end nodes: %v", row)
+	}
+	keys := row["keys"].Get().([]Value)
+	if len(keys) != 1 || keys[0].Get() != "since" {
+		t.Errorf("Wrong keys: %v", keys)
+	}
+	props := row["props"].Get().(map[string]Value)
+	if len(props) != 2 || props["name"].Get() != "Peter" || props["age"].Get() != 34 {
+		t.Errorf("Wrong properties: %v", props)
+	}
+	if row["hasAge"].Get() != true || row["hasX"].Get() != false || row["nullid"].Get() != nil {
+		t.Errorf("Wrong result: %v", row)
+	}
+	// exists with a pattern is the pattern predicate
+	rs := runTestMatch(t, `MATCH (n:Person) RETURN n.name AS name, exists((n)-[:KNOWS]->()) AS knows, EXISTS(((n)<-[:LIKES]-())) AS liked ORDER BY name`, g)
+	for i, expected := range [][]interface{}{{"Andy", true, true}, {"Emil", false, false}, {"Peter", true, false}, {"Timothy", false, false}} {
+		if row := rs.Rows[i]; row["name"].Get() != expected[0] || row["knows"].Get() != expected[1] || row["liked"].Get() != expected[2] {
+			t.Errorf("Wrong result: %v", row)
+		}
+	}
+	if _, err := ParseAndEvaluate(`RETURN startNode(1) AS x`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error")
+	}
+}
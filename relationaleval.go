@@ -1,6 +1,12 @@
 package opencypher
 
-import ()
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
 
 func comparePrimitiveValues(v1, v2 interface{}) (int, error) {
 	if v1 == nil {
@@ -71,19 +77,14 @@ func comparePrimitiveValues(v1, v2 interface{}) (int, error) {
 			if value1.Days == dur.Days && value1.Months == dur.Months && value1.Seconds == dur.Seconds && value1.Nanos == dur.Nanos {
 				return 0, nil
 			}
-			if value1.Days < dur.Days {
-				return -1, nil
-			}
-			if value1.Months < dur.Months {
-				return -1, nil
-			}
-			if value1.Seconds < dur.Seconds {
-				return -1, nil
-			}
-			if value1.Nanos < dur.Nanos {
-				return -1, nil
+			// Durations are not comparable. They are ordered by their
+			// components so sorting is deterministic
+			for _, c := range []int{compareInt64(value1.Months, dur.Months), compareInt64(value1.Days, dur.Days), compareInt64(value1.Seconds, dur.Seconds)} {
+				if c != 0 {
+					return c, nil
+				}
 			}
-			return 1, nil
+			return compareInt64(int64(value1.Nanos), int64(dur.Nanos)), nil
 		}
 	case Date:
 		if date, ok := v2.(Date); ok {
@@ -95,10 +96,25 @@ func comparePrimitiveValues(v1, v2 interface{}) (int, error) {
 			if t1.Before(t2) {
 				return -1, nil
 			}
-			return 0, nil
+			return 1, nil
 		}
 	case LocalTime:
 		if date, ok := v2.(LocalTime); ok {
+			return compareInt64(nanoOfDay(value1.Time()), nanoOfDay(date.Time())), nil
+		}
+	case Time:
+		// Times are compared after adjusting for their offsets
+		if tm, ok := v2.(Time); ok {
+			_, offset1 := value1.Time().Zone()
+			_, offset2 := tm.Time().Zone()
+			return compareInt64(nanoOfDay(value1.Time())-int64(offset1)*int64(time.Second), nanoOfDay(tm.Time())-int64(offset2)*int64(time.Second)), nil
+		}
+	case Point:
+		if p, ok := v2.(Point); ok {
+			return value1.compare(p), nil
+		}
+	case DateTime:
+		if date, ok := v2.(DateTime); ok {
 			t1 := value1.Time()
 			t2 := date.Time()
 			if t1.Equal(t2) {
@@ -107,7 +123,7 @@ func comparePrimitiveValues(v1, v2 interface{}) (int, error) {
 			if t1.Before(t2) {
 				return -1, nil
 			}
-			return 0, nil
+			return 1, nil
 		}
 	case LocalDateTime:
 		if date, ok := v2.(LocalDateTime); ok {
@@ -119,13 +135,143 @@ func comparePrimitiveValues(v1, v2 interface{}) (int, error) {
 			if t1.Before(t2) {
 				return -1, nil
 			}
-			return 0, nil
+			return 1, nil
 		}
 	}
 	return 0, ErrInvalidComparison
 
 }
 
+func compareInt64(n1, n2 int64) int {
+	if n1 == n2 {
+		return 0
+	}
+	if n1 < n2 {
+		return -1
+	}
+	return 1
+}
+
+// orderGroup returns the rank of the type of the value in the
+// openCypher global sort order. Values of different types are
+// ordered by their ranks. Null is ordered after all other values.
+func orderGroup(v interface{}) int {
+	switch v.(type) {
+	case map[string]Value:
+		return 0
+	case *lpg.Node:
+		return 1
+	case *lpg.Edge:
+		return 2
+	case []Value:
+		return 3
+	case *lpg.Path:
+		return 4
+	case Point:
+		return 5
+	case DateTime:
+		return 6
+	case LocalDateTime:
+		return 7
+	case Date:
+		return 8
+	case Time:
+		return 9
+	case LocalTime:
+		return 10
+	case Duration:
+		return 11
+	case string:
+		return 12
+	case bool:
+		return 13
+	case int, float64:
+		return 14
+	case nil:
+		return 16
+	}
+	return 15
+}
+
+// compareOrderable compares two values using the openCypher
+// orderability rules. Unlike comparePrimitiveValues, any two values
+// can be ordered: values of different types are ordered by type,
+// null is greater than all other values, and NaN is greater than
+// all other numbers.
+func compareOrderable(v1, v2 interface{}) int {
+	g1, g2 := orderGroup(v1), orderGroup(v2)
+	if g1 != g2 {
+		return g1 - g2
+	}
+	switch value1 := v1.(type) {
+	case nil:
+		return 0
+	case float64:
+		if math.IsNaN(value1) {
+			if f, ok := v2.(float64); ok && math.IsNaN(f) {
+				return 0
+			}
+			return 1
+		}
+		if f, ok := v2.(float64); ok && math.IsNaN(f) {
+			return -1
+		}
+	case int:
+		if f, ok := v2.(float64); ok && math.IsNaN(f) {
+			return -1
+		}
+	case *lpg.Node:
+		return value1.GetID() - v2.(*lpg.Node).GetID()
+	case *lpg.Edge:
+		return value1.GetID() - v2.(*lpg.Edge).GetID()
+	case []Value:
+		value2 := v2.([]Value)
+		for i := 0; i < len(value1) && i < len(value2); i++ {
+			if c := compareOrderable(value1[i].Get(), value2[i].Get()); c != 0 {
+				return c
+			}
+		}
+		return len(value1) - len(value2)
+	case *lpg.Path:
+		value2 := v2.(*lpg.Path)
+		if c := compareOrderable(value1.First(), value2.First()); c != 0 {
+			return c
+		}
+		for i := 0; i < value1.NumEdges() && i < value2.NumEdges(); i++ {
+			if c := compareOrderable(value1.GetEdge(i), value2.GetEdge(i)); c != 0 {
+				return c
+			}
+		}
+		return value1.NumEdges() - value2.NumEdges()
+	case map[string]Value:
+		value2 := v2.(map[string]Value)
+		keys1 := make([]string, 0, len(value1))
+		for k := range value1 {
+			keys1 = append(keys1, k)
+		}
+		keys2 := make([]string, 0, len(value2))
+		for k := range value2 {
+			keys2 = append(keys2, k)
+		}
+		sort.Strings(keys1)
+		sort.Strings(keys2)
+		for i := 0; i < len(keys1) && i < len(keys2); i++ {
+			if keys1[i] != keys2[i] {
+				if keys1[i] < keys2[i] {
+					return -1
+				}
+				return 1
+			}
+			if c := compareOrderable(value1[keys1[i]].Get(), value2[keys2[i]].Get()); c != 0 {
+				return c
+			}
+		}
+		return len(keys1) - len(keys2)
+	}
+	c, _ := comparePrimitiveValues(v1, v2)
+	return c
+}
+
 func (expr comparisonExpression) Evaluate(ctx *EvalContext) (Value, error) {
 	val, err := expr.first.Evaluate(ctx)
 	if err != nil {
@@ -144,6 +290,13 @@ func (expr comparisonExpression) Evaluate(ctx *EvalContext) (Value, error) {
 		if second.Get() == nil {
 			return RValue{}, nil
 		}
+		// Points and durations can only be compared for equality
+		switch val.Get().(type) {
+		case Point, Duration:
+			if expr.second[i].op != "=" && expr.second[i].op != "<>" {
+				return RValue{}, nil
+			}
+		}
 		result, err := comparePrimitiveValues(val.Get(), second.Get())
 		if err != nil {
 			return nil, err
@@ -0,0 +1,263 @@
+package opencypher
+
+import (
+	"strings"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+// shortestPath is a shortestPath or allShortestPaths invocation
+type shortestPath struct {
+	all     bool
+	pattern relationshipsPattern
+}
+
+// asShortestPath returns a shortestPath if the function invocation
+// is shortestPath(pattern) or allShortestPaths(pattern)
+func asShortestPath(f *functionInvocation) (shortestPath, bool) {
+	if len(f.name) != 1 || len(f.args) != 1 {
+		return shortestPath{}, false
+	}
+	ret := shortestPath{}
+	switch strings.ToLower(string(f.name[0])) {
+	case "shortestpath":
+	case "allshortestpaths":
+		ret.all = true
+	default:
+		return shortestPath{}, false
+	}
+	found := false
+	walkExpression(f.args[0], func(e Evaluatable) bool {
+		if rp, ok := e.(relationshipsPattern); ok && !found {
+			ret.pattern = rp
+			found = true
+		}
+		return !found
+	})
+	return ret, found
+}
+
+// getCandidates returns the nodes that can be used as the endpoints
+// of the shortest path. If the node pattern variable is defined, it
+// is the only candidate. Returns nil if it is bound to null.
+func (np nodePattern) getCandidates(ctx *EvalContext) ([]*lpg.Node, error) {
+	if np.variable != nil {
+		if v, err := ctx.GetVar(string(*np.variable)); err == nil {
+			if v.Get() == nil {
+				return nil, nil
+			}
+			node, ok := v.Get().(*lpg.Node)
+			if !ok {
+				return nil, ErrInvalidValueReferenceInPattern{Symbol: string(*np.variable)}
+			}
+			return []*lpg.Node{node}, nil
+		}
+	}
+	item, err := np.getPattern(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := lpg.GetNodeFilterFunc(item.Labels, item.Properties)
+	ret := make([]*lpg.Node, 0)
+	for nodes := ctx.graph.GetNodes(); nodes.Next(); {
+		if node := nodes.Node(); filter(node) {
+			ret = append(ret, node)
+		}
+	}
+	return ret, nil
+}
+
+// bfsNode keeps the BFS depth of a node, and the path elements
+// reaching to that node from the nodes at the previous depth
+type bfsNode struct {
+	depth int
+	preds []lpg.PathElement
+}
+
+// getEndpoints returns the candidate start and end nodes of the
+// pattern
+func (sp shortestPath) getEndpoints(ctx *EvalContext) ([]*lpg.Node, []*lpg.Node, error) {
+	if len(sp.pattern.chain) != 1 {
+		return nil, nil, ErrInvalidExpression("shortestPath requires a pattern with a single relationship")
+	}
+	sources, err := sp.pattern.start.getCandidates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	targets, err := sp.pattern.chain[0].node.getCandidates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sources, targets, nil
+}
+
+// Evaluate finds the shortest paths between the start and end nodes
+// of the pattern. Returns a path, or null for shortestPath, and a
+// list of paths for allShortestPaths.
+func (sp shortestPath) Evaluate(ctx *EvalContext) (Value, error) {
+	sources, targets, err := sp.getEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := sp.find(ctx, sources, targets)
+	if err != nil {
+		return nil, err
+	}
+	if !sp.all {
+		if len(paths) == 0 {
+			return RValue{}, nil
+		}
+		return RValue{Value: paths[0]}, nil
+	}
+	ret := make([]Value, 0, len(paths))
+	for _, path := range paths {
+		ret = append(ret, RValue{Value: path})
+	}
+	return RValue{Value: ret}, nil
+}
+
+// match finds the shortest paths between each pair of start and end
+// nodes of the pattern, and returns a row for each path. The rows
+// bind the node and relationship variables of the pattern, and
+// pathVar if it is not nil.
+func (sp shortestPath) match(ctx *EvalContext, pathVar *variable) ([]map[string]Value, error) {
+	sources, targets, err := sp.getEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]map[string]Value, 0)
+	for _, source := range sources {
+		for _, target := range targets {
+			paths, err := sp.find(ctx, []*lpg.Node{source}, []*lpg.Node{target})
+			if err != nil {
+				return nil, err
+			}
+			for _, path := range paths {
+				row := make(map[string]Value)
+				if v := sp.pattern.start.variable; v != nil {
+					row[string(*v)] = RValue{Value: source}
+				}
+				if v := sp.pattern.chain[0].rel.variable; v != nil {
+					row[string(*v)] = RValue{Value: path}
+				}
+				if v := sp.pattern.chain[0].node.variable; v != nil {
+					row[string(*v)] = RValue{Value: target}
+				}
+				if pathVar != nil {
+					row[string(*pathVar)] = RValue{Value: path}
+				}
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// find returns the shortest paths from the sources to the targets
+// using breadth-first search. Returns at most one path for
+// shortestPath.
+func (sp shortestPath) find(ctx *EvalContext, sources, targets []*lpg.Node) ([]*lpg.Path, error) {
+	if len(sources) == 0 || len(targets) == 0 {
+		return nil, nil
+	}
+	rel, err := sp.pattern.chain[0].rel.getPattern(ctx)
+	if err != nil {
+		return nil, err
+	}
+	minLen, maxLen := rel.Min, rel.Max
+	if minLen == -1 {
+		minLen = 1
+	}
+	if minLen > 1 {
+		return nil, ErrInvalidExpression("shortestPath minimum length must be 0 or 1")
+	}
+	edgeFilter := lpg.GetEdgeFilterFunc(rel.Labels, rel.Properties)
+
+	visited := make(map[*lpg.Node]*bfsNode)
+	frontier := make([]*lpg.Node, 0, len(sources))
+	for _, x := range sources {
+		if _, ok := visited[x]; !ok {
+			visited[x] = &bfsNode{}
+			frontier = append(frontier, x)
+		}
+	}
+	found := func(depth int) []*lpg.Node {
+		ret := make([]*lpg.Node, 0)
+		for _, x := range targets {
+			if n, ok := visited[x]; ok && n.depth == depth {
+				ret = append(ret, x)
+			}
+		}
+		return ret
+	}
+	var ends []*lpg.Node
+	if minLen == 0 {
+		ends = found(0)
+	}
+	for depth := 1; len(ends) == 0 && len(frontier) > 0 && (maxLen == -1 || depth <= maxLen); depth++ {
+		next := make([]*lpg.Node, 0)
+		visit := func(pe lpg.PathElement) {
+			if !edgeFilter(pe.Edge) {
+				return
+			}
+			target := pe.GetTargetNode()
+			if n, ok := visited[target]; ok {
+				if n.depth == depth {
+					n.preds = append(n.preds, pe)
+				}
+				return
+			}
+			visited[target] = &bfsNode{depth: depth, preds: []lpg.PathElement{pe}}
+			next = append(next, target)
+		}
+		for _, node := range frontier {
+			if !rel.ToLeft {
+				for edges := node.GetEdges(lpg.OutgoingEdge); edges.Next(); {
+					visit(lpg.PathElement{Edge: edges.Edge()})
+				}
+			}
+			if rel.ToLeft || rel.Undirected {
+				for edges := node.GetEdges(lpg.IncomingEdge); edges.Next(); {
+					visit(lpg.PathElement{Edge: edges.Edge(), Reverse: true})
+				}
+			}
+		}
+		frontier = next
+		ends = found(depth)
+	}
+	if len(ends) == 0 {
+		return nil, nil
+	}
+
+	// Build the paths backwards from the end nodes
+	var buildPaths func(*lpg.Node, []lpg.PathElement) []*lpg.Path
+	buildPaths = func(node *lpg.Node, suffix []lpg.PathElement) []*lpg.Path {
+		n := visited[node]
+		if n.depth == 0 {
+			if len(suffix) == 0 {
+				return []*lpg.Path{lpg.PathFromNode(node)}
+			}
+			elements := make([]lpg.PathElement, 0, len(suffix))
+			for i := len(suffix) - 1; i >= 0; i-- {
+				elements = append(elements, suffix[i])
+			}
+			return []*lpg.Path{lpg.NewPathFromElements(elements...)}
+		}
+		ret := make([]*lpg.Path, 0)
+		for _, pe := range n.preds {
+			ret = append(ret, buildPaths(pe.GetSourceNode(), append(suffix[:len(suffix):len(suffix)], pe))...)
+			if !sp.all {
+				break
+			}
+		}
+		return ret
+	}
+	if !sp.all {
+		return buildPaths(ends[0], nil), nil
+	}
+	ret := make([]*lpg.Path, 0)
+	for _, end := range ends {
+		ret = append(ret, buildPaths(end, nil)...)
+	}
+	return ret, nil
+}
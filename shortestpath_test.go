@@ -0,0 +1,126 @@
+package opencypher
+
+import (
+	"testing"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+// getNamedGraph returns a graph of nodes with the label and the
+// names, and edges given as {from, to, label}
+func getNamedGraph(label string, names []string, edges [][3]string) (*lpg.Graph, map[string]*lpg.Node) {
+	g := lpg.NewGraph()
+	nodes := make(map[string]*lpg.Node)
+	for _, name := range names {
+		nodes[name] = g.NewNode([]string{label}, map[string]interface{}{"name": name})
+	}
+	for _, e := range edges {
+		g.NewEdge(nodes[e[0]], nodes[e[1]], e[2], nil)
+	}
+	return g, nodes
+}
+
+// getDatasetGraph returns the graph
+//
+//	a -> b -> c -> d, a -> e -> d, d -> f, a -> f via LINK
+//
+// with all other edges labeled DEPENDS
+func getDatasetGraph() (*lpg.Graph, map[string]*lpg.Node) {
+	return getNamedGraph("Dataset", []string{"a", "b", "c", "d", "e", "f"}, [][3]string{{"a", "b", "DEPENDS"}, {"b", "c", "DEPENDS"}, {"c", "d", "DEPENDS"}, {"a", "e", "DEPENDS"}, {"e", "d", "DEPENDS"}, {"d", "f", "DEPENDS"}, {"a", "f", "LINK"}})
+}
+
+// pathNames returns the names of the nodes of the path
+func pathNames(v interface{}) []interface{} {
+	path := v.(*lpg.Path)
+	ret := make([]interface{}, 0)
+	for i := 0; i < path.NumNodes(); i++ {
+		name, _ := path.GetNode(i).GetProperty("name")
+		ret = append(ret, name)
+	}
+	return ret
+}
+
+func TestShortestPath(t *testing.T) {
+	g, nodes := getDatasetGraph()
+
+	rs := runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) RETURN shortestPath((a)-[:DEPENDS*]->(d)) AS p`, g)
+	if names := pathNames(rs.Rows[0]["p"].Get()); len(names) != 3 || names[1] != "e" {
+		t.Errorf("Wrong path: %v", names)
+	}
+
+	rs = runTestMatch(t, `MATCH (a {name:'a'}) RETURN length(shortestPath((a)-[*]->(:Dataset {name:'f'}))) AS l1, length(shortestPath((a)-[:DEPENDS*]->(:Dataset {name:'f'}))) AS l2`, g)
+	if rs.Rows[0]["l1"].Get() != 1 || rs.Rows[0]["l2"].Get() != 3 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// Direction and length bounds
+	rs = runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) RETURN shortestPath((d)-[*]->(a)) AS p1, shortestPath((d)<-[*]-(a)) AS p2, shortestPath((a)-[*..1]->(d)) AS p3, shortestPath((a)-[*]-(a)) AS p4, length(shortestPath((a)-[*0..]-(a))) AS p5`, g)
+	row := rs.Rows[0]
+	if row["p1"].Get() != nil || row["p3"].Get() != nil || row["p4"].Get() != nil || row["p5"].Get() != 0 {
+		t.Errorf("Wrong result: %v", row)
+	}
+	if names := pathNames(row["p2"].Get()); len(names) != 3 || names[0] != "d" || names[2] != "a" {
+		t.Errorf("Wrong path: %v", names)
+	}
+
+	rs = runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) RETURN allShortestPaths((a)-[:DEPENDS*]-(d)) AS p`, g)
+	if paths := rs.Rows[0]["p"].Get().([]Value); len(paths) != 1 {
+		t.Errorf("Wrong paths: %v", paths)
+	}
+	g.NewEdge(nodes["a"], nodes["c"], "DEPENDS", nil)
+	rs = runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) RETURN allShortestPaths((a)-[:DEPENDS*]-(d)) AS p`, g)
+	if paths := rs.Rows[0]["p"].Get().([]Value); len(paths) != 2 {
+		t.Errorf("Wrong paths: %v", paths)
+	}
+
+	if _, err := ParseAndEvaluate(`MATCH (a {name:'a'}), (d {name:'d'}) RETURN shortestPath((a)-[*2..]->(d))`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error")
+	}
+}
+
+func TestShortestPathInMatch(t *testing.T) {
+	g, nodes := getDatasetGraph()
+
+	rs := runTestMatch(t, `MATCH p = shortestPath((a {name:'a'})-[:DEPENDS*]->(d {name:'d'})) RETURN p AS p, a.name AS a, d.name AS d`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["a"].Get() != "a" || rs.Rows[0]["d"].Get() != "d" {
+		t.Fatalf("Wrong result: %v", rs)
+	}
+	if names := pathNames(rs.Rows[0]["p"].Get()); len(names) != 3 || names[1] != "e" {
+		t.Errorf("Wrong path: %v", names)
+	}
+
+	// Bound endpoints, relationship variables and WHERE
+	rs = runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) MATCH p = shortestPath((a)-[r*]->(d)) WHERE length(p) > 1 RETURN p AS p, r AS r`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["r"].Get().(*lpg.Path).NumEdges() != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	// One row for each pair of endpoints
+	rs = runTestMatch(t, `MATCH p = shortestPath((a {name:'a'})-[:DEPENDS*]->(x:Dataset)) RETURN x.name AS x, length(p) AS l`, g)
+	lengths := make(map[interface{}]interface{})
+	for _, row := range rs.Rows {
+		lengths[row["x"].Get()] = row["l"].Get()
+	}
+	if len(lengths) != 5 || lengths["b"] != 1 || lengths["d"] != 2 || lengths["f"] != 3 {
+		t.Errorf("Wrong result: %v", lengths)
+	}
+
+	g.NewEdge(nodes["a"], nodes["c"], "DEPENDS", nil)
+	rs = runTestMatch(t, `MATCH p = allShortestPaths((a {name:'a'})-[:DEPENDS*]->(d {name:'d'})) RETURN p AS p`, g)
+	if len(rs.Rows) != 2 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	rs = runTestMatch(t, `MATCH p = shortestPath((d {name:'d'})-[*]->(a {name:'a'})) RETURN p AS p`, g)
+	if len(rs.Rows) != 0 {
+		t.Errorf("Expecting no rows, got %v", rs)
+	}
+	rs = runTestMatch(t, `OPTIONAL MATCH p = shortestPath((d {name:'d'})-[*]->(a {name:'a'})) RETURN p AS p`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["p"].Get() != nil {
+		t.Errorf("Expecting null path, got %v", rs)
+	}
+
+	if _, err := ParseAndEvaluate(`CREATE shortestPath((a)-[:R]->(b))`, NewEvalContext(g)); err == nil {
+		t.Errorf("Expecting error")
+	}
+}
@@ -2,8 +2,11 @@ package opencypher
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 func init() {
@@ -43,6 +46,66 @@ func init() {
 		MaxArgs:   1,
 		ValueFunc: rtrimFunc,
 	}
+	globalFuncs["lTrim"] = Function{
+		Name:      "lTrim",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: ltrimFunc,
+	}
+	globalFuncs["rTrim"] = Function{
+		Name:      "rTrim",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: rtrimFunc,
+	}
+	globalFuncs["toUpper"] = Function{
+		Name:      "toUpper",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: toUpperFunc,
+	}
+	globalFuncs["toLower"] = Function{
+		Name:      "toLower",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: toLowerFunc,
+	}
+	globalFuncs["replace"] = Function{
+		Name:      "replace",
+		MinArgs:   3,
+		MaxArgs:   3,
+		ValueFunc: replaceFunc,
+	}
+	globalFuncs["reverse"] = Function{
+		Name:      "reverse",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: reverseFunc,
+	}
+	globalFuncs["toString"] = Function{
+		Name:      "toString",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: toStringFunc,
+	}
+	globalFuncs["toStringOrNull"] = Function{
+		Name:      "toStringOrNull",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: toStringOrNullFunc,
+	}
+	globalFuncs["toBoolean"] = Function{
+		Name:      "toBoolean",
+		MinArgs:   1,
+		MaxArgs:   1,
+		ValueFunc: toBooleanFunc,
+	}
+	globalFuncs["normalize"] = Function{
+		Name:      "normalize",
+		MinArgs:   1,
+		MaxArgs:   2,
+		ValueFunc: normalizeFunc,
+	}
 	globalFuncs["substring"] = Function{
 		Name:      "substring",
 		MinArgs:   2,
@@ -125,10 +188,14 @@ func rightFunc(ctx *EvalContext, args []Value) (Value, error) {
 	if err != nil {
 		return nil, fmt.Errorf("In right: %s", err)
 	}
-	if i < 0 || i > len(s) {
+	runes := []rune(s)
+	if i < 0 {
 		return RValue{Value: ""}, nil
 	}
-	return RValue{Value: s[i:]}, nil
+	if i > len(runes) {
+		return RValue{Value: s}, nil
+	}
+	return RValue{Value: string(runes[len(runes)-i:])}, nil
 }
 
 func leftFunc(ctx *EvalContext, args []Value) (Value, error) {
@@ -143,10 +210,14 @@ func leftFunc(ctx *EvalContext, args []Value) (Value, error) {
 	if err != nil {
 		return nil, fmt.Errorf("In left: %w", err)
 	}
-	if i < 0 || i > len(s) {
+	runes := []rune(s)
+	if i < 0 {
 		return RValue{Value: ""}, nil
 	}
-	return RValue{Value: s[:i]}, nil
+	if i > len(runes) {
+		return RValue{Value: s}, nil
+	}
+	return RValue{Value: string(runes[:i])}, nil
 }
 
 func substringFunc(ctx *EvalContext, args []Value) (Value, error) {
@@ -174,20 +245,176 @@ func substringFunc(ctx *EvalContext, args []Value) (Value, error) {
 			return RValue{}, nil
 		}
 	}
-	if start < 0 || start > len(s) {
+	runes := []rune(s)
+	if start < 0 || start > len(runes) {
 		return RValue{Value: ""}, nil
 	}
 	var end int
 	if max == -1 {
-		end = len(s)
+		end = len(runes)
 	} else {
 		end = start + max
 	}
 
-	if end > len(s) {
-		end = len(s)
+	if end > len(runes) {
+		end = len(runes)
+	}
+	return RValue{Value: string(runes[start:end])}, nil
+}
+
+func toUpperFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	str, err := ValueAsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In toUpper: %w", err)
+	}
+	return RValue{Value: strings.ToUpper(str)}, nil
+}
+
+func toLowerFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	str, err := ValueAsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In toLower: %w", err)
+	}
+	return RValue{Value: strings.ToLower(str)}, nil
+}
+
+func replaceFunc(ctx *EvalContext, args []Value) (Value, error) {
+	strs := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg.Get() == nil {
+			return RValue{}, nil
+		}
+		str, err := ValueAsString(arg)
+		if err != nil {
+			return nil, fmt.Errorf("In replace: %w", err)
+		}
+		strs = append(strs, str)
+	}
+	return RValue{Value: strings.ReplaceAll(strs[0], strs[1], strs[2])}, nil
+}
+
+// reverseFunc reverses a string or a list
+func reverseFunc(ctx *EvalContext, args []Value) (Value, error) {
+	switch val := args[0].Get().(type) {
+	case nil:
+		return RValue{}, nil
+	case string:
+		runes := []rune(val)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return RValue{Value: string(runes)}, nil
+	case []Value:
+		out := make([]Value, 0, len(val))
+		for i := len(val) - 1; i >= 0; i-- {
+			out = append(out, val[i])
+		}
+		return RValue{Value: out}, nil
+	}
+	return nil, fmt.Errorf("In reverse: Expecting a string or list: %v", args[0].Get())
+}
+
+// valueToString converts a primitive value to string. Returns false
+// if the value cannot be converted.
+func valueToString(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return val, true
+	case int:
+		return strconv.Itoa(val), true
+	case float64:
+		s := strconv.FormatFloat(val, 'f', -1, 64)
+		if !strings.ContainsAny(s, ".NI") {
+			s += ".0"
+		}
+		return s, true
+	case bool:
+		return strconv.FormatBool(val), true
+	case fmt.Stringer:
+		if IsValuePrimitive(RValue{Value: v}) {
+			return val.String(), true
+		}
+	}
+	return "", false
+}
+
+func toStringFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	str, ok := valueToString(args[0].Get())
+	if !ok {
+		return nil, fmt.Errorf("In toString: Cannot convert %T to string", args[0].Get())
+	}
+	return RValue{Value: str}, nil
+}
+
+func toStringOrNullFunc(ctx *EvalContext, args []Value) (Value, error) {
+	str, ok := valueToString(args[0].Get())
+	if !ok {
+		return RValue{}, nil
+	}
+	return RValue{Value: str}, nil
+}
+
+// toBooleanFunc converts strings "true" and "false", and integers to
+// boolean. Other strings return null.
+func toBooleanFunc(ctx *EvalContext, args []Value) (Value, error) {
+	switch val := args[0].Get().(type) {
+	case nil:
+		return RValue{}, nil
+	case bool:
+		return RValue{Value: val}, nil
+	case int:
+		return RValue{Value: val != 0}, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(val)) {
+		case "true":
+			return RValue{Value: true}, nil
+		case "false":
+			return RValue{Value: false}, nil
+		}
+		return RValue{}, nil
+	}
+	return nil, fmt.Errorf("In toBoolean: Cannot convert %T to boolean", args[0].Get())
+}
+
+// normalizeFunc normalizes a string using one of the Unicode
+// normalization forms NFC, NFD, NFKC, or NFKD. Default is NFC.
+func normalizeFunc(ctx *EvalContext, args []Value) (Value, error) {
+	if args[0].Get() == nil {
+		return RValue{}, nil
+	}
+	str, err := ValueAsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("In normalize: %w", err)
+	}
+	form := "NFC"
+	if len(args) == 2 {
+		if args[1].Get() == nil {
+			return RValue{}, nil
+		}
+		if form, err = ValueAsString(args[1]); err != nil {
+			return nil, fmt.Errorf("In normalize: %w", err)
+		}
 	}
-	return RValue{Value: s[start:end]}, nil
+	switch strings.ToUpper(form) {
+	case "NFC":
+		return RValue{Value: norm.NFC.String(str)}, nil
+	case "NFD":
+		return RValue{Value: norm.NFD.String(str)}, nil
+	case "NFKC":
+		return RValue{Value: norm.NFKC.String(str)}, nil
+	case "NFKD":
+		return RValue{Value: norm.NFKD.String(str)}, nil
+	}
+	return nil, fmt.Errorf("In normalize: Unknown normalization form: %s", form)
 }
 
 func printFunc(ctx *EvalContext, args []Value) (Value, error) {
@@ -150,6 +150,7 @@ func (d deleteClause) Update(ctx *EvalContext, result ResultSet) (Value, error)
 						return nil, fmt.Errorf("Cannot delete attached node")
 					}
 				}
+				removeFromIndexes(item)
 				item.DetachAndRemove()
 
 			case *lpg.Path:
@@ -214,13 +215,36 @@ func (c create) TopLevelUpdate(ctx *EvalContext) (Value, error) {
 }
 
 func (c create) Update(ctx *EvalContext, result ResultSet) (Value, error) {
+	// Each row creates its own nodes and edges. The variables
+	// created for a row are added to that row
+	ret := *NewResultSet()
+	var rowCtx *EvalContext
 	for _, row := range result.Rows {
-		ctx.SetVars(row)
-		if _, err := c.TopLevelUpdate(ctx); err != nil {
+		rowCtx = ctx.SubContext()
+		rowCtx.SetVars(row)
+		if _, err := c.TopLevelUpdate(rowCtx); err != nil {
 			return nil, err
 		}
+		newRow := make(map[string]Value, len(row))
+		for k, v := range row {
+			newRow[k] = v
+		}
+		for k, v := range rowCtx.GetVarsNearestScope() {
+			newRow[k] = v
+		}
+		ret.Append(newRow)
+	}
+	ret.Cols = result.Cols
+	if rowCtx != nil {
+		vars := rowCtx.GetVarsNearestScope()
+		for _, k := range sortedKeys(vars) {
+			if _, ok := result.Rows[0][k]; !ok {
+				ret.Cols = append(ret.Cols, k)
+			}
+		}
+		ctx.SetVars(vars)
 	}
-	return RValue{Value: result}, nil
+	return RValue{Value: ret}, nil
 }
 
 func (np nodePattern) Create(ctx *EvalContext) (string, *lpg.Node, error) {
@@ -271,6 +295,9 @@ func (np nodePattern) createNode(ctx *EvalContext) (*lpg.Node, error) {
 }
 
 func (part PatternPart) Create(ctx *EvalContext) (*lpg.Node, *lpg.Path, error) {
+	if part.shortest != nil {
+		return nil, nil, ErrShortestPathNotAllowed
+	}
 	_, lastNode, err := part.start.Create(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -344,6 +371,9 @@ func (rel relationshipPattern) Create(ctx *EvalContext, from, to *lpg.Node) (lpg
 }
 
 func (m merge) getResults(ctx *EvalContext) (map[string]struct{}, ResultSet, error) {
+	if m.pattern.shortest != nil {
+		return nil, *NewResultSet(), ErrShortestPathNotAllowed
+	}
 	pattern, err := m.pattern.getPattern(ctx)
 	if err != nil {
 		return nil, *NewResultSet(), err
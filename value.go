@@ -3,6 +3,7 @@ package opencypher
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cloudprivacylabs/lpg/v2"
 )
@@ -16,8 +17,11 @@ import (
 //    string
 //    Duration
 //    Date
+//    DateTime
 //    LocalDateTime
+//    Time
 //    LocalTime
+//    Point
 //
 //  composites:
 //    []Value
@@ -75,7 +79,7 @@ func (v RValue) Evaluate(*EvalContext) (Value, error) { return v, nil }
 // string, duration, date, datetime, localDateTime, or localTime
 func IsValuePrimitive(v Value) bool {
 	switch v.Get().(type) {
-	case int, float64, bool, string, Duration, Date, LocalDateTime, LocalTime:
+	case int, float64, bool, string, Duration, Date, DateTime, LocalDateTime, Time, LocalTime, Point:
 		return true
 	}
 	return false
@@ -124,10 +128,18 @@ func ValueOf(in interface{}) Value {
 		return RValue{Value: v}
 	case Date:
 		return RValue{Value: v}
+	case DateTime:
+		return RValue{Value: v}
+	case time.Time:
+		return RValue{Value: DateTime(v)}
 	case LocalDateTime:
 		return RValue{Value: v}
+	case Time:
+		return RValue{Value: v}
 	case LocalTime:
 		return RValue{Value: v}
+	case Point:
+		return RValue{Value: v}
 	case *lpg.Node:
 		return RValue{Value: v}
 	case []*lpg.Edge:
@@ -136,6 +148,36 @@ func ValueOf(in interface{}) Value {
 		return RValue{Value: v}
 	case map[string]Value:
 		return RValue{Value: v}
+	case []float64:
+		arr := make([]Value, 0, len(v))
+		for _, x := range v {
+			arr = append(arr, RValue{Value: x})
+		}
+		return RValue{Value: arr}
+	case []int:
+		arr := make([]Value, 0, len(v))
+		for _, x := range v {
+			arr = append(arr, RValue{Value: x})
+		}
+		return RValue{Value: arr}
+	case []string:
+		arr := make([]Value, 0, len(v))
+		for _, x := range v {
+			arr = append(arr, RValue{Value: x})
+		}
+		return RValue{Value: arr}
+	case []interface{}:
+		arr := make([]Value, 0, len(v))
+		for _, x := range v {
+			arr = append(arr, ValueOf(x))
+		}
+		return RValue{Value: arr}
+	case map[string]interface{}:
+		m := make(map[string]Value, len(v))
+		for k, x := range v {
+			m[k] = ValueOf(x)
+		}
+		return RValue{Value: m}
 	case lpg.StringSet:
 		return RValue{Value: v}
 	case lpg.PathElement:
@@ -148,10 +190,13 @@ func ValueOf(in interface{}) Value {
 
 // IsValueSame compares two values and decides if the two are the same
 func IsValueSame(v, v2 Value) bool {
+	if v.Get() == nil || v2.Get() == nil {
+		return v.Get() == nil && v2.Get() == nil
+	}
 	if IsValuePrimitive(v) {
 		if IsValuePrimitive(v2) {
 			eq, err := comparePrimitiveValues(v.Get(), v2.Get())
-			return err != nil && eq == 0
+			return err == nil && eq == 0
 		}
 		return false
 	}
@@ -214,15 +259,18 @@ func IsValueSame(v, v2 Value) bool {
 		return val1 == val2
 
 	case *lpg.Path:
-		val2, ok := v2.Get().([]*lpg.Path)
+		val2, ok := v2.Get().(*lpg.Path)
 		if !ok {
 			return false
 		}
-		if val1.NumEdges() != val1.NumEdges() {
+		if val1.NumNodes() != val2.NumNodes() {
 			return false
 		}
+		if val1.NumEdges() == 0 {
+			return val1.First() == val2.First()
+		}
 		for i := 0; i < val1.NumEdges(); i++ {
-			if val1.Slice(i, -1) != val2[i] {
+			if val1.GetEdge(i) != val2.GetEdge(i) {
 				return false
 			}
 		}
@@ -254,7 +302,7 @@ func (v RValue) String() string {
 // ValueAsInt returns the int value. If value is not int, returns
 // ErrIntValueRequired. If an err argument is given, that error is returned.
 func ValueAsInt(v Value, err ...error) (int, error) {
-	if len(err) != 0 {
+	if len(err) != 0 && err[0] != nil {
 		return 0, err[0]
 	}
 	i, ok := v.Get().(int)
@@ -267,7 +315,7 @@ func ValueAsInt(v Value, err ...error) (int, error) {
 // ValueAsString returns the string value. If value is not string,
 // rReturns ErrStringValueRequired. If an err argument is given, that error is returned.
 func ValueAsString(v Value, err ...error) (string, error) {
-	if len(err) != 0 {
+	if len(err) != 0 && err[0] != nil {
 		return "", err[0]
 	}
 	s, ok := v.Get().(string)
@@ -276,3 +324,14 @@ func ValueAsString(v Value, err ...error) (string, error) {
 	}
 	return s, nil
 }
+
+// valueComponent returns the named component of a temporal value, a
+// duration, or a point. The second return value is false if the value
+// does not have components.
+func valueComponent(v interface{}, name string) (Value, bool, error) {
+	if p, ok := v.(Point); ok {
+		ret, err := p.component(name)
+		return ret, true, err
+	}
+	return temporalComponent(v, name)
+}
@@ -0,0 +1,82 @@
+package opencypher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func TestIsValueSame(t *testing.T) {
+	g := lpg.NewGraph()
+	n1 := g.NewNode(nil, nil)
+	n2 := g.NewNode(nil, nil)
+	n3 := g.NewNode(nil, nil)
+	e1 := g.NewEdge(n1, n2, "e", nil)
+	e2 := g.NewEdge(n2, n3, "e", nil)
+	e3 := g.NewEdge(n1, n2, "e", nil)
+	path := func(edges ...*lpg.Edge) *lpg.Path {
+		return lpg.NewPathFromElements(lpg.NewPathElementsFromEdges(edges)...)
+	}
+
+	tests := []struct {
+		v1, v2 interface{}
+		same   bool
+	}{
+		{nil, nil, true},
+		{nil, 1, false},
+		{1, nil, false},
+		{1, 1, true},
+		{1, 2, false},
+		{"a", "a", true},
+		{path(e1, e2), path(e1, e2), true},
+		{path(e1, e2), path(e3, e2), false},
+		{path(e1), path(e1, e2), false},
+		{lpg.PathFromNode(n1), lpg.PathFromNode(n1), true},
+		{lpg.PathFromNode(n1), lpg.PathFromNode(n2), false},
+	}
+	for _, test := range tests {
+		if IsValueSame(RValue{Value: test.v1}, RValue{Value: test.v2}) != test.same {
+			t.Errorf("IsValueSame(%v, %v) should be %v", test.v1, test.v2, test.same)
+		}
+	}
+}
+
+func TestValueAsIntAndString(t *testing.T) {
+	if i, err := ValueAsInt(RValue{Value: 1}, nil); err != nil || i != 1 {
+		t.Errorf("Expecting 1, got %v %v", i, err)
+	}
+	if _, err := ValueAsInt(RValue{Value: 1}, ErrInvalidListIndex); err != ErrInvalidListIndex {
+		t.Errorf("Expecting the given error, got %v", err)
+	}
+	if _, err := ValueAsInt(RValue{Value: "a"}); err != ErrIntValueRequired {
+		t.Errorf("Expecting ErrIntValueRequired, got %v", err)
+	}
+	if s, err := ValueAsString(RValue{Value: "a"}, nil); err != nil || s != "a" {
+		t.Errorf("Expecting a, got %v %v", s, err)
+	}
+	if _, err := ValueAsString(RValue{Value: "a"}, ErrInvalidListIndex); err != ErrInvalidListIndex {
+		t.Errorf("Expecting the given error, got %v", err)
+	}
+}
+
+func TestCompareTemporalValues(t *testing.T) {
+	t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(24 * time.Hour)
+	pairs := [][2]interface{}{
+		{NewDate(t1), NewDate(t2)},
+		{NewLocalTime(t1), NewLocalTime(t1.Add(time.Hour))},
+		{NewLocalDateTime(t1), NewLocalDateTime(t2)},
+	}
+	for _, pair := range pairs {
+		if c, err := comparePrimitiveValues(pair[0], pair[1]); err != nil || c != -1 {
+			t.Errorf("%v < %v: got %d %v", pair[0], pair[1], c, err)
+		}
+		if c, err := comparePrimitiveValues(pair[1], pair[0]); err != nil || c != 1 {
+			t.Errorf("%v > %v: got %d %v", pair[1], pair[0], c, err)
+		}
+		if c, err := comparePrimitiveValues(pair[0], pair[0]); err != nil || c != 0 {
+			t.Errorf("%v = %v: got %d %v", pair[0], pair[0], c, err)
+		}
+	}
+}
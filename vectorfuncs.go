@@ -0,0 +1,370 @@
+package opencypher
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func init() {
+	RegisterGlobalFunc(
+		Function{
+			Name:      "vector.cosineSimilarity",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: vectorFunc("vector.cosineSimilarity", cosineSimilarity),
+		},
+		Function{
+			Name:      "vector.euclideanDistance",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: vectorFunc("vector.euclideanDistance", euclideanDistance),
+		},
+		Function{
+			Name:      "vector.dotProduct",
+			MinArgs:   2,
+			MaxArgs:   2,
+			ValueFunc: vectorFunc("vector.dotProduct", dotProduct),
+		},
+	)
+	RegisterGlobalProcedure(
+		Procedure{
+			Name:    "vector.topK",
+			MinArgs: 4,
+			MaxArgs: 5,
+			Args:    []string{"label", "property", "vector", "k", "config"},
+			Outputs: []string{"node", "score"},
+			Func:    vectorTopKProc,
+		},
+		Procedure{
+			Name:    "vector.createIndex",
+			MinArgs: 3,
+			MaxArgs: 3,
+			Args:    []string{"name", "label", "property"},
+			Outputs: []string{"name", "nodeCount"},
+			Func:    vectorCreateIndexProc,
+		},
+		Procedure{
+			Name:    "vector.queryIndex",
+			MinArgs: 3,
+			MaxArgs: 4,
+			Args:    []string{"name", "vector", "k", "config"},
+			Outputs: []string{"node", "score"},
+			Func:    vectorQueryIndexProc,
+		},
+	)
+}
+
+// valueAsVector returns the numeric list value as a vector. Property
+// values can also be []float64, []float32, or []int.
+func valueAsVector(v Value) ([]float64, error) {
+	switch val := v.Get().(type) {
+	case []float64:
+		return val, nil
+	case []float32:
+		ret := make([]float64, 0, len(val))
+		for _, x := range val {
+			ret = append(ret, float64(x))
+		}
+		return ret, nil
+	case []int:
+		ret := make([]float64, 0, len(val))
+		for _, x := range val {
+			ret = append(ret, float64(x))
+		}
+		return ret, nil
+	case []Value:
+		ret := make([]float64, 0, len(val))
+		for _, x := range val {
+			f, err := valueAsFloat(x)
+			if err != nil {
+				return nil, fmt.Errorf("Vector must be a list of numbers: %w", err)
+			}
+			ret = append(ret, f)
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("Vector must be a list of numbers: %v", v.Get())
+}
+
+// vectorSimilarity computes a score for two vectors of the same
+// dimension. The second return value is false if the score is
+// undefined.
+type vectorSimilarity func(a, b []float64) (float64, bool)
+
+func dotProduct(a, b []float64) (float64, bool) {
+	ret := 0.0
+	for i := range a {
+		ret += a[i] * b[i]
+	}
+	return ret, true
+}
+
+// cosineSimilarity is undefined if one of the vectors is zero
+func cosineSimilarity(a, b []float64) (float64, bool) {
+	dot, _ := dotProduct(a, b)
+	na, _ := dotProduct(a, a)
+	nb, _ := dotProduct(b, b)
+	if na == 0 || nb == 0 {
+		return 0, false
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb)), true
+}
+
+func euclideanDistance(a, b []float64) (float64, bool) {
+	ret := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		ret += d * d
+	}
+	return math.Sqrt(ret), true
+}
+
+// vectorFunc returns a function that computes the similarity of its
+// two vector arguments. It returns null if an argument is null, or
+// if the similarity is undefined.
+func vectorFunc(fname string, sim vectorSimilarity) func(*EvalContext, []Value) (Value, error) {
+	return func(ctx *EvalContext, args []Value) (Value, error) {
+		if args[0].Get() == nil || args[1].Get() == nil {
+			return RValue{}, nil
+		}
+		a, err := valueAsVector(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("In %s: %w", fname, err)
+		}
+		b, err := valueAsVector(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("In %s: %w", fname, err)
+		}
+		if len(a) != len(b) {
+			return nil, fmt.Errorf("In %s: Vector dimensions differ: %d and %d", fname, len(a), len(b))
+		}
+		ret, ok := sim(a, b)
+		if !ok {
+			return RValue{}, nil
+		}
+		return RValue{Value: ret}, nil
+	}
+}
+
+// vectorConfig is the configuration map of vector.topK and
+// vector.queryIndex:
+//
+//	similarity: cosine, euclidean, or dot. Default is cosine. For
+//	  euclidean, the score is the distance, and the nodes closest to
+//	  the vector are returned.
+type vectorConfig struct {
+	similarity vectorSimilarity
+	// ascending is true if lower scores are better
+	ascending bool
+}
+
+func parseVectorConfig(v Value) (vectorConfig, error) {
+	ret := vectorConfig{similarity: cosineSimilarity}
+	err := parseConfig(v, map[string]func(Value) error{
+		"similarity": func(val Value) error {
+			s, err := ValueAsString(val)
+			if err != nil {
+				return err
+			}
+			switch s {
+			case "cosine":
+				ret.similarity, ret.ascending = cosineSimilarity, false
+			case "euclidean":
+				ret.similarity, ret.ascending = euclideanDistance, true
+			case "dot":
+				ret.similarity, ret.ascending = dotProduct, false
+			default:
+				return fmt.Errorf("Invalid similarity: %s", s)
+			}
+			return nil
+		},
+	})
+	return ret, err
+}
+
+// vectorIndex is an in-memory index of the vectors stored in a node
+// property
+type vectorIndex struct {
+	label     string
+	property  string
+	dimension int
+	nodes     []*lpg.Node
+	vectors   [][]float64
+	// deleted are the indexed nodes deleted after the index is
+	// created
+	deleted map[*lpg.Node]struct{}
+}
+
+// removeNode marks the node deleted if it has the label of the index
+func (index *vectorIndex) removeNode(node *lpg.Node) {
+	if !node.HasLabel(index.label) {
+		return
+	}
+	if index.deleted == nil {
+		index.deleted = make(map[*lpg.Node]struct{})
+	}
+	index.deleted[node] = struct{}{}
+}
+
+// nodeVectors returns the nodes with the label that have the vector
+// property, and their vectors. All vectors must have the same
+// dimension.
+func nodeVectors(g *lpg.Graph, label, property string) (*vectorIndex, error) {
+	ret := &vectorIndex{label: label, property: property}
+	for nodes := g.GetNodesWithAllLabels(lpg.NewStringSet(label)); nodes.Next(); {
+		node := nodes.Node()
+		prop, ok := node.GetProperty(property)
+		if !ok || prop == nil {
+			continue
+		}
+		vec, err := valueAsVector(propertyValue(prop))
+		if err != nil {
+			return nil, fmt.Errorf("Node %d: %w", node.GetID(), err)
+		}
+		if len(ret.vectors) == 0 {
+			ret.dimension = len(vec)
+		} else if len(vec) != ret.dimension {
+			return nil, fmt.Errorf("Node %d: Vector dimensions differ: %d and %d", node.GetID(), ret.dimension, len(vec))
+		}
+		ret.nodes = append(ret.nodes, node)
+		ret.vectors = append(ret.vectors, vec)
+	}
+	return ret, nil
+}
+
+// topK emits the k nodes whose vectors score best against the query
+// vector. Ties are broken by node id.
+func (index *vectorIndex) topK(query []float64, k int, cfg vectorConfig, emit func(map[string]Value) error) error {
+	if len(index.vectors) > 0 && len(query) != index.dimension {
+		return fmt.Errorf("Vector dimensions differ: %d and %d", index.dimension, len(query))
+	}
+	type result struct {
+		node  *lpg.Node
+		score float64
+	}
+	results := make([]result, 0, len(index.nodes))
+	for i, vec := range index.vectors {
+		if _, ok := index.deleted[index.nodes[i]]; ok {
+			continue
+		}
+		score, ok := cfg.similarity(query, vec)
+		if !ok {
+			continue
+		}
+		results = append(results, result{node: index.nodes[i], score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return (results[i].score < results[j].score) == cfg.ascending
+		}
+		return results[i].node.GetID() < results[j].node.GetID()
+	})
+	if k < len(results) {
+		results = results[:k]
+	}
+	for _, r := range results {
+		if err := emit(map[string]Value{
+			"node":  RValue{Value: r.node},
+			"score": RValue{Value: r.score},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vectorQueryArgs returns the query vector, k, and the config. The
+// second return value is false if the vector or k is null.
+func vectorQueryArgs(args []Value) ([]float64, int, vectorConfig, bool, error) {
+	var cfgValue Value
+	if len(args) > 2 {
+		cfgValue = args[2]
+	}
+	cfg, err := parseVectorConfig(cfgValue)
+	if err != nil {
+		return nil, 0, cfg, false, err
+	}
+	if args[0].Get() == nil || args[1].Get() == nil {
+		return nil, 0, cfg, false, nil
+	}
+	query, err := valueAsVector(args[0])
+	if err != nil {
+		return nil, 0, cfg, false, err
+	}
+	k, err := ValueAsInt(args[1])
+	if err != nil {
+		return nil, 0, cfg, false, err
+	}
+	if k < 0 {
+		return nil, 0, cfg, false, fmt.Errorf("k must be non-negative: %d", k)
+	}
+	return query, k, cfg, true, nil
+}
+
+// vectorTopKProc returns the k nodes with the label whose vector
+// property is most similar to the given vector by scanning all such
+// nodes
+func vectorTopKProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	label, err := ValueAsString(args[0])
+	if err != nil {
+		return fmt.Errorf("label: %w", err)
+	}
+	property, err := ValueAsString(args[1])
+	if err != nil {
+		return fmt.Errorf("property: %w", err)
+	}
+	query, k, cfg, ok, err := vectorQueryArgs(args[2:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	index, err := nodeVectors(ctx.graph, label, property)
+	if err != nil {
+		return err
+	}
+	return index.topK(query, k, cfg, emit)
+}
+
+// vectorCreateIndexProc creates a named in-memory index of the vectors
+// of the nodes with the label
+func vectorCreateIndexProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	strs := make([]string, 0, len(args))
+	for i, arg := range args {
+		s, err := ValueAsString(arg)
+		if err != nil {
+			return fmt.Errorf("%s: %w", []string{"name", "label", "property"}[i], err)
+		}
+		strs = append(strs, s)
+	}
+	index, err := nodeVectors(ctx.graph, strs[1], strs[2])
+	if err != nil {
+		return err
+	}
+	return createIndex(ctx, strs[0], index, len(index.nodes), emit)
+}
+
+// vectorQueryIndexProc returns the k nodes of a vector index that are
+// most similar to the given vector
+func vectorQueryIndexProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	name, x, err := lookupIndex(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	index, ok := x.(*vectorIndex)
+	if !ok {
+		return fmt.Errorf("Vector index not found: %s", name)
+	}
+	query, k, cfg, ok, err := vectorQueryArgs(args[1:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	return index.topK(query, k, cfg, emit)
+}
@@ -0,0 +1,121 @@
+package opencypher
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+// getVectorGraph returns a graph of documents with 2D embeddings
+func getVectorGraph() *lpg.Graph {
+	g := lpg.NewGraph()
+	for _, doc := range []struct {
+		name      string
+		embedding interface{}
+	}{
+		{"a", []float64{1, 0}},
+		{"b", []float64{0.9, 0.1}},
+		{"c", []float64{0, 1}},
+		{"d", []interface{}{-1, 0}},
+		{"e", nil},
+	} {
+		props := map[string]interface{}{"name": doc.name}
+		if doc.embedding != nil {
+			props["embedding"] = doc.embedding
+		}
+		g.NewNode([]string{"Doc"}, props)
+	}
+	g.NewNode([]string{"Other"}, map[string]interface{}{"name": "x", "embedding": []float64{1, 0}})
+	return g
+}
+
+// columnValues returns the values of a column of the result set
+func columnValues(rs ResultSet, column string) []interface{} {
+	ret := make([]interface{}, 0, len(rs.Rows))
+	for _, row := range rs.Rows {
+		ret = append(ret, row[column].Get())
+	}
+	return ret
+}
+
+func TestVectorFunctions(t *testing.T) {
+	evalExprs(t, map[string]interface{}{
+		`vector.cosineSimilarity([1, 0], [2, 0])`:  1.0,
+		`vector.cosineSimilarity([1, 0], [0, 3])`:  0.0,
+		`vector.cosineSimilarity([1, 0], [0, 0])`:  nil,
+		`vector.cosineSimilarity(null, [0, 0])`:    nil,
+		`vector.euclideanDistance([0, 0], [3, 4])`: 5.0,
+		`vector.dotProduct([1, 2, 3], [4, 5, 6])`:  32.0,
+	})
+	for _, expr := range []string{
+		`vector.dotProduct([1, 2], [1, 2, 3])`,
+		`vector.dotProduct([1, 'a'], [1, 2])`,
+		`vector.dotProduct(1, [1, 2])`,
+	} {
+		if _, err := ParseAndEvaluate("RETURN "+expr+" AS x", NewEvalContext(lpg.NewGraph())); err == nil {
+			t.Errorf("%s: Expecting error", expr)
+		}
+	}
+}
+
+func TestVectorTopK(t *testing.T) {
+	g := getVectorGraph()
+	ctx := NewEvalContext(g)
+	for query, expected := range map[string][]interface{}{
+		`CALL vector.topK('Doc', 'embedding', [1, 0], 2) YIELD node RETURN node.name AS name`:                            {"a", "b"},
+		`CALL vector.topK('Doc', 'embedding', [1, 0], 10) YIELD node RETURN node.name AS name`:                           {"a", "b", "c", "d"},
+		`CALL vector.topK('Doc', 'embedding', [0, 2], 1, {similarity: 'euclidean'}) YIELD node RETURN node.name AS name`: {"c"},
+		`CALL vector.topK('Doc', 'embedding', [2, 0], 3, {similarity: 'dot'}) YIELD node RETURN node.name AS name`:       {"a", "b", "c"},
+		`CALL vector.topK('Doc', 'embedding', [1, 0], 2) YIELD node, score WHERE score < 1 RETURN node.name AS name`:     {"b"},
+	} {
+		if got := columnValues(runTestMatch(t, query, g), "name"); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v, got %v", query, expected, got)
+		}
+	}
+	for _, query := range []string{
+		`CALL vector.topK('Doc', 'embedding', [1, 0, 0], 2) YIELD node RETURN node`,
+		`CALL vector.topK('Doc', 'embedding', [1, 0], 2, {similarity: 'manhattan'}) YIELD node RETURN node`,
+		`CALL vector.topK('Doc', 'name', [1, 0], 2) YIELD node RETURN node`,
+	} {
+		if _, err := ParseAndEvaluate(query, ctx); err == nil {
+			t.Errorf("%s: Expecting error", query)
+		}
+	}
+}
+
+func TestVectorIndex(t *testing.T) {
+	g := getVectorGraph()
+	ctx := NewEvalContext(g)
+	v, err := ParseAndEvaluate(`CALL vector.createIndex('docs', 'Doc', 'embedding') YIELD nodeCount RETURN nodeCount AS nodeCount`, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := v.Get().(ResultSet).Rows[0]["nodeCount"].Get(); n != 4 {
+		t.Errorf("Expecting 4 indexed nodes, got %v", n)
+	}
+	// The index does not see nodes added after it is created
+	g.NewNode([]string{"Doc"}, map[string]interface{}{"name": "f", "embedding": []float64{1, 0}})
+	query := `CALL vector.queryIndex('docs', [1, 0], 2) YIELD node RETURN node.name AS name`
+	if got := columnValues(runTestMatch(t, query, g), "name"); !reflect.DeepEqual(got, []interface{}{"a", "b"}) {
+		t.Errorf("%s: got %v", query, got)
+	}
+	// The index belongs to the graph, and skips deleted nodes
+	ctx = NewEvalContext(g)
+	if _, err := ParseAndEvaluate(`MATCH (n:Doc {name: 'a'}) DETACH DELETE n`, ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := columnValues(runTestMatch(t, query, g), "name"); !reflect.DeepEqual(got, []interface{}{"b", "c"}) {
+		t.Errorf("%s: got %v", query, got)
+	}
+	if _, err := ParseAndEvaluate(query, NewEvalContext(getVectorGraph())); err == nil {
+		t.Errorf("Expecting error for the index of another graph")
+	}
+	query = `CALL vector.topK('Doc', 'embedding', [1, 0], 2) YIELD node RETURN node.name AS name`
+	if got := columnValues(runTestMatch(t, query, g), "name"); !reflect.DeepEqual(got, []interface{}{"f", "b"}) {
+		t.Errorf("%s: got %v", query, got)
+	}
+	if _, err := ParseAndEvaluate(`CALL vector.queryIndex('missing', [1, 0], 2) YIELD node RETURN node`, ctx); err == nil {
+		t.Errorf("Expecting error for missing index")
+	}
+}
@@ -0,0 +1,116 @@
+package opencypher
+
+// walkExpression calls f for expr and the expressions nested in
+// it. If f returns false, the expressions nested under that
+// expression are skipped. Pattern comprehensions are not descended
+// into.
+func walkExpression(expr Evaluatable, f func(Evaluatable) bool) {
+	if expr == nil {
+		return
+	}
+	if !f(expr) {
+		return
+	}
+	walkAll := func(exprs ...Evaluatable) {
+		for _, x := range exprs {
+			walkExpression(x, f)
+		}
+	}
+	switch e := expr.(type) {
+	case orExpression:
+		walkAll(e.parts...)
+	case xorExpression:
+		walkAll(e.parts...)
+	case andExpression:
+		walkAll(e.parts...)
+	case notExpression:
+		walkAll(e.part)
+	case comparisonExpression:
+		walkAll(e.first)
+		for _, x := range e.second {
+			walkAll(x.expr)
+		}
+	case *addOrSubtractExpression:
+		for _, x := range e.add {
+			walkAll(x)
+		}
+		for _, x := range e.sub {
+			walkAll(x)
+		}
+	case *multiplyDivideModuloExpression:
+		for _, x := range e.parts {
+			walkAll(x.expr)
+		}
+	case *powerOfExpression:
+		walkAll(e.parts...)
+	case *unaryAddOrSubtractExpression:
+		walkAll(e.expr)
+	case stringListNullOperatorExpression:
+		walkAll(e.propertyOrLabels)
+		for _, part := range e.parts {
+			if part.stringOp != nil {
+				walkAll(part.stringOp.expr)
+			}
+			if part.listIn != nil {
+				walkAll(part.listIn)
+			}
+			if part.listIndex != nil {
+				walkAll(part.listIndex)
+			}
+			if part.listRange != nil {
+				walkAll(part.listRange.first, part.listRange.second)
+			}
+		}
+	case propertyOrLabelsExpression:
+		walkAll(e.atom)
+	case caseClause:
+		if e.test != nil {
+			walkAll(e.test)
+		}
+		for _, alt := range e.alternatives {
+			walkAll(alt.when, alt.then)
+		}
+		if e.def != nil {
+			walkAll(e.def)
+		}
+	case *functionInvocation:
+		for _, x := range e.args {
+			walkAll(x)
+		}
+	case listComprehension:
+		walkFilterExpression(e.filter, f)
+		if e.expr != nil {
+			walkAll(e.expr)
+		}
+	case reduceExpression:
+		walkAll(e.init)
+		walkFilterExpression(e.filter, f)
+		walkAll(e.expr)
+	case filterAtom:
+		walkFilterExpression(e.filter, f)
+	case *listLiteral:
+		for _, x := range e.values {
+			walkAll(x)
+		}
+	case *mapLiteral:
+		for _, x := range e.keyValues {
+			walkAll(x.value)
+		}
+	case mapProjection:
+		walkAll(e.variable)
+		for _, x := range e.items {
+			if x.value != nil {
+				walkAll(x.value)
+			}
+		}
+	}
+}
+
+func walkFilterExpression(flt filterExpression, f func(Evaluatable) bool) {
+	if flt.inExpr != nil {
+		walkExpression(flt.inExpr, f)
+	}
+	if flt.where != nil {
+		walkExpression(flt.where, f)
+	}
+}
@@ -0,0 +1,192 @@
+package opencypher
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func init() {
+	RegisterGlobalProcedure(Procedure{
+		Name:    "path.weighted",
+		MinArgs: 3,
+		MaxArgs: 4,
+		Args:    []string{"start", "end", "costProperty", "config"},
+		Outputs: []string{"path", "totalCost"},
+		Func:    weightedPathProc,
+	})
+}
+
+// weightedPathConfig is the configuration map of path.weighted:
+//
+//	relTypes: list of relationship types to traverse. Default is all.
+//	direction: OUTGOING, INCOMING, or BOTH. Default is OUTGOING.
+//	defaultCost: The cost of edges without the cost property. If
+//	  not given, such edges are an error.
+type weightedPathConfig struct {
+	relTypes    []string
+	outgoing    bool
+	incoming    bool
+	defaultCost *float64
+}
+
+func parseWeightedPathConfig(v Value) (weightedPathConfig, error) {
+	ret := weightedPathConfig{outgoing: true}
+	err := parseConfig(v, map[string]func(Value) error{
+		"relTypes": func(val Value) (err error) {
+			ret.relTypes, err = valueAsStrings(val)
+			return
+		},
+		"direction": func(val Value) (err error) {
+			ret.outgoing, ret.incoming, err = parseDirection(val)
+			return
+		},
+		"defaultCost": func(val Value) error {
+			f, err := valueAsFloat(val)
+			if err != nil {
+				return err
+			}
+			ret.defaultCost = &f
+			return nil
+		},
+	})
+	return ret, err
+}
+
+// edgeCost returns the cost of traversing the edge
+func (cfg weightedPathConfig) edgeCost(edge *lpg.Edge, property string) (float64, error) {
+	prop, ok := edge.GetProperty(property)
+	if !ok {
+		if cfg.defaultCost != nil {
+			return *cfg.defaultCost, nil
+		}
+		return 0, fmt.Errorf("Edge has no %s property: %v", property, edge)
+	}
+	cost, err := valueAsFloat(propertyValue(prop))
+	if err != nil {
+		return 0, err
+	}
+	if cost < 0 {
+		return 0, fmt.Errorf("Negative cost: %v", cost)
+	}
+	return cost, nil
+}
+
+// dijkstraItem is a node in the priority queue
+type dijkstraItem struct {
+	node *lpg.Node
+	cost float64
+}
+
+type dijkstraQueue []dijkstraItem
+
+func (q dijkstraQueue) Len() int            { return len(q) }
+func (q dijkstraQueue) Less(i, j int) bool  { return q[i].cost < q[j].cost }
+func (q dijkstraQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *dijkstraQueue) Push(x interface{}) { *q = append(*q, x.(dijkstraItem)) }
+func (q *dijkstraQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// weightedPathProc finds the least-cost path between start and end
+// using Dijkstra's algorithm. It emits no rows if end is not
+// reachable from start.
+func weightedPathProc(ctx *EvalContext, args []Value, emit func(map[string]Value) error) error {
+	if args[0].Get() == nil || args[1].Get() == nil {
+		return nil
+	}
+	start, ok := args[0].Get().(*lpg.Node)
+	if !ok {
+		return fmt.Errorf("Start is not a node: %v", args[0].Get())
+	}
+	end, ok := args[1].Get().(*lpg.Node)
+	if !ok {
+		return fmt.Errorf("End is not a node: %v", args[1].Get())
+	}
+	property, err := ValueAsString(args[2])
+	if err != nil {
+		return err
+	}
+	var cfg weightedPathConfig
+	if len(args) > 3 {
+		cfg, err = parseWeightedPathConfig(args[3])
+	} else {
+		cfg, err = parseWeightedPathConfig(nil)
+	}
+	if err != nil {
+		return err
+	}
+	edgeFilter := lpg.GetEdgeFilterFunc(lpg.NewStringSet(cfg.relTypes...), nil)
+
+	costs := map[*lpg.Node]float64{start: 0}
+	preds := make(map[*lpg.Node]lpg.PathElement)
+	done := make(map[*lpg.Node]struct{})
+	queue := &dijkstraQueue{{node: start}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(dijkstraItem)
+		if _, ok := done[item.node]; ok {
+			continue
+		}
+		done[item.node] = struct{}{}
+		if item.node == end {
+			break
+		}
+		relax := func(pe lpg.PathElement) error {
+			if !edgeFilter(pe.Edge) {
+				return nil
+			}
+			target := pe.GetTargetNode()
+			if _, ok := done[target]; ok {
+				return nil
+			}
+			cost, err := cfg.edgeCost(pe.Edge, property)
+			if err != nil {
+				return err
+			}
+			cost += item.cost
+			if c, ok := costs[target]; !ok || cost < c {
+				costs[target] = cost
+				preds[target] = pe
+				heap.Push(queue, dijkstraItem{node: target, cost: cost})
+			}
+			return nil
+		}
+		if cfg.outgoing {
+			for edges := item.node.GetEdges(lpg.OutgoingEdge); edges.Next(); {
+				if err := relax(lpg.PathElement{Edge: edges.Edge()}); err != nil {
+					return err
+				}
+			}
+		}
+		if cfg.incoming {
+			for edges := item.node.GetEdges(lpg.IncomingEdge); edges.Next(); {
+				if err := relax(lpg.PathElement{Edge: edges.Edge(), Reverse: true}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if _, ok := done[end]; !ok {
+		return nil
+	}
+	var path *lpg.Path
+	if start == end {
+		path = lpg.PathFromNode(start)
+	} else {
+		elements := make([]lpg.PathElement, 0)
+		for node := end; node != start; {
+			pe := preds[node]
+			elements = append([]lpg.PathElement{pe}, elements...)
+			node = pe.GetSourceNode()
+		}
+		path = lpg.NewPathFromElements(elements...)
+	}
+	return emit(map[string]Value{
+		"path":      RValue{Value: path},
+		"totalCost": RValue{Value: costs[end]},
+	})
+}
@@ -0,0 +1,54 @@
+package opencypher
+
+import (
+	"testing"
+
+	"github.com/cloudprivacylabs/lpg/v2"
+)
+
+func TestWeightedPath(t *testing.T) {
+	g := lpg.NewGraph()
+	nodes := make(map[string]*lpg.Node)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		nodes[name] = g.NewNode([]string{"Router"}, map[string]interface{}{"name": name})
+	}
+	for _, e := range []struct {
+		from, to, label string
+		cost            interface{}
+	}{{"a", "b", "ROUTE", 1}, {"b", "c", "ROUTE", 2.5}, {"a", "c", "ROUTE", 5}, {"c", "d", "ROUTE", 1}, {"a", "d", "TUNNEL", 1}, {"e", "a", "ROUTE", 1}} {
+		g.NewEdge(nodes[e.from], nodes[e.to], e.label, map[string]interface{}{"cost": e.cost})
+	}
+
+	rs := runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'cost', {relTypes:['ROUTE']}) YIELD path, totalCost RETURN length(path) AS len, totalCost AS cost`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["len"].Get() != 3 || rs.Rows[0]["cost"].Get() != 4.5 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'cost') YIELD totalCost RETURN totalCost AS totalCost`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["totalCost"].Get() != 1.0 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	// e is only reachable following edges backwards
+	rs = runTestMatch(t, `MATCH (d {name:'d'}), (e {name:'e'}) CALL path.weighted(d, e, 'cost') YIELD totalCost RETURN totalCost AS totalCost`, g)
+	if len(rs.Rows) != 0 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+	rs = runTestMatch(t, `MATCH (d {name:'d'}), (e {name:'e'}) CALL path.weighted(d, e, 'cost', {direction:'both', relTypes:'ROUTE'}) YIELD path, totalCost RETURN nodes(path) AS nodes, totalCost AS totalCost`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["totalCost"].Get() != 5.5 || len(rs.Rows[0]["nodes"].Get().([]Value)) != 5 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+
+	for _, q := range []string{
+		`MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'weight') YIELD totalCost RETURN totalCost AS totalCost`,
+		`MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'cost', {direction:'UP'}) YIELD totalCost RETURN totalCost AS totalCost`,
+		`MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'cost', {types:['ROUTE']}) YIELD totalCost RETURN totalCost AS totalCost`,
+		`MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'cost', 'ROUTE') YIELD totalCost RETURN totalCost AS totalCost`,
+	} {
+		if _, err := ParseAndEvaluate(q, NewEvalContext(g)); err == nil {
+			t.Errorf("%s: Expecting error", q)
+		}
+	}
+	rs = runTestMatch(t, `MATCH (a {name:'a'}), (d {name:'d'}) CALL path.weighted(a, d, 'weight', {defaultCost: 2}) YIELD totalCost RETURN totalCost AS totalCost`, g)
+	if len(rs.Rows) != 1 || rs.Rows[0]["totalCost"].Get() != 2.0 {
+		t.Errorf("Wrong result: %v", rs)
+	}
+}